@@ -29,6 +29,12 @@ type CheckRequest struct {
 	Dlogger               DetailLogger
 	Repo                  clients.Repo
 	VulnerabilitiesClient clients.VulnerabilitiesClient
+	// Deprecations are the deprecation notices found outside of the repository,
+	// e.g. in the package registry the project was looked up in.
+	Deprecations []Deprecation
+	// LookBackDays is the number of days in which activity is looked for,
+	// or zero for DefaultLookBackDays.
+	LookBackDays int
 	// UPGRADEv6: return raw results instead of scores.
 	RawResults    *RawResults
 	RequiredTypes []RequestType
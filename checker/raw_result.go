@@ -16,6 +16,8 @@ package checker
 
 import (
 	"fmt"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/ossf/scorecard/v4/clients"
@@ -37,6 +39,7 @@ type RawResults struct {
 	DangerousWorkflowResults    DangerousWorkflowData
 	DependencyUpdateToolResults DependencyUpdateToolData
 	FuzzingResults              FuzzingData
+	InstallScriptsResults       InstallScriptsData
 	LicenseResults              LicenseData
 	MaintainedResults           MaintainedData
 	Metadata                    MetadataData
@@ -44,7 +47,9 @@ type RawResults struct {
 	PinningDependenciesResults  PinningDependenciesData
 	SASTResults                 SASTData
 	SecurityPolicyResults       SecurityPolicyData
+	SecuritySettingsResults     SecuritySettingsData
 	SignedReleasesResults       SignedReleasesData
+	ThirdPartyActionsResults    ThirdPartyActionsData
 	TokenPermissionsResults     TokenPermissionsData
 	VulnerabilitiesResults      VulnerabilitiesData
 	WebhookResults              WebhooksData
@@ -68,6 +73,40 @@ type CITestData struct {
 // FuzzingData represents different fuzzing done.
 type FuzzingData struct {
 	Fuzzers []Tool
+	// Targets are the fuzz targets found in the repository.
+	Targets []FuzzTarget
+	// Executions are the evidence that fuzz targets run continuously.
+	Executions []FuzzExecution
+}
+
+// FuzzTarget represents a fuzz target.
+type FuzzTarget struct {
+	Function string
+	Language clients.LanguageName
+	File     File
+}
+
+// FuzzExecutionType is the way fuzz targets are run.
+type FuzzExecutionType string
+
+const (
+	// FuzzExecutionOSSFuzz is a project integrated with OSS-Fuzz.
+	FuzzExecutionOSSFuzz FuzzExecutionType = "OSS-Fuzz"
+	// FuzzExecutionCIFuzz is a workflow running the CIFuzz action of OSS-Fuzz.
+	FuzzExecutionCIFuzz FuzzExecutionType = "CIFuzz"
+	// FuzzExecutionClusterFuzzLite is a workflow running the ClusterFuzzLite action.
+	FuzzExecutionClusterFuzzLite FuzzExecutionType = "ClusterFuzzLite"
+	// FuzzExecutionGoTest is a CI step running `go test -fuzz`.
+	FuzzExecutionGoTest FuzzExecutionType = "go test -fuzz"
+	// FuzzExecutionCargoFuzz is a CI step running `cargo fuzz run`.
+	FuzzExecutionCargoFuzz FuzzExecutionType = "cargo fuzz run"
+)
+
+// FuzzExecution represents evidence that fuzz targets run continuously.
+type FuzzExecution struct {
+	// File is nil for executions outside the repository, such as OSS-Fuzz.
+	File *File
+	Type FuzzExecutionType
 }
 
 // TODO: Add Msg to all results.
@@ -75,18 +114,75 @@ type FuzzingData struct {
 // PackagingData contains results for the Packaging check.
 type PackagingData struct {
 	Packages []Package
+	// ReleaseJobs are the workflow jobs publishing packages,
+	// whether or not they ran.
+	ReleaseJobs []ReleaseJob
+	// Environments are the deployment environments of the repository,
+	// or nil if they could not be listed.
+	Environments []clients.Environment
+}
+
+// ReleaseJob is a workflow job publishing packages.
+type ReleaseJob struct {
+	File File
+	// ID is the key of the job in the workflow.
+	ID string
+	// Environment is the deployment environment of the job, if any.
+	Environment string
+	// Credentials are the credentials the steps of the job publish with.
+	Credentials []PublishCredential
+	// IDTokenWrite is true if the job can request an OIDC identity token,
+	// i.e. it has the `id-token: write` permission.
+	IDTokenWrite bool
 }
 
+// PublishCredentialType is the type of credential a job publishes packages with.
+type PublishCredentialType string
+
+const (
+	// PublishCredentialOIDC is a short-lived credential exchanged for the OIDC
+	// identity token of the job, e.g. PyPI trusted publishing.
+	PublishCredentialOIDC PublishCredentialType = "oidc"
+	// PublishCredentialSecret is a long-lived API token stored as a secret.
+	PublishCredentialSecret PublishCredentialType = "secret"
+)
+
+// PublishCredential is a credential used by a step of a release job.
+type PublishCredential struct {
+	File File
+	Type PublishCredentialType
+	// Secret is the name of the secret, for PublishCredentialSecret.
+	Secret string
+}
+
+// PackageEcosystem is the ecosystem a package is published to.
+type PackageEcosystem string
+
+const (
+	PackageEcosystemNpm       PackageEcosystem = "npm"
+	PackageEcosystemPyPI      PackageEcosystem = "PyPI"
+	PackageEcosystemCratesIO  PackageEcosystem = "crates.io"
+	PackageEcosystemMaven     PackageEcosystem = "Maven"
+	PackageEcosystemRubyGems  PackageEcosystem = "RubyGems"
+	PackageEcosystemNuGet     PackageEcosystem = "NuGet"
+	PackageEcosystemGo        PackageEcosystem = "Go"
+	PackageEcosystemContainer PackageEcosystem = "container"
+	PackageEcosystemHelm      PackageEcosystem = "Helm"
+)
+
 // Package represents a package.
 type Package struct {
-	// TODO: not supported yet. This needs to be unique across
-	// ecosystems: purl, OSV, CPE, etc.
+	// Name is the purl of the package, e.g. "pkg:cargo/serde",
+	// if it can be derived from the manifests of the repository.
 	Name *string
 	Job  *WorkflowJob
 	File *File
 	// Note: Msg is populated only for debug messages.
-	Msg  *string
-	Runs []Run
+	Msg *string
+	// Ecosystem is empty if the workflow may publish to several
+	// ecosystems, e.g. with semantic-release.
+	Ecosystem PackageEcosystem
+	Runs      []Run
 }
 
 // DependencyUseType represents a type of dependency use.
@@ -109,6 +205,8 @@ const (
 	DependencyUseTypePipCommand DependencyUseType = "pipCommand"
 	// DependencyUseTypeNugetCommand is a nuget command.
 	DependencyUseTypeNugetCommand DependencyUseType = "nugetCommand"
+	// DependencyUseTypeGitSubmodule is a git submodule.
+	DependencyUseTypeGitSubmodule DependencyUseType = "gitSubmodule"
 )
 
 // PinningDependenciesData represents pinned dependency data.
@@ -127,7 +225,23 @@ type Dependency struct {
 	Msg         *string // Only for debug messages.
 	Pinned      *bool
 	Remediation *rule.Remediation
-	Type        DependencyUseType
+	// Submodule is only set for DependencyUseTypeGitSubmodule.
+	Submodule *Submodule
+	Type      DependencyUseType
+}
+
+// Submodule is a git submodule declared in `.gitmodules`.
+type Submodule struct {
+	// Reachable is whether the pinned commit is reachable from a branch
+	// of the upstream repository, or nil if it was not verified.
+	Reachable *bool
+	// Path is the path of the submodule in the repository.
+	Path string
+	// URL is the upstream repository as declared, possibly relative
+	// to the repository.
+	URL string
+	// Branch is the branch followed by `git submodule update --remote`, if any.
+	Branch string
 }
 
 // MaintainedData contains the raw results
@@ -136,7 +250,74 @@ type MaintainedData struct {
 	CreatedAt            time.Time
 	Issues               []clients.Issue
 	DefaultBranchCommits []clients.Commit
-	ArchivedStatus       ArchivedStatus
+	Releases             []clients.Release
+	// MergedRequests are the pull or merge requests of DefaultBranchCommits.
+	MergedRequests    []clients.PullRequest
+	ArchivedStatus    ArchivedStatus
+	IssueResponseTime IssueResponseTime
+	// Deprecations are the deprecation notices of the project.
+	Deprecations []Deprecation
+	// LookBackDays is the number of days in which activity is looked for.
+	LookBackDays int
+}
+
+// DeprecationSource is where a deprecation notice was found.
+type DeprecationSource string
+
+const (
+	// DeprecationSourceReadme is a banner or badge in the README.
+	DeprecationSourceReadme DeprecationSource = "readme"
+	// DeprecationSourceTopic is a topic of the repository.
+	DeprecationSourceTopic DeprecationSource = "topic"
+	// DeprecationSourceRegistry is the metadata of the package in its registry.
+	DeprecationSourceRegistry DeprecationSource = "registry"
+)
+
+// Deprecation is a notice that the project is deprecated or no longer maintained.
+type Deprecation struct {
+	Source DeprecationSource
+	// Location is the file, topic or package which contains the notice.
+	Location string
+	Message  string
+	// Replacement is the project to use instead, if the notice names one.
+	Replacement string
+}
+
+const (
+	// DefaultLookBackDays is the default number of days in which activity is looked for.
+	DefaultLookBackDays = 90
+	// MinLookBackDays is the shortest window in which activity is looked for,
+	// as at least one activity a week is expected.
+	MinLookBackDays = 7
+)
+
+// LookBackThreshold returns the time since which activity is looked for.
+func (m *MaintainedData) LookBackThreshold() time.Time {
+	return time.Now().AddDate(0 /*years*/, 0 /*months*/, -1*m.LookBack() /*days*/)
+}
+
+// LookBack returns the number of days in which activity is looked for.
+func (m *MaintainedData) LookBack() int {
+	return lookBack(m.LookBackDays)
+}
+
+func lookBack(days int) int {
+	if days <= 0 {
+		return DefaultLookBackDays
+	}
+	return days
+}
+
+// IssueResponseTime summarizes how long maintainers take to respond to the issues
+// opened by other users within the look back window.
+type IssueResponseTime struct {
+	// Median is the median time to the first response of a maintainer. Issues without
+	// a response count with the time elapsed since they were opened.
+	Median time.Duration
+	// Issues is the number of issues opened by other users.
+	Issues int
+	// Responded is the number of those issues a maintainer responded to.
+	Responded int
 }
 
 type LicenseAttributionType string
@@ -167,6 +348,25 @@ type LicenseFile struct {
 // Some repos may have more than one license.
 type LicenseData struct {
 	LicenseFiles []LicenseFile
+	// ManifestLicenses are the licenses declared in the package manifests
+	// at the top-level directory, e.g. package.json or Cargo.toml.
+	ManifestLicenses []LicenseDeclaration
+	// HeaderLicenses are the SPDX-License-Identifier headers of source files.
+	HeaderLicenses []LicenseDeclaration
+}
+
+// LicenseDeclaration is a license expression declared outside of a license file.
+type LicenseDeclaration struct {
+	File File
+	// Expression is the license expression as written in the file.
+	Expression string
+	// SpdxIDs are the license identifiers of the expression.
+	// It is empty if the expression is not a valid SPDX expression.
+	SpdxIDs []string
+	// Valid is true if the expression is a valid SPDX expression.
+	Valid bool
+	// Approved is true if the expression can be satisfied with FSF or OSI approved licenses.
+	Approved bool
 }
 
 // CodeReviewData contains the raw results
@@ -191,6 +391,24 @@ type Changeset struct {
 	Commits        []clients.Commit
 	Reviews        []clients.Review
 	Author         clients.User
+	// MergedBy is the user who merged the changeset, if known.
+	MergedBy clients.User
+	// HeadSHA is the last commit pushed to the changeset before it was merged.
+	HeadSHA string
+	// HeadCommittedAt is the commit time of HeadSHA.
+	HeadCommittedAt time.Time
+	// CommitAuthors are the authors of the commits pushed to the changeset.
+	CommitAuthors []clients.User
+}
+
+// SubmittedReviews returns the reviews submitted by reviewers. Unlike
+// Reviews, it does not include the implicit approval of the user who
+// merged a GitHub or GitLab changeset.
+func (c *Changeset) SubmittedReviews() []clients.Review {
+	if c.ReviewPlatform == ReviewPlatformGitHub && len(c.Commits) > 0 {
+		return c.Commits[0].AssociatedMergeRequest.Reviews
+	}
+	return c.Reviews
 }
 
 // ContributorsData represents contributor information.
@@ -225,12 +443,37 @@ type SecurityPolicyInformation struct {
 }
 
 type SecurityPolicyFile struct {
+	// SecurityTxt holds the fields of a security.txt file, nil for other policy files.
+	SecurityTxt *SecurityTxt
 	// security policy information found in repo or org
 	Information []SecurityPolicyInformation
+	// DisclosureTimeline are the lines committing to a response or disclosure delay.
+	DisclosureTimeline []SecurityPolicyValueType
+	// SupportedVersions is the table of versions receiving security updates.
+	SupportedVersions []SupportedVersion
 	// file that contains the security policy information
 	File File
 }
 
+// SecurityTxt represents the fields of a security.txt file, as defined by RFC 9116.
+type SecurityTxt struct {
+	// Expires is nil if the field is missing or invalid.
+	Expires            *time.Time
+	Contacts           []string
+	Encryption         []string
+	Policies           []string
+	PreferredLanguages []string
+	Canonical          []string
+	// Signed is true if the file is signed with OpenPGP cleartext signature.
+	Signed bool
+}
+
+// SupportedVersion represents a row of the supported versions table of a security policy.
+type SupportedVersion struct {
+	Version   string
+	Supported bool
+}
+
 // SASTData contains the raw results
 // for the SAST check.
 type SASTData struct {
@@ -263,6 +506,16 @@ const (
 	PysaWorkflow SASTWorkflowType = "Pysa"
 	// QodanaWorkflow represents a workflow that runs Qodana.
 	QodanaWorkflow SASTWorkflowType = "Qodana"
+	// SemgrepWorkflow represents a workflow that runs Semgrep.
+	SemgrepWorkflow SASTWorkflowType = "Semgrep"
+	// GosecWorkflow represents a workflow that runs gosec.
+	GosecWorkflow SASTWorkflowType = "gosec"
+	// BanditWorkflow represents a workflow that runs Bandit.
+	BanditWorkflow SASTWorkflowType = "Bandit"
+	// GolangciLintWorkflow represents a workflow that runs golangci-lint with gosec enabled.
+	GolangciLintWorkflow SASTWorkflowType = "golangci-lint"
+	// GitLabSASTWorkflow represents a pipeline including the GitLab SAST template or component.
+	GitLabSASTWorkflow SASTWorkflowType = "GitLab SAST"
 )
 
 // SASTWorkflow represents a SAST workflow.
@@ -274,7 +527,15 @@ type SASTWorkflow struct {
 // SecurityPolicyData contains the raw results
 // for the Security-Policy check.
 type SecurityPolicyData struct {
-	PolicyFiles []SecurityPolicyFile
+	// PrivateVulnerabilityReportingEnabled is nil if the setting could not be read.
+	PrivateVulnerabilityReportingEnabled *bool
+	PolicyFiles                          []SecurityPolicyFile
+	// SecurityTxtFiles contains the security.txt files of the repository.
+	// They are kept apart from PolicyFiles and only used by the security.txt probes.
+	SecurityTxtFiles []SecurityPolicyFile
+	// SecurityAdvisories lists the published advisories of the repository.
+	// It is nil if they could not be listed, and empty if there are none.
+	SecurityAdvisories []clients.SecurityAdvisory
 }
 
 // BinaryArtifactData contains the raw results
@@ -295,6 +556,53 @@ type SignedReleasesData struct {
 type DependencyUpdateToolData struct {
 	// Tools contains a list of tools.
 	Tools []Tool
+	// Manifests are the dependency manifests in the repository,
+	// one per ecosystem and directory.
+	Manifests []DependencyManifest
+	// LookBackDays is the number of days in which the activity of the tools is looked for.
+	LookBackDays int
+}
+
+// LookBack returns the number of days in which the activity of the tools is looked for.
+func (d *DependencyUpdateToolData) LookBack() int {
+	return lookBack(d.LookBackDays)
+}
+
+// DependencyManifest is a file declaring dependencies.
+type DependencyManifest struct {
+	File File
+	// Ecosystem is the Dependabot `package-ecosystem` of the manifest, e.g. "gomod".
+	Ecosystem string
+	// Directory is the directory of the manifest, e.g. "/" for the root.
+	Directory string
+}
+
+// DependencyUpdateCoverage is an ecosystem and directory a dependency
+// update tool is configured to update.
+type DependencyUpdateCoverage struct {
+	// Ecosystem is a Dependabot `package-ecosystem`, or empty for all ecosystems.
+	Ecosystem string
+	// Directory is a directory, or a pattern ending in "/**" for a directory
+	// and its subdirectories.
+	Directory string
+}
+
+// Covers returns true if the manifest is in the ecosystem and directory.
+func (c *DependencyUpdateCoverage) Covers(m *DependencyManifest) bool {
+	if c.Ecosystem != "" && c.Ecosystem != m.Ecosystem {
+		return false
+	}
+	if dir, ok := strings.CutSuffix(c.Directory, "/**"); ok {
+		return dir == "" || m.Directory == dir || strings.HasPrefix(m.Directory, dir+"/")
+	}
+	match, err := path.Match(c.Directory, m.Directory)
+	return err == nil && match
+}
+
+// ToolActivity is the recent activity of a tool in a repository.
+type ToolActivity struct {
+	// Commits are the commits authored by the tool, most recent first.
+	Commits []clients.Commit
 }
 
 // WebhooksData contains the raw results
@@ -303,11 +611,154 @@ type WebhooksData struct {
 	Webhooks []clients.Webhook
 }
 
+// SecuritySettingsData contains the raw results
+// for the Security-Settings check.
+type SecuritySettingsData struct {
+	// Settings maps the security settings readable with the token
+	// to whether they are enabled. Missing settings are unknown.
+	Settings map[clients.SecuritySetting]bool
+}
+
+// InstallScriptsData contains the raw results
+// for the Install-Scripts check.
+type InstallScriptsData struct {
+	// Manifests are the package manifests parsed, e.g. `package.json`.
+	Manifests []File
+	// Scripts are the code run when the packages are installed.
+	Scripts []InstallScript
+}
+
+// InstallScriptType is the mechanism running code when a package is installed.
+type InstallScriptType string
+
+const (
+	// InstallScriptNpmLifecycle is a `preinstall`, `install` or `postinstall`
+	// script of a `package.json`.
+	InstallScriptNpmLifecycle InstallScriptType = "npmLifecycleScript"
+	// InstallScriptSetupPy is a network or subprocess call at the module level of a `setup.py`.
+	InstallScriptSetupPy InstallScriptType = "setupPy"
+	// InstallScriptPythonBuildBackend is an in-tree or unknown build backend of a `pyproject.toml`.
+	InstallScriptPythonBuildBackend InstallScriptType = "pythonBuildBackend"
+	// InstallScriptGemExtension is a native extension of a gemspec, built by running its `extconf.rb`.
+	InstallScriptGemExtension InstallScriptType = "gemExtension"
+)
+
+// InstallScript is code run on the machines of the consumers
+// when they install a package.
+type InstallScript struct {
+	// Downloads are the unpinned downloads of the shell commands of the script.
+	Downloads []Dependency
+	// ProcessingErrors are the shell commands of the script which could not be
+	// parsed. Downloads may be incomplete when they are set.
+	ProcessingErrors []ElementError
+	// Name is the script, call, build backend or extension, e.g. `postinstall`.
+	Name string
+	Type InstallScriptType
+	// File is the location of the script in the manifest.
+	File File
+}
+
+// ThirdPartyActionsData contains the Scorecard results of the
+// repositories of the third-party GitHub Actions used by the repository.
+type ThirdPartyActionsData struct {
+	// Actions is nil if the actions were not scored.
+	Actions []ThirdPartyAction
+}
+
+// ThirdPartyAction is a repository of third-party GitHub Actions.
+type ThirdPartyAction struct {
+	// Scores maps the names of the checks run against the repository
+	// to their score. It is nil if the repository was not scored.
+	Scores map[string]int
+	// Repo is the action repository, e.g. `actions/checkout`.
+	Repo string
+	// UsedBy is the action repository whose workflows use the actions,
+	// or empty for the scored repository.
+	UsedBy string
+	// Error explains why the repository was not scored.
+	Error string
+	// Locations are the `uses:` references to the actions.
+	Locations []File
+	// Depth is 1 for the actions used by the scored repository,
+	// 2 for the actions used by these actions, etc.
+	Depth int
+}
+
+// Score returns the average of the conclusive check scores
+// of the action repository, or false if there are none.
+func (a *ThirdPartyAction) Score() (float64, bool) {
+	var sum, n int
+	for _, score := range a.Scores {
+		if score == InconclusiveResultScore {
+			continue
+		}
+		sum += score
+		n++
+	}
+	if n == 0 {
+		return 0, false
+	}
+	return float64(sum) / float64(n), true
+}
+
 // BranchProtectionsData contains the raw results
 // for the Branch-Protection check.
 type BranchProtectionsData struct {
 	Branches        []clients.BranchRef
 	CodeownersFiles []string
+	// Codeowners is the CODEOWNERS file used by the platform,
+	// or nil if the repository has none.
+	Codeowners *Codeowners
+	// SensitivePaths are the security-sensitive files in the repository.
+	SensitivePaths []SensitivePath
+}
+
+// Codeowners is a parsed CODEOWNERS file.
+type Codeowners struct {
+	Path   string
+	Rules  []CodeownersRule
+	Errors []CodeownersError
+}
+
+// CodeownersRule is a rule of a CODEOWNERS file.
+type CodeownersRule struct {
+	Pattern string
+	// Section is the GitLab section of the rule, empty outside of sections.
+	Section string
+	Owners  []string
+	// Approvals is the number of approvals required by the GitLab section, or 0.
+	Approvals int
+	Line      uint
+	// Optional is true for rules in an optional GitLab section.
+	Optional bool
+}
+
+// CodeownersError is a syntax error or a rule matching no file.
+type CodeownersError struct {
+	Text string
+	Line uint
+}
+
+// SensitivePathKind is the kind of a security-sensitive path.
+type SensitivePathKind string
+
+const (
+	// SensitivePathWorkflow is a CI workflow definition.
+	SensitivePathWorkflow SensitivePathKind = "workflow"
+	// SensitivePathReleaseScript is a script or configuration used to release the project.
+	SensitivePathReleaseScript SensitivePathKind = "releaseScript"
+	// SensitivePathDockerfile is a Dockerfile.
+	SensitivePathDockerfile SensitivePathKind = "dockerfile"
+	// SensitivePathDependencyManifest is a dependency manifest.
+	SensitivePathDependencyManifest SensitivePathKind = "dependencyManifest"
+)
+
+// SensitivePath is a security-sensitive file and its code owners.
+type SensitivePath struct {
+	Path string
+	Kind SensitivePathKind
+	// Owners are the code owners of the file, empty if it has none.
+	Owners []string
 }
 
 // Tool represents a tool.
@@ -322,6 +773,11 @@ type Tool struct {
 	Issues []clients.Issue
 	// Merge requests created by the tool.
 	MergeRequests []clients.PullRequest
+	// Coverage is what the configuration of the tool covers,
+	// or nil if the configuration could not be parsed.
+	Coverage []DependencyUpdateCoverage
+	// Activity is nil if the activity of the tool is not known.
+	Activity *ToolActivity
 
 	// TODO: CodeCoverage, jsonWorkflowJob.
 }
@@ -333,8 +789,9 @@ type Run struct {
 
 // ArchivedStatus defines the archived status.
 type ArchivedStatus struct {
-	Status bool
-	// TODO: add fields, e.g., date of archival.
+	// ArchivedAt is nil if the date of archival is unknown.
+	ArchivedAt *time.Time
+	Status     bool
 }
 
 // File represents a file.
@@ -350,7 +807,10 @@ type File struct {
 
 // CIIBestPracticesData contains data for CIIBestPractices check.
 type CIIBestPracticesData struct {
-	Badge clients.BadgeLevel
+	// Criteria are the self-attested criteria of the project,
+	// nil if the project has no badge entry.
+	Criteria clients.BadgeCriteria
+	Badge    clients.BadgeLevel
 }
 
 // DangerousWorkflowType represents a type of dangerous workflow.
@@ -361,13 +821,25 @@ const (
 	DangerousWorkflowScriptInjection DangerousWorkflowType = "scriptInjection"
 	// DangerousWorkflowUntrustedCheckout represents an untrusted checkout.
 	DangerousWorkflowUntrustedCheckout DangerousWorkflowType = "untrustedCheckout"
+	// DangerousWorkflowGitHubEnvInjection represents untrusted data written to GITHUB_ENV or GITHUB_PATH.
+	DangerousWorkflowGitHubEnvInjection DangerousWorkflowType = "githubEnvInjection"
+	// DangerousWorkflowSecretsInherit represents secrets inherited by a reusable
+	// workflow called from a fork-reachable trigger.
+	DangerousWorkflowSecretsInherit DangerousWorkflowType = "secretsInherit"
+	// DangerousWorkflowUnprotectedVariables represents a GitLab CI job running on
+	// merge requests that references project variables which are not protected.
+	DangerousWorkflowUnprotectedVariables DangerousWorkflowType = "unprotectedVariables"
 )
 
 // DangerousWorkflowData contains raw results
 // for dangerous workflow check.
 type DangerousWorkflowData struct {
-	Workflows    []DangerousWorkflow
-	NumWorkflows int
+	// PrivateRepo is nil if the repository visibility is unknown.
+	PrivateRepo          *bool
+	Workflows            []DangerousWorkflow
+	SelfHostedRunnerJobs []SelfHostedRunnerJob
+	ProcessingErrors     []ElementError // files with errors may have incomplete results
+	NumWorkflows         int
 }
 
 // DangerousWorkflow represents a dangerous workflow.
@@ -377,6 +849,16 @@ type DangerousWorkflow struct {
 	File File
 }
 
+// SelfHostedRunnerJob represents a workflow job that runs on self-hosted runners.
+type SelfHostedRunnerJob struct {
+	Job *WorkflowJob
+	// Labels are the runner labels requested by the job.
+	Labels []string
+	// ForkTriggers are the workflow triggers that a fork can fire.
+	ForkTriggers []string
+	File         File
+}
+
 // WorkflowJob represents a workflow job.
 type WorkflowJob struct {
 	Name *string
@@ -385,8 +867,37 @@ type WorkflowJob struct {
 
 // TokenPermissionsData represents data about a permission failure.
 type TokenPermissionsData struct {
-	TokenPermissions []TokenPermission
-	NumTokens        int
+	// JobTokenScope is the GitLab CI/CD job token access setting,
+	// nil if unknown or not applicable.
+	JobTokenScope *clients.CIJobTokenScope
+	// RepoWorkflowPermissions and OrgWorkflowPermissions are the GitHub Actions
+	// settings of the GITHUB_TOKEN, nil if unknown or not applicable.
+	RepoWorkflowPermissions *clients.WorkflowPermissions
+	OrgWorkflowPermissions  *clients.WorkflowPermissions
+	TokenPermissions        []TokenPermission
+	NumTokens               int
+}
+
+// DefaultWorkflowPermissions returns the settings applying to the GITHUB_TOKEN
+// of the repository's workflows, or nil if unknown.
+// Without the repository setting, the organization setting is only used if it
+// is read-only, as its repositories then can't grant write permissions.
+func (t *TokenPermissionsData) DefaultWorkflowPermissions() *clients.WorkflowPermissions {
+	if t.RepoWorkflowPermissions != nil {
+		return t.RepoWorkflowPermissions
+	}
+	if t.OrgWorkflowPermissions != nil &&
+		t.OrgWorkflowPermissions.DefaultPermissions == string(PermissionLevelRead) {
+		return t.OrgWorkflowPermissions
+	}
+	return nil
+}
+
+// DefaultWorkflowPermissionsAreReadOnly returns true if the GITHUB_TOKEN is known to
+// default to read-only permissions when a workflow does not declare any.
+func (t *TokenPermissionsData) DefaultWorkflowPermissionsAreReadOnly() bool {
+	perms := t.DefaultWorkflowPermissions()
+	return perms != nil && perms.DefaultPermissions == string(PermissionLevelRead)
 }
 
 // PermissionLocation represents a declaration type.
@@ -15,6 +15,8 @@
 package checks
 
 import (
+	"fmt"
+
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/checks/evaluation"
 	"github.com/ossf/scorecard/v4/checks/raw"
@@ -46,6 +48,15 @@ func DangerousWorkflow(c *checker.CheckRequest) checker.CheckResult {
 		return checker.CreateRuntimeErrorResult(CheckDangerousWorkflow, e)
 	}
 
+	for i := range rawData.ProcessingErrors {
+		e := rawData.ProcessingErrors[i]
+		c.Dlogger.Debug(&checker.LogMessage{
+			Path: e.Location.Path,
+			Type: e.Location.Type,
+			Text: fmt.Sprintf("incomplete results: %v", e.Err),
+		})
+	}
+
 	// Set the raw results.
 	pRawResults := getRawResults(c)
 	pRawResults.DangerousWorkflowResults = rawData
@@ -39,7 +39,7 @@ func init() {
 
 // DependencyUpdateTool checks if the repository uses a dependency update tool.
 func DependencyUpdateTool(c *checker.CheckRequest) checker.CheckResult {
-	rawData, err := raw.DependencyUpdateTool(c.RepoClient)
+	rawData, err := raw.DependencyUpdateTool(c)
 	if err != nil {
 		e := sce.WithMessage(sce.ErrScorecardInternal, err.Error())
 		return checker.CreateRuntimeErrorResult(CheckDependencyUpdateTool, e)
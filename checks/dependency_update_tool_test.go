@@ -15,6 +15,8 @@
 package checks
 
 import (
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -47,7 +49,7 @@ func TestDependencyUpdateTool(t *testing.T) {
 			files: []string{
 				".github/dependabot.yml",
 			},
-			CallSearchCommits: 0,
+			CallSearchCommits: 1,
 			expected: scut.TestReturn{
 				NumberOfInfo: 1,
 				NumberOfWarn: 0,
@@ -60,7 +62,7 @@ func TestDependencyUpdateTool(t *testing.T) {
 			files: []string{
 				".github/dependabot.yaml",
 			},
-			CallSearchCommits: 0,
+			CallSearchCommits: 1,
 			expected: scut.TestReturn{
 				NumberOfInfo: 1,
 				NumberOfWarn: 0,
@@ -147,7 +149,11 @@ func TestDependencyUpdateTool(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockRepo := mockrepo.NewMockRepoClient(ctrl)
 			mockRepo.EXPECT().ListFiles(gomock.Any()).Return(tt.files, nil)
+			mockRepo.EXPECT().URI().Return("github.com/ossf/scorecard").AnyTimes()
 			mockRepo.EXPECT().SearchCommits(gomock.Any()).Return(tt.SearchCommits, nil).Times(tt.CallSearchCommits)
+			mockRepo.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(string) (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader("")), nil
+			}).AnyTimes()
 			dl := scut.TestDetailLogger{}
 			c := &checker.CheckRequest{
 				RepoClient: mockRepo,
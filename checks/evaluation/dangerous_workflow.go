@@ -18,7 +18,10 @@ import (
 	"github.com/ossf/scorecard/v4/checker"
 	sce "github.com/ossf/scorecard/v4/errors"
 	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/hasDangerousWorkflowGitHubEnvInjection"
 	"github.com/ossf/scorecard/v4/probes/hasDangerousWorkflowScriptInjection"
+	"github.com/ossf/scorecard/v4/probes/hasDangerousWorkflowSecretsInherit"
+	"github.com/ossf/scorecard/v4/probes/hasDangerousWorkflowUnprotectedVariables"
 	"github.com/ossf/scorecard/v4/probes/hasDangerousWorkflowUntrustedCheckout"
 )
 
@@ -29,6 +32,9 @@ func DangerousWorkflow(name string,
 	expectedProbes := []string{
 		hasDangerousWorkflowScriptInjection.Probe,
 		hasDangerousWorkflowUntrustedCheckout.Probe,
+		hasDangerousWorkflowGitHubEnvInjection.Probe,
+		hasDangerousWorkflowSecretsInherit.Probe,
+		hasDangerousWorkflowUnprotectedVariables.Probe,
 	}
 
 	if !finding.UniqueProbesEqual(findings, expectedProbes) {
@@ -58,7 +64,7 @@ func DangerousWorkflow(name string,
 		}
 	}
 
-	if hasDWWithUntrustedCheckout(findings) || hasDWWithScriptInjection(findings) {
+	if hasNegativeFinding(findings) {
 		return checker.CreateMinScoreResult(name,
 			"dangerous workflow patterns detected")
 	}
@@ -78,25 +84,10 @@ func hasWorkflows(findings []finding.Finding) bool {
 	return true
 }
 
-func hasDWWithUntrustedCheckout(findings []finding.Finding) bool {
+func hasNegativeFinding(findings []finding.Finding) bool {
 	for i := range findings {
-		f := &findings[i]
-		if f.Probe == hasDangerousWorkflowUntrustedCheckout.Probe {
-			if f.Outcome == finding.OutcomeNegative {
-				return true
-			}
-		}
-	}
-	return false
-}
-
-func hasDWWithScriptInjection(findings []finding.Finding) bool {
-	for i := range findings {
-		f := &findings[i]
-		if f.Probe == hasDangerousWorkflowScriptInjection.Probe {
-			if f.Outcome == finding.OutcomeNegative {
-				return true
-			}
+		if findings[i].Outcome == finding.OutcomeNegative {
+			return true
 		}
 	}
 	return false
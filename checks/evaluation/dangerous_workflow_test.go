@@ -48,6 +48,15 @@ func TestDangerousWorkflow(t *testing.T) {
 						LineStart: &testLineStart,
 						Snippet:   &testSnippet,
 					},
+				}, {
+					Probe:   "hasDangerousWorkflowGitHubEnvInjection",
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowSecretsInherit",
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowUnprotectedVariables",
+					Outcome: finding.OutcomePositive,
 				},
 			},
 			result: scut.TestReturn{
@@ -64,6 +73,15 @@ func TestDangerousWorkflow(t *testing.T) {
 				}, {
 					Probe:   "hasDangerousWorkflowUntrustedCheckout",
 					Outcome: finding.OutcomeNotApplicable,
+				}, {
+					Probe:   "hasDangerousWorkflowGitHubEnvInjection",
+					Outcome: finding.OutcomeNotApplicable,
+				}, {
+					Probe:   "hasDangerousWorkflowSecretsInherit",
+					Outcome: finding.OutcomeNotApplicable,
+				}, {
+					Probe:   "hasDangerousWorkflowUnprotectedVariables",
+					Outcome: finding.OutcomeNotApplicable,
 				},
 			},
 			result: scut.TestReturn{
@@ -79,6 +97,15 @@ func TestDangerousWorkflow(t *testing.T) {
 				}, {
 					Probe:   "hasDangerousWorkflowUntrustedCheckout",
 					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowGitHubEnvInjection",
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowSecretsInherit",
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowUnprotectedVariables",
+					Outcome: finding.OutcomePositive,
 				},
 			},
 			result: scut.TestReturn{
@@ -100,6 +127,15 @@ func TestDangerousWorkflow(t *testing.T) {
 						LineStart: &testLineStart,
 						Snippet:   &testSnippet,
 					},
+				}, {
+					Probe:   "hasDangerousWorkflowGitHubEnvInjection",
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowSecretsInherit",
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowUnprotectedVariables",
+					Outcome: finding.OutcomePositive,
 				},
 			},
 			result: scut.TestReturn{
@@ -122,6 +158,15 @@ func TestDangerousWorkflow(t *testing.T) {
 				}, {
 					Probe:   "hasDangerousWorkflowUntrustedCheckout",
 					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowGitHubEnvInjection",
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowSecretsInherit",
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowUnprotectedVariables",
+					Outcome: finding.OutcomePositive,
 				},
 			},
 			result: scut.TestReturn{
@@ -153,6 +198,15 @@ func TestDangerousWorkflow(t *testing.T) {
 				}, {
 					Probe:   "hasDangerousWorkflowUntrustedCheckout",
 					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowGitHubEnvInjection",
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowSecretsInherit",
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowUnprotectedVariables",
+					Outcome: finding.OutcomePositive,
 				},
 			},
 			result: scut.TestReturn{
@@ -238,6 +292,15 @@ func TestDangerousWorkflow(t *testing.T) {
 				}, {
 					Probe:   "hasDangerousWorkflowUntrustedCheckout",
 					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowGitHubEnvInjection",
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowSecretsInherit",
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowUnprotectedVariables",
+					Outcome: finding.OutcomePositive,
 				},
 			},
 			result: scut.TestReturn{
@@ -245,6 +308,74 @@ func TestDangerousWorkflow(t *testing.T) {
 				NumberOfWarn: 8,
 			},
 		},
+		{
+			name: "DangerousWorkflow - GITHUB_ENV injection and inherited secrets detected",
+			findings: []finding.Finding{
+				{
+					Probe:   "hasDangerousWorkflowScriptInjection",
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowUntrustedCheckout",
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowGitHubEnvInjection",
+					Outcome: finding.OutcomeNegative,
+					Location: &finding.Location{
+						Type:      finding.FileTypeText,
+						Path:      "./github/workflows/dangerous-workflow.yml",
+						LineStart: &testLineStart,
+						Snippet:   &testSnippet,
+					},
+				}, {
+					Probe:   "hasDangerousWorkflowSecretsInherit",
+					Outcome: finding.OutcomeNegative,
+					Location: &finding.Location{
+						Type:      finding.FileTypeText,
+						Path:      "./github/workflows/dangerous-workflow2.yml",
+						LineStart: &testLineStart,
+						Snippet:   &testSnippet,
+					},
+				}, {
+					Probe:   "hasDangerousWorkflowUnprotectedVariables",
+					Outcome: finding.OutcomePositive,
+				},
+			},
+			result: scut.TestReturn{
+				Score:        0,
+				NumberOfWarn: 2,
+			},
+		},
+		{
+			name: "DangerousWorkflow - unprotected variables used on merge requests detected",
+			findings: []finding.Finding{
+				{
+					Probe:   "hasDangerousWorkflowScriptInjection",
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowUntrustedCheckout",
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowGitHubEnvInjection",
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowSecretsInherit",
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   "hasDangerousWorkflowUnprotectedVariables",
+					Outcome: finding.OutcomeNegative,
+					Location: &finding.Location{
+						Type:      finding.FileTypeSource,
+						Path:      ".gitlab-ci.yml",
+						LineStart: &testLineStart,
+						Snippet:   &testSnippet,
+					},
+				},
+			},
+			result: scut.TestReturn{
+				Score:        0,
+				NumberOfWarn: 1,
+			},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
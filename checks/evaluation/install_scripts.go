@@ -0,0 +1,71 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	sce "github.com/ossf/scorecard/v4/errors"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/hasInstallScriptDownloads"
+	"github.com/ossf/scorecard/v4/probes/hasInstallScripts"
+)
+
+// installScriptsScore is the score of packages running code at install
+// time without downloading more code.
+const installScriptsScore = 5
+
+// InstallScripts applies the score policy for the Install-Scripts check.
+func InstallScripts(name string,
+	findings []finding.Finding, dl checker.DetailLogger,
+) checker.CheckResult {
+	expectedProbes := []string{
+		hasInstallScripts.Probe,
+		hasInstallScriptDownloads.Probe,
+	}
+
+	if !finding.UniqueProbesEqual(findings, expectedProbes) {
+		e := sce.WithMessage(sce.ErrScorecardInternal, "invalid probe results")
+		return checker.CreateRuntimeErrorResult(name, e)
+	}
+
+	checker.LogFindings(findings, dl)
+
+	var scripts, downloads int
+	for i := range findings {
+		f := &findings[i]
+		switch {
+		case f.Outcome == finding.OutcomeNotApplicable && f.Probe == hasInstallScripts.Probe:
+			return checker.CreateInconclusiveResult(name, "no package manifests detected")
+		case f.Outcome != finding.OutcomeNegative:
+		case f.Probe == hasInstallScripts.Probe:
+			scripts++
+		case f.Probe == hasInstallScriptDownloads.Probe:
+			downloads++
+		}
+	}
+
+	switch {
+	case downloads > 0:
+		return checker.CreateMinScoreResult(name,
+			fmt.Sprintf("%d unpinned downloads run at install time", downloads))
+	case scripts > 0:
+		return checker.CreateResultWithScore(name,
+			fmt.Sprintf("%d scripts run at install time", scripts), installScriptsScore)
+	default:
+		return checker.CreateMaxScoreResult(name, "packages do not run code at install time")
+	}
+}
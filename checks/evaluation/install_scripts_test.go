@@ -0,0 +1,100 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v4/checker"
+	sce "github.com/ossf/scorecard/v4/errors"
+	"github.com/ossf/scorecard/v4/finding"
+	scut "github.com/ossf/scorecard/v4/utests"
+)
+
+func TestInstallScripts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		findings []finding.Finding
+		result   scut.TestReturn
+	}{
+		{
+			name: "no package manifests",
+			findings: []finding.Finding{
+				{Probe: "hasInstallScripts", Outcome: finding.OutcomeNotApplicable},
+				{Probe: "hasInstallScriptDownloads", Outcome: finding.OutcomeNotApplicable},
+			},
+			result: scut.TestReturn{
+				Score:         checker.InconclusiveResultScore,
+				NumberOfDebug: 2,
+			},
+		},
+		{
+			name: "no install scripts",
+			findings: []finding.Finding{
+				{Probe: "hasInstallScripts", Outcome: finding.OutcomePositive},
+				{Probe: "hasInstallScriptDownloads", Outcome: finding.OutcomeNotApplicable},
+			},
+			result: scut.TestReturn{
+				Score:         checker.MaxResultScore,
+				NumberOfInfo:  1,
+				NumberOfDebug: 1,
+			},
+		},
+		{
+			name: "install scripts without downloads",
+			findings: []finding.Finding{
+				{Probe: "hasInstallScripts", Outcome: finding.OutcomeNegative},
+				{Probe: "hasInstallScripts", Outcome: finding.OutcomeNegative},
+				{Probe: "hasInstallScriptDownloads", Outcome: finding.OutcomePositive},
+			},
+			result: scut.TestReturn{
+				Score:        installScriptsScore,
+				NumberOfWarn: 2,
+				NumberOfInfo: 1,
+			},
+		},
+		{
+			name: "install scripts with downloads",
+			findings: []finding.Finding{
+				{Probe: "hasInstallScripts", Outcome: finding.OutcomeNegative},
+				{Probe: "hasInstallScriptDownloads", Outcome: finding.OutcomeNegative},
+			},
+			result: scut.TestReturn{
+				Score:        checker.MinResultScore,
+				NumberOfWarn: 2,
+			},
+		},
+		{
+			name: "missing probes",
+			findings: []finding.Finding{
+				{Probe: "hasInstallScripts", Outcome: finding.OutcomePositive},
+			},
+			result: scut.TestReturn{
+				Score: checker.InconclusiveResultScore,
+				Error: sce.ErrScorecardInternal,
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dl := scut.TestDetailLogger{}
+			got := InstallScripts(tt.name, tt.findings, &dl)
+			scut.ValidateTestReturn(t, tt.name, &tt.result, &got, &dl)
+		})
+	}
+}
@@ -22,13 +22,14 @@ import (
 	sce "github.com/ossf/scorecard/v4/errors"
 	"github.com/ossf/scorecard/v4/finding"
 	"github.com/ossf/scorecard/v4/probes/hasRecentCommits"
+	"github.com/ossf/scorecard/v4/probes/hasRecentReleases"
 	"github.com/ossf/scorecard/v4/probes/issueActivityByProjectMember"
 	"github.com/ossf/scorecard/v4/probes/notArchived"
 	"github.com/ossf/scorecard/v4/probes/notCreatedRecently"
+	"github.com/ossf/scorecard/v4/probes/respondsToIssues"
 )
 
 const (
-	lookBackDays    = 90
 	activityPerWeek = 1
 	daysInOneWeek   = 7
 )
@@ -37,12 +38,14 @@ const (
 func Maintained(name string,
 	findings []finding.Finding, dl checker.DetailLogger,
 ) checker.CheckResult {
-	// We have 4 unique probes, each should have a finding.
+	// We have 6 unique probes, each should have a finding.
 	expectedProbes := []string{
 		notArchived.Probe,
 		issueActivityByProjectMember.Probe,
 		hasRecentCommits.Probe,
 		notCreatedRecently.Probe,
+		hasRecentReleases.Probe,
+		respondsToIssues.Probe,
 	}
 
 	if !finding.UniqueProbesEqual(findings, expectedProbes) {
@@ -55,15 +58,26 @@ func Maintained(name string,
 		return checker.CreateMinScoreResult(name, "project is archived")
 	}
 
-	if projectWasCreatedInLast90Days(findings) {
+	if days, created := projectWasCreatedRecently(findings); created {
 		checker.LogFindings(negativeFindings(findings), dl)
-		return checker.CreateMinScoreResult(name, "project was created in last 90 days. please review its contents carefully")
+		return checker.CreateMinScoreResult(name,
+			fmt.Sprintf("project was created in last %d days. please review its contents carefully", days))
 	}
 
-	var commitsWithinThreshold, numberOfIssuesUpdatedWithinThreshold int
+	lookBackDays := checker.DefaultLookBackDays
+	var commitsWithinThreshold, numberOfIssuesUpdatedWithinThreshold, releasesWithinThreshold int
+	var respondsToIssuesPromptly bool
 	var err error
 	for i := range findings {
 		f := &findings[i]
+		if f.Probe == hasRecentCommits.Probe {
+			if days, ok := f.Values[hasRecentCommits.LookbackDayKey]; ok {
+				lookBackDays, err = strconv.Atoi(days)
+				if err != nil {
+					return checker.CreateRuntimeErrorResult(name, sce.WithMessage(sce.ErrScorecardInternal, err.Error()))
+				}
+			}
+		}
 		if f.Outcome == finding.OutcomePositive {
 			switch f.Probe {
 			case issueActivityByProjectMember.Probe:
@@ -76,14 +90,32 @@ func Maintained(name string,
 				if err != nil {
 					return checker.CreateRuntimeErrorResult(name, sce.WithMessage(sce.ErrScorecardInternal, err.Error()))
 				}
+			case hasRecentReleases.Probe:
+				releasesWithinThreshold, err = strconv.Atoi(f.Values[hasRecentReleases.NumReleasesKey])
+				if err != nil {
+					return checker.CreateRuntimeErrorResult(name, sce.WithMessage(sce.ErrScorecardInternal, err.Error()))
+				}
+			case respondsToIssues.Probe:
+				respondsToIssuesPromptly = true
 			}
 		}
 	}
+	if lookBackDays <= 0 {
+		lookBackDays = checker.DefaultLookBackDays
+	}
 
-	return checker.CreateProportionalScoreResult(name, fmt.Sprintf(
-		"%d commit(s) and %d issue activity found in the last %d days",
-		commitsWithinThreshold, numberOfIssuesUpdatedWithinThreshold, lookBackDays),
-		commitsWithinThreshold+numberOfIssuesUpdatedWithinThreshold, activityPerWeek*lookBackDays/daysInOneWeek)
+	reason := fmt.Sprintf(
+		"%d commit(s), %d issue activity and %d release(s) found in the last %d days",
+		commitsWithinThreshold, numberOfIssuesUpdatedWithinThreshold, releasesWithinThreshold, lookBackDays)
+	activity := commitsWithinThreshold + numberOfIssuesUpdatedWithinThreshold + releasesWithinThreshold
+	score := checker.CreateProportionalScore(activity, activityPerWeek*lookBackDays/daysInOneWeek)
+	// Stable projects with few changes are still maintained if their
+	// maintainers respond to the issues users open.
+	if respondsToIssuesPromptly && score < checker.MaxResultScore/2 {
+		score = checker.MaxResultScore / 2
+		reason += ", and maintainers respond to issues promptly"
+	}
+	return checker.CreateResultWithScore(name, checker.NormalizeReason(reason, score), score)
 }
 
 func projectIsArchived(findings []finding.Finding) bool {
@@ -96,12 +128,18 @@ func projectIsArchived(findings []finding.Finding) bool {
 	return false
 }
 
-func projectWasCreatedInLast90Days(findings []finding.Finding) bool {
+// projectWasCreatedRecently returns whether the project was created within
+// the look back window, and the number of days of the window.
+func projectWasCreatedRecently(findings []finding.Finding) (int, bool) {
 	for i := range findings {
 		f := &findings[i]
 		if f.Outcome == finding.OutcomeNegative && f.Probe == notCreatedRecently.Probe {
-			return true
+			days, err := strconv.Atoi(f.Values[notCreatedRecently.LookbackDayKey])
+			if err != nil {
+				days = checker.DefaultLookBackDays
+			}
+			return days, true
 		}
 	}
-	return false
+	return 0, false
 }
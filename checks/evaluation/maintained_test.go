@@ -19,9 +19,11 @@ import (
 	sce "github.com/ossf/scorecard/v4/errors"
 	"github.com/ossf/scorecard/v4/finding"
 	"github.com/ossf/scorecard/v4/probes/hasRecentCommits"
+	"github.com/ossf/scorecard/v4/probes/hasRecentReleases"
 	"github.com/ossf/scorecard/v4/probes/issueActivityByProjectMember"
 	"github.com/ossf/scorecard/v4/probes/notArchived"
 	"github.com/ossf/scorecard/v4/probes/notCreatedRecently"
+	"github.com/ossf/scorecard/v4/probes/respondsToIssues"
 	scut "github.com/ossf/scorecard/v4/utests"
 )
 
@@ -53,6 +55,12 @@ func TestMaintained(t *testing.T) {
 				}, {
 					Probe:   notCreatedRecently.Probe,
 					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   hasRecentReleases.Probe,
+					Outcome: finding.OutcomeNegative,
+				}, {
+					Probe:   respondsToIssues.Probe,
+					Outcome: finding.OutcomeNotApplicable,
 				},
 			},
 			result: scut.TestReturn{
@@ -74,12 +82,114 @@ func TestMaintained(t *testing.T) {
 				}, {
 					Probe:   notCreatedRecently.Probe,
 					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   hasRecentReleases.Probe,
+					Outcome: finding.OutcomeNegative,
+				}, {
+					Probe:   respondsToIssues.Probe,
+					Outcome: finding.OutcomeNotApplicable,
 				},
 			},
 			result: scut.TestReturn{
 				Score: 0,
 			},
 		},
+		{
+			name: "Releases count as activity",
+			findings: []finding.Finding{
+				{
+					Probe:   hasRecentCommits.Probe,
+					Outcome: finding.OutcomePositive,
+					Values: map[string]string{
+						hasRecentCommits.NumCommitsKey:  "2",
+						hasRecentCommits.LookbackDayKey: "90",
+					},
+				}, {
+					Probe:   issueActivityByProjectMember.Probe,
+					Outcome: finding.OutcomeNegative,
+				}, {
+					Probe:   notArchived.Probe,
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   notCreatedRecently.Probe,
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   hasRecentReleases.Probe,
+					Outcome: finding.OutcomePositive,
+					Values: map[string]string{
+						hasRecentReleases.NumReleasesKey: "3",
+					},
+				}, {
+					Probe:   respondsToIssues.Probe,
+					Outcome: finding.OutcomeNotApplicable,
+				},
+			},
+			result: scut.TestReturn{
+				Score: 4,
+			},
+		},
+		{
+			name: "Prompt issue responses raise the score",
+			findings: []finding.Finding{
+				{
+					Probe:   hasRecentCommits.Probe,
+					Outcome: finding.OutcomeNegative,
+					Values: map[string]string{
+						hasRecentCommits.NumCommitsKey:  "0",
+						hasRecentCommits.LookbackDayKey: "90",
+					},
+				}, {
+					Probe:   issueActivityByProjectMember.Probe,
+					Outcome: finding.OutcomeNegative,
+				}, {
+					Probe:   notArchived.Probe,
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   notCreatedRecently.Probe,
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   hasRecentReleases.Probe,
+					Outcome: finding.OutcomeNegative,
+				}, {
+					Probe:   respondsToIssues.Probe,
+					Outcome: finding.OutcomePositive,
+				},
+			},
+			result: scut.TestReturn{
+				Score: 5,
+			},
+		},
+		{
+			name: "Configured look back window",
+			findings: []finding.Finding{
+				{
+					Probe:   hasRecentCommits.Probe,
+					Outcome: finding.OutcomePositive,
+					Values: map[string]string{
+						hasRecentCommits.NumCommitsKey:  "26",
+						hasRecentCommits.LookbackDayKey: "365",
+					},
+				}, {
+					Probe:   issueActivityByProjectMember.Probe,
+					Outcome: finding.OutcomeNegative,
+				}, {
+					Probe:   notArchived.Probe,
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   notCreatedRecently.Probe,
+					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   hasRecentReleases.Probe,
+					Outcome: finding.OutcomeNegative,
+				}, {
+					Probe:   respondsToIssues.Probe,
+					Outcome: finding.OutcomeNotApplicable,
+				},
+			},
+			result: scut.TestReturn{
+				Score: 5,
+			},
+		},
 		{
 			name: "Wrong probe name",
 			findings: []finding.Finding{
@@ -95,6 +205,12 @@ func TestMaintained(t *testing.T) {
 				}, {
 					Probe:   notCreatedRecently.Probe,
 					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   hasRecentReleases.Probe,
+					Outcome: finding.OutcomeNegative,
+				}, {
+					Probe:   respondsToIssues.Probe,
+					Outcome: finding.OutcomeNotApplicable,
 				},
 			},
 			result: scut.TestReturn{
@@ -117,11 +233,17 @@ func TestMaintained(t *testing.T) {
 				}, {
 					Probe:   notCreatedRecently.Probe,
 					Outcome: finding.OutcomePositive,
+				}, {
+					Probe:   hasRecentReleases.Probe,
+					Outcome: finding.OutcomeNegative,
+				}, {
+					Probe:   respondsToIssues.Probe,
+					Outcome: finding.OutcomeNotApplicable,
 				},
 			},
 			result: scut.TestReturn{
 				Score:        0,
-				NumberOfWarn: 3,
+				NumberOfWarn: 4,
 			},
 		},
 	}
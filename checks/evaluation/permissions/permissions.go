@@ -112,6 +112,7 @@ func TokenPermissions(name string, c *checker.CheckRequest, r *checker.TokenPerm
 	if err != nil {
 		return checker.CreateRuntimeErrorResult(name, err)
 	}
+	reportDefaultWorkflowPermissions(r, c.Dlogger)
 
 	if score != checker.MaxResultScore {
 		return checker.CreateResultWithScore(name,
@@ -175,6 +176,12 @@ func rawToFindings(results *checker.TokenPermissionsData) ([]finding.Finding, er
 				"LocationType":    string(locationType),
 				"PermissionType":  string(permType),
 			})
+			// Undeclared permissions are safe if the token is read-only by default.
+			if results.DefaultWorkflowPermissionsAreReadOnly() {
+				f = f.WithValue("DefaultPermissionLevel", string(permissionLevelRead))
+				f = f.WithMessage(f.Message + ", default GITHUB_TOKEN permissions are read-only")
+				f = f.WithOutcome(finding.OutcomePositive)
+			}
 		case checker.PermissionLevelWrite:
 			var locationType permissionLocationType
 			switch *r.LocationType {
@@ -305,6 +312,12 @@ func applyScorePolicy(findings []finding.Finding, c *checker.CheckRequest) (int,
 			})
 
 		case permissionLevelUndeclared:
+			if permissionLevel(f.Values["DefaultPermissionLevel"]) == permissionLevelRead {
+				dl.Info(&checker.LogMessage{
+					Finding: f,
+				})
+				continue
+			}
 			switch permissionLocationType(f.Values["LocationType"]) {
 			case permissionLocationNil:
 				return checker.InconclusiveResultScore,
@@ -358,6 +371,28 @@ func reportDefaultFindings(results []finding.Finding,
 	return nil
 }
 
+// reportDefaultWorkflowPermissions reports the GITHUB_TOKEN settings of the repository.
+// They do not affect the score beyond the undeclared permissions they make safe.
+func reportDefaultWorkflowPermissions(r *checker.TokenPermissionsData, dl checker.DetailLogger) {
+	perms := r.DefaultWorkflowPermissions()
+	if perms == nil {
+		return
+	}
+	msg := &checker.LogMessage{
+		Text: fmt.Sprintf("default GITHUB_TOKEN permissions set to '%s'", perms.DefaultPermissions),
+	}
+	if r.DefaultWorkflowPermissionsAreReadOnly() {
+		dl.Info(msg)
+	} else {
+		dl.Warn(msg)
+	}
+	if perms.CanApprovePullRequestReviews {
+		dl.Warn(&checker.LogMessage{
+			Text: "GitHub Actions are allowed to create and approve pull requests",
+		})
+	}
+}
+
 func reportFinding(probe, text string, o finding.Outcome, dl checker.DetailLogger) error {
 	content, err := probes.ReadFile(probe + ".yml")
 	if err != nil {
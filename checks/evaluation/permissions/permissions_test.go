@@ -0,0 +1,127 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+	scut "github.com/ossf/scorecard/v4/utests"
+)
+
+func TestTokenPermissionsDefaultWorkflowPermissions(t *testing.T) {
+	t.Parallel()
+	top := checker.PermissionLocationTop
+	job := checker.PermissionLocationJob
+	undeclared := []checker.TokenPermission{
+		{
+			LocationType: &top,
+			Type:         checker.PermissionLevelUndeclared,
+			File: &checker.File{
+				Path: ".github/workflows/ci.yml",
+				Type: finding.FileTypeSource,
+			},
+		},
+		{
+			LocationType: &job,
+			Type:         checker.PermissionLevelUndeclared,
+			File: &checker.File{
+				Path:   ".github/workflows/ci.yml",
+				Type:   finding.FileTypeSource,
+				Offset: 10,
+			},
+		},
+	}
+	tests := []struct {
+		name     string
+		raw      checker.TokenPermissionsData
+		expected scut.TestReturn
+	}{
+		{
+			name: "undeclared permissions with unknown default",
+			raw: checker.TokenPermissionsData{
+				NumTokens:        1,
+				TokenPermissions: undeclared,
+			},
+			expected: scut.TestReturn{
+				Score:         checker.MinResultScore,
+				NumberOfWarn:  1,
+				NumberOfInfo:  1,
+				NumberOfDebug: 1,
+			},
+		},
+		{
+			name: "undeclared permissions with read-only repository default",
+			raw: checker.TokenPermissionsData{
+				NumTokens:        1,
+				TokenPermissions: undeclared,
+				RepoWorkflowPermissions: &clients.WorkflowPermissions{
+					DefaultPermissions: "read",
+				},
+				OrgWorkflowPermissions: &clients.WorkflowPermissions{
+					DefaultPermissions: "write",
+				},
+			},
+			expected: scut.TestReturn{
+				Score:        checker.MaxResultScore,
+				NumberOfInfo: 4,
+			},
+		},
+		{
+			name: "undeclared permissions with read-only organization default",
+			raw: checker.TokenPermissionsData{
+				NumTokens:        1,
+				TokenPermissions: undeclared,
+				OrgWorkflowPermissions: &clients.WorkflowPermissions{
+					DefaultPermissions:           "read",
+					CanApprovePullRequestReviews: true,
+				},
+			},
+			expected: scut.TestReturn{
+				Score:        checker.MaxResultScore,
+				NumberOfInfo: 4,
+				NumberOfWarn: 1,
+			},
+		},
+		{
+			name: "undeclared permissions with write default",
+			raw: checker.TokenPermissionsData{
+				NumTokens:        1,
+				TokenPermissions: undeclared,
+				RepoWorkflowPermissions: &clients.WorkflowPermissions{
+					DefaultPermissions: "write",
+				},
+			},
+			expected: scut.TestReturn{
+				Score:         checker.MinResultScore,
+				NumberOfWarn:  2,
+				NumberOfInfo:  1,
+				NumberOfDebug: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dl := scut.TestDetailLogger{}
+			req := checker.CheckRequest{Dlogger: &dl}
+			res := TokenPermissions("Token-Permissions", &req, &tt.raw)
+			scut.ValidateTestReturn(t, tt.name, &tt.expected, &res, &dl)
+		})
+	}
+}
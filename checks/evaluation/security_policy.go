@@ -18,6 +18,8 @@ import (
 	"github.com/ossf/scorecard/v4/checker"
 	sce "github.com/ossf/scorecard/v4/errors"
 	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/privateVulnerabilityReportingEnabled"
+	"github.com/ossf/scorecard/v4/probes/publishesSecurityAdvisories"
 	"github.com/ossf/scorecard/v4/probes/securityPolicyContainsLinks"
 	"github.com/ossf/scorecard/v4/probes/securityPolicyContainsText"
 	"github.com/ossf/scorecard/v4/probes/securityPolicyContainsVulnerabilityDisclosure"
@@ -33,12 +35,19 @@ func SecurityPolicy(name string, findings []finding.Finding, dl checker.DetailLo
 		securityPolicyContainsText.Probe,
 		securityPolicyPresent.Probe,
 	}
-	if !finding.UniqueProbesEqual(findings, expectedProbes) {
+	// The disclosure channel probes are only run in experimental mode.
+	disclosureProbes := []string{
+		privateVulnerabilityReportingEnabled.Probe,
+		publishesSecurityAdvisories.Probe,
+	}
+	if !finding.UniqueProbesEqual(findings, expectedProbes) &&
+		!finding.UniqueProbesEqual(findings, append(expectedProbes, disclosureProbes...)) {
 		e := sce.WithMessage(sce.ErrScorecardInternal, "invalid probe results")
 		return checker.CreateRuntimeErrorResult(name, e)
 	}
 
 	score := 0
+	hasChannel := false
 	m := make(map[string]bool)
 	for i := range findings {
 		f := &findings[i]
@@ -52,6 +61,11 @@ func SecurityPolicy(name string, findings []finding.Finding, dl checker.DetailLo
 				score += scoreProbeOnce(f.Probe, m, 3)
 			case securityPolicyPresent.Probe:
 				m[f.Probe] = true
+			// A private reporting channel or past advisories stand in for the
+			// contact links of the policy file.
+			case privateVulnerabilityReportingEnabled.Probe, publishesSecurityAdvisories.Probe:
+				hasChannel = true
+				score += scoreProbeOnce(securityPolicyContainsLinks.Probe, m, 6)
 			default:
 				e := sce.WithMessage(sce.ErrScorecardInternal, "unknown probe results")
 				return checker.CreateRuntimeErrorResult(name, e)
@@ -59,6 +73,11 @@ func SecurityPolicy(name string, findings []finding.Finding, dl checker.DetailLo
 		}
 	}
 	_, defined := m[securityPolicyPresent.Probe]
+	if !defined && hasChannel {
+		checker.LogFindings(findings, dl)
+		return checker.CreateResultWithScore(name,
+			"security policy file not detected, but vulnerabilities can be disclosed privately", score)
+	}
 	if !defined {
 		if score > 0 {
 			e := sce.WithMessage(sce.ErrScorecardInternal, "score calculation problem")
@@ -182,6 +182,73 @@ func TestSecurityPolicy(t *testing.T) {
 				NumberOfInfo: 4,
 			},
 		},
+		{
+			name: "file not found with private vulnerability reporting",
+			findings: []finding.Finding{
+				{
+					Probe:   "securityPolicyContainsVulnerabilityDisclosure",
+					Outcome: finding.OutcomeNegative,
+				},
+				{
+					Probe:   "securityPolicyContainsLinks",
+					Outcome: finding.OutcomeNegative,
+				},
+				{
+					Probe:   "securityPolicyContainsText",
+					Outcome: finding.OutcomeNegative,
+				},
+				{
+					Probe:   "securityPolicyPresent",
+					Outcome: finding.OutcomeNegative,
+				},
+				{
+					Probe:   "privateVulnerabilityReportingEnabled",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "publishesSecurityAdvisories",
+					Outcome: finding.OutcomeNegative,
+				},
+			},
+			result: scut.TestReturn{
+				Score:        6,
+				NumberOfInfo: 1,
+				NumberOfWarn: 5,
+			},
+		},
+		{
+			name: "file with links and published advisories",
+			findings: []finding.Finding{
+				{
+					Probe:   "securityPolicyContainsVulnerabilityDisclosure",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "securityPolicyContainsLinks",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "securityPolicyContainsText",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "securityPolicyPresent",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "privateVulnerabilityReportingEnabled",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "publishesSecurityAdvisories",
+					Outcome: finding.OutcomePositive,
+				},
+			},
+			result: scut.TestReturn{
+				Score:        checker.MaxResultScore,
+				NumberOfInfo: 6,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,69 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	sce "github.com/ossf/scorecard/v4/errors"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/codeScanningEnabled"
+	"github.com/ossf/scorecard/v4/probes/dependencyAlertsEnabled"
+	"github.com/ossf/scorecard/v4/probes/dependencySecurityUpdatesEnabled"
+	"github.com/ossf/scorecard/v4/probes/secretPushProtectionEnabled"
+	"github.com/ossf/scorecard/v4/probes/secretScanningEnabled"
+)
+
+// SecuritySettings applies the score policy for the Security-Settings check.
+func SecuritySettings(name string,
+	findings []finding.Finding, dl checker.DetailLogger,
+) checker.CheckResult {
+	expectedProbes := []string{
+		secretScanningEnabled.Probe,
+		secretPushProtectionEnabled.Probe,
+		dependencyAlertsEnabled.Probe,
+		dependencySecurityUpdatesEnabled.Probe,
+		codeScanningEnabled.Probe,
+	}
+
+	if !finding.UniqueProbesEqual(findings, expectedProbes) {
+		e := sce.WithMessage(sce.ErrScorecardInternal, "invalid probe results")
+		return checker.CreateRuntimeErrorResult(name, e)
+	}
+
+	checker.LogFindings(findings, dl)
+
+	// Settings which cannot be read are left out of the score,
+	// so that a token without admin scope does not lower it.
+	var enabled, known int
+	for i := range findings {
+		switch findings[i].Outcome {
+		case finding.OutcomePositive:
+			enabled++
+			known++
+		case finding.OutcomeNegative:
+			known++
+		default:
+		}
+	}
+
+	if known == 0 {
+		return checker.CreateInconclusiveResult(name, "could not read the security settings of the repository")
+	}
+
+	msg := fmt.Sprintf("%d out of %d readable security settings are enabled", enabled, known)
+	return checker.CreateProportionalScoreResult(name, msg, enabled, known)
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v4/checker"
+	sce "github.com/ossf/scorecard/v4/errors"
+	"github.com/ossf/scorecard/v4/finding"
+	scut "github.com/ossf/scorecard/v4/utests"
+)
+
+func securitySettingsFindings(outcomes ...finding.Outcome) []finding.Finding {
+	probes := []string{
+		"secretScanningEnabled",
+		"secretPushProtectionEnabled",
+		"dependencyAlertsEnabled",
+		"dependencySecurityUpdatesEnabled",
+		"codeScanningEnabled",
+	}
+	findings := make([]finding.Finding, 0, len(probes))
+	for i, probe := range probes {
+		findings = append(findings, finding.Finding{
+			Probe:   probe,
+			Outcome: outcomes[i],
+		})
+	}
+	return findings
+}
+
+func TestSecuritySettings(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		findings []finding.Finding
+		result   scut.TestReturn
+	}{
+		{
+			name: "all settings enabled",
+			findings: securitySettingsFindings(
+				finding.OutcomePositive, finding.OutcomePositive, finding.OutcomePositive,
+				finding.OutcomePositive, finding.OutcomePositive),
+			result: scut.TestReturn{
+				Score:        checker.MaxResultScore,
+				NumberOfInfo: 5,
+			},
+		},
+		{
+			name: "all settings disabled",
+			findings: securitySettingsFindings(
+				finding.OutcomeNegative, finding.OutcomeNegative, finding.OutcomeNegative,
+				finding.OutcomeNegative, finding.OutcomeNegative),
+			result: scut.TestReturn{
+				Score:        checker.MinResultScore,
+				NumberOfWarn: 5,
+			},
+		},
+		{
+			name: "unreadable settings are not scored",
+			findings: securitySettingsFindings(
+				finding.OutcomePositive, finding.OutcomeNotAvailable, finding.OutcomeNegative,
+				finding.OutcomeNotAvailable, finding.OutcomeNotAvailable),
+			result: scut.TestReturn{
+				Score:         5,
+				NumberOfInfo:  1,
+				NumberOfWarn:  1,
+				NumberOfDebug: 3,
+			},
+		},
+		{
+			name: "no readable settings",
+			findings: securitySettingsFindings(
+				finding.OutcomeNotAvailable, finding.OutcomeNotAvailable, finding.OutcomeNotAvailable,
+				finding.OutcomeNotAvailable, finding.OutcomeNotAvailable),
+			result: scut.TestReturn{
+				Score:         checker.InconclusiveResultScore,
+				NumberOfDebug: 5,
+			},
+		},
+		{
+			name: "missing probes",
+			findings: []finding.Finding{
+				{
+					Probe:   "secretScanningEnabled",
+					Outcome: finding.OutcomePositive,
+				},
+			},
+			result: scut.TestReturn{
+				Score: checker.InconclusiveResultScore,
+				Error: sce.ErrScorecardInternal,
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dl := scut.TestDetailLogger{}
+			got := SecuritySettings(tt.name, tt.findings, &dl)
+			scut.ValidateTestReturn(t, tt.name, &tt.result, &got, &dl)
+		})
+	}
+}
@@ -20,7 +20,9 @@ import (
 	"github.com/ossf/scorecard/v4/checker"
 	sce "github.com/ossf/scorecard/v4/errors"
 	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/webhooksHaveMinimalEvents"
 	"github.com/ossf/scorecard/v4/probes/webhooksUseSecrets"
+	"github.com/ossf/scorecard/v4/probes/webhooksVerifyTLS"
 )
 
 // Webhooks applies the score policy for the Webhooks check.
@@ -29,6 +31,8 @@ func Webhooks(name string,
 ) checker.CheckResult {
 	expectedProbes := []string{
 		webhooksUseSecrets.Probe,
+		webhooksVerifyTLS.Probe,
+		webhooksHaveMinimalEvents.Probe,
 	}
 
 	if !finding.UniqueProbesEqual(findings, expectedProbes) {
@@ -36,21 +40,38 @@ func Webhooks(name string,
 		return checker.CreateRuntimeErrorResult(name, e)
 	}
 
-	if len(findings) == 1 && findings[0].Outcome == finding.OutcomeNotApplicable {
-		return checker.CreateMaxScoreResult(name, "project does not have webhook")
-	}
-
-	var webhooksWithNoSecret int
-
-	totalWebhooks := len(findings)
+	var webhooksWithNoSecret, totalWebhooks int
 
 	for i := range findings {
 		f := &findings[i]
-		if f.Outcome == finding.OutcomeNegative {
+		if f.Outcome == finding.OutcomeNotApplicable {
+			return checker.CreateMaxScoreResult(name, "project does not have webhook")
+		}
+		if f.Probe != webhooksUseSecrets.Probe {
+			// The TLS verification and the events of the webhooks
+			// are reported but do not affect the score.
+			if f.Outcome == finding.OutcomeNegative {
+				dl.Warn(&checker.LogMessage{
+					Finding: f,
+				})
+			}
+			continue
+		}
+		switch f.Outcome {
+		case finding.OutcomeNegative:
 			webhooksWithNoSecret++
+			totalWebhooks++
+		case finding.OutcomePositive:
+			totalWebhooks++
+		default:
+			// Whether the webhook uses a secret is unknown.
 		}
 	}
 
+	if totalWebhooks == 0 {
+		return checker.CreateInconclusiveResult(name, "could not determine whether the webhooks have a secret configured")
+	}
+
 	if totalWebhooks == webhooksWithNoSecret {
 		return checker.CreateMinScoreResult(name, "no hook(s) have a secret configured")
 	}
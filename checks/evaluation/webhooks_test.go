@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/ossf/scorecard/v4/checker"
+	sce "github.com/ossf/scorecard/v4/errors"
 	"github.com/ossf/scorecard/v4/finding"
 	scut "github.com/ossf/scorecard/v4/utests"
 )
@@ -37,6 +38,14 @@ func TestWebhooks(t *testing.T) {
 					Probe:   "webhooksUseSecrets",
 					Outcome: finding.OutcomeNotApplicable,
 				},
+				{
+					Probe:   "webhooksVerifyTLS",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "webhooksHaveMinimalEvents",
+					Outcome: finding.OutcomeNotApplicable,
+				},
 			},
 			result: scut.TestReturn{
 				Score: checker.MaxResultScore,
@@ -49,6 +58,14 @@ func TestWebhooks(t *testing.T) {
 					Probe:   "webhooksUseSecrets",
 					Outcome: finding.OutcomeNegative,
 				},
+				{
+					Probe:   "webhooksVerifyTLS",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "webhooksHaveMinimalEvents",
+					Outcome: finding.OutcomePositive,
+				},
 			},
 			result: scut.TestReturn{
 				Score: checker.MinResultScore,
@@ -61,11 +78,63 @@ func TestWebhooks(t *testing.T) {
 					Probe:   "webhooksUseSecrets",
 					Outcome: finding.OutcomePositive,
 				},
+				{
+					Probe:   "webhooksVerifyTLS",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "webhooksHaveMinimalEvents",
+					Outcome: finding.OutcomePositive,
+				},
 			},
 			result: scut.TestReturn{
 				Score: checker.MaxResultScore,
 			},
 		},
+		{
+			name: "1 webhook with unknown secret",
+			findings: []finding.Finding{
+				{
+					Probe:   "webhooksUseSecrets",
+					Outcome: finding.OutcomeNotAvailable,
+				},
+				{
+					Probe:   "webhooksVerifyTLS",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "webhooksHaveMinimalEvents",
+					Outcome: finding.OutcomePositive,
+				},
+			},
+			result: scut.TestReturn{
+				Score: checker.InconclusiveResultScore,
+			},
+		},
+		{
+			name: "2 webhooks one of which has unknown secret",
+			findings: []finding.Finding{
+				{
+					Probe:   "webhooksUseSecrets",
+					Outcome: finding.OutcomeNotAvailable,
+				},
+				{
+					Probe:   "webhooksUseSecrets",
+					Outcome: finding.OutcomeNegative,
+				},
+				{
+					Probe:   "webhooksVerifyTLS",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "webhooksHaveMinimalEvents",
+					Outcome: finding.OutcomePositive,
+				},
+			},
+			result: scut.TestReturn{
+				Score: checker.MinResultScore,
+			},
+		},
 		{
 			name: "2 webhooks one of which has secret",
 			findings: []finding.Finding{
@@ -77,6 +146,14 @@ func TestWebhooks(t *testing.T) {
 					Probe:   "webhooksUseSecrets",
 					Outcome: finding.OutcomePositive,
 				},
+				{
+					Probe:   "webhooksVerifyTLS",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "webhooksHaveMinimalEvents",
+					Outcome: finding.OutcomePositive,
+				},
 			},
 			result: scut.TestReturn{
 				Score: 5,
@@ -105,6 +182,14 @@ func TestWebhooks(t *testing.T) {
 					Probe:   "webhooksUseSecrets",
 					Outcome: finding.OutcomeNegative,
 				},
+				{
+					Probe:   "webhooksVerifyTLS",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "webhooksHaveMinimalEvents",
+					Outcome: finding.OutcomePositive,
+				},
 			},
 			result: scut.TestReturn{
 				Score: 6,
@@ -161,6 +246,14 @@ func TestWebhooks(t *testing.T) {
 					Probe:   "webhooksUseSecrets",
 					Outcome: finding.OutcomePositive,
 				},
+				{
+					Probe:   "webhooksVerifyTLS",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "webhooksHaveMinimalEvents",
+					Outcome: finding.OutcomePositive,
+				},
 			},
 			result: scut.TestReturn{
 				Score: 9,
@@ -217,11 +310,53 @@ func TestWebhooks(t *testing.T) {
 					Probe:   "webhooksUseSecrets",
 					Outcome: finding.OutcomeNegative,
 				},
+				{
+					Probe:   "webhooksVerifyTLS",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "webhooksHaveMinimalEvents",
+					Outcome: finding.OutcomePositive,
+				},
 			},
 			result: scut.TestReturn{
 				Score: checker.MinResultScore,
 			},
 		},
+		{
+			name: "1 webhook with secret and TLS verification disabled",
+			findings: []finding.Finding{
+				{
+					Probe:   "webhooksUseSecrets",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "webhooksVerifyTLS",
+					Outcome: finding.OutcomeNegative,
+				},
+				{
+					Probe:   "webhooksHaveMinimalEvents",
+					Outcome: finding.OutcomeNegative,
+				},
+			},
+			result: scut.TestReturn{
+				Score:        checker.MaxResultScore,
+				NumberOfWarn: 2,
+			},
+		},
+		{
+			name: "missing probe",
+			findings: []finding.Finding{
+				{
+					Probe:   "webhooksUseSecrets",
+					Outcome: finding.OutcomePositive,
+				},
+			},
+			result: scut.TestReturn{
+				Score: checker.InconclusiveResultScore,
+				Error: sce.ErrScorecardInternal,
+			},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
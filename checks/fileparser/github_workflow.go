@@ -19,6 +19,7 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/rhysd/actionlint"
@@ -36,6 +37,7 @@ const (
 	windows             = "windows"
 	os                  = "os"
 	matrixos            = "matrix.os"
+	selfHosted          = "self-hosted"
 )
 
 // GetJobName returns Name.Value if non-nil, else returns "".
@@ -156,6 +158,47 @@ func getJobStrategyMatrixIncludeCombinations(job *actionlint.Job) []*actionlint.
 	return nil
 }
 
+// githubHostedRunnerPrefixes are the prefixes of the labels of GitHub-hosted
+// runners, larger runners included, e.g. ubuntu-latest, windows-2022 or macos-14-xlarge.
+var githubHostedRunnerPrefixes = []string{"ubuntu-", "windows-", "macos-"}
+
+// GetSelfHostedRunnerLabels returns the runner labels of a job that runs on
+// self-hosted runners, or nil if the job runs on GitHub-hosted runners.
+// Jobs running in a runner group or with a label of no GitHub-hosted runner
+// are self-hosted. Labels left as expressions are ignored.
+func GetSelfHostedRunnerLabels(job *actionlint.Job) []string {
+	labels, err := GetOSesForJob(job)
+	if err != nil {
+		// The matrix could not be resolved, fall back to the raw 'runs-on' values.
+		labels = nil
+		for _, label := range getJobRunsOnLabels(job) {
+			labels = append(labels, label.Value)
+		}
+	}
+	if job != nil && job.RunsOn != nil && job.RunsOn.Group != nil {
+		return append(labels, "group: "+job.RunsOn.Group.Value)
+	}
+	for _, label := range labels {
+		if isSelfHostedRunnerLabel(label) {
+			return labels
+		}
+	}
+	return nil
+}
+
+func isSelfHostedRunnerLabel(label string) bool {
+	label = strings.ToLower(strings.TrimSpace(label))
+	if label == "" || strings.Contains(label, "${{") {
+		return false
+	}
+	for _, prefix := range githubHostedRunnerPrefixes {
+		if strings.HasPrefix(label, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
 // FormatActionlintError combines the errors into a single one.
 func FormatActionlintError(errs []*actionlint.Error) error {
 	if len(errs) == 0 {
@@ -331,6 +374,8 @@ type JobMatcher struct {
 	LogText string
 	// Each step in this field has a matching step in the job.
 	Steps []*JobMatcherStep
+	// The ecosystem a matching packaging job publishes to, if known.
+	Ecosystem checker.PackageEcosystem
 }
 
 // JobMatcherStep is a single step that needs to be matched.
@@ -345,8 +390,17 @@ type JobMatcherStep struct {
 
 // JobMatchResult represents the result of a match.
 type JobMatchResult struct {
-	Msg  string
-	File checker.File
+	Msg       string
+	File      checker.File
+	Ecosystem checker.PackageEcosystem
+	// JobID is the key of the job in the workflow.
+	JobID string
+	// Environment is the deployment environment of the job, if any.
+	Environment string
+	// Credentials and IDTokenWrite are only set for packaging jobs,
+	// see PublishCredentials and GrantsIDToken.
+	Credentials  []checker.PublishCredential
+	IDTokenWrite bool
 }
 
 // AnyJobsMatch returns true if any of the jobs have a match in the given workflow.
@@ -358,15 +412,7 @@ func AnyJobsMatch(workflow *actionlint.Workflow, jobMatchers []JobMatcher, fp st
 			if !matcher.matches(job) {
 				continue
 			}
-
-			return JobMatchResult{
-				File: checker.File{
-					Path:   fp,
-					Type:   finding.FileTypeSource,
-					Offset: GetLineNumber(job.Pos),
-				},
-				Msg: fmt.Sprintf("%v: %v", matcher.LogText, fp),
-			}, true
+			return matcher.result(job, fp), true
 		}
 	}
 
@@ -380,6 +426,55 @@ func AnyJobsMatch(workflow *actionlint.Workflow, jobMatchers []JobMatcher, fp st
 	}, false
 }
 
+// AllJobsMatch returns a result for each job that has a match in the given workflow.
+func AllJobsMatch(workflow *actionlint.Workflow, jobMatchers []JobMatcher, fp string) []JobMatchResult {
+	var results []JobMatchResult
+	for _, job := range workflow.Jobs {
+		for _, matcher := range jobMatchers {
+			if !matcher.matches(job) {
+				continue
+			}
+			results = append(results, matcher.result(job, fp))
+			break
+		}
+	}
+	// Jobs are stored in a map, so sort them for stable results.
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].File.Offset < results[j].File.Offset
+	})
+	return results
+}
+
+func (m *JobMatcher) result(job *actionlint.Job, fp string) JobMatchResult {
+	return JobMatchResult{
+		File: checker.File{
+			Path:   fp,
+			Type:   finding.FileTypeSource,
+			Offset: GetLineNumber(job.Pos),
+		},
+		Msg:         fmt.Sprintf("%v: %v", m.LogText, fp),
+		Ecosystem:   m.Ecosystem,
+		JobID:       jobID(job),
+		Environment: jobEnvironment(job),
+	}
+}
+
+// jobID returns the key of the job in the workflow.
+func jobID(job *actionlint.Job) string {
+	if job.ID == nil {
+		return ""
+	}
+	return job.ID.Value
+}
+
+// jobEnvironment returns the name of the deployment environment of the job.
+func jobEnvironment(job *actionlint.Job) string {
+	if job.Environment == nil || job.Environment.Name == nil {
+		return ""
+	}
+	return job.Environment.Name.Value
+}
+
 // matches returns true if the job matches the job matcher.
 func (m *JobMatcher) matches(job *actionlint.Job) bool {
 	for _, stepToMatch := range m.Steps {
@@ -451,142 +546,254 @@ func stepsMatch(stepToMatch *JobMatcherStep, step *actionlint.Step) bool {
 
 // IsPackagingWorkflow checks for a packaging workflow.
 func IsPackagingWorkflow(workflow *actionlint.Workflow, fp string) (JobMatchResult, bool) {
-	jobMatchers := []JobMatcher{
-		{
-			Steps: []*JobMatcherStep{
-				{
-					Uses: "actions/setup-node",
-					With: map[string]string{"registry-url": "https://registry.npmjs.org"},
-				},
-				{
-					Run: "npm.*publish",
-				},
+	return AnyJobsMatch(workflow, packagingJobMatchers, fp, "not a publishing workflow")
+}
+
+// PackagingJobs returns the jobs of a workflow that publish packages.
+func PackagingJobs(workflow *actionlint.Workflow, fp string) []JobMatchResult {
+	results := AllJobsMatch(workflow, packagingJobMatchers, fp)
+	for i := range results {
+		for _, job := range workflow.Jobs {
+			if jobID(job) != results[i].JobID {
+				continue
+			}
+			results[i].Credentials = PublishCredentials(workflow, job, fp)
+			results[i].IDTokenWrite = GrantsIDToken(workflow, job)
+		}
+	}
+	return results
+}
+
+var packagingJobMatchers = []JobMatcher{
+	{
+		Steps: []*JobMatcherStep{
+			{
+				Uses: "actions/setup-node",
+				With: map[string]string{"registry-url": "https://registry.npmjs.org"},
+			},
+			{
+				Run: "(npm|yarn|pnpm).*publish",
 			},
-			LogText: "candidate node publishing workflow using npm",
 		},
-		{
-			// Java packages with maven.
-			Steps: []*JobMatcherStep{
-				{
-					Uses: "actions/setup-java",
-				},
-				{
-					Run: "mvn.*deploy",
-				},
+		LogText:   "candidate node publishing workflow using npm",
+		Ecosystem: checker.PackageEcosystemNpm,
+	},
+	{
+		// https://github.com/JS-DevTools/npm-publish
+		Steps: []*JobMatcherStep{
+			{
+				Uses: "JS-DevTools/npm-publish",
 			},
-			LogText: "candidate java publishing workflow using maven",
 		},
-		{
-			// Java packages with gradle.
-			Steps: []*JobMatcherStep{
-				{
-					Uses: "actions/setup-java",
-				},
-				{
-					Run: "gradle.*publish",
-				},
+		LogText:   "candidate node publishing workflow using npm-publish",
+		Ecosystem: checker.PackageEcosystemNpm,
+	},
+	{
+		// Container images built by maven or gradle with jib.
+		Steps: []*JobMatcherStep{
+			{
+				Run: "(mvn|gradle).*jib",
 			},
-			LogText: "candidate java publishing workflow using gradle",
 		},
-		{
-			// Ruby packages.
-			Steps: []*JobMatcherStep{
-				{
-					Run: "gem.*push",
-				},
+		LogText:   "candidate container publishing workflow using jib",
+		Ecosystem: checker.PackageEcosystemContainer,
+	},
+	{
+		// Java packages with maven.
+		Steps: []*JobMatcherStep{
+			{
+				Uses: "actions/setup-java",
+			},
+			{
+				Run: "mvn.*deploy",
 			},
-			LogText: "candidate ruby publishing workflow using gem",
 		},
-		{
-			// NuGet packages.
-			Steps: []*JobMatcherStep{
-				{
-					Run: "nuget.*push",
-				},
+		LogText:   "candidate java publishing workflow using maven",
+		Ecosystem: checker.PackageEcosystemMaven,
+	},
+	{
+		// Java packages with gradle, e.g., the maven-publish, nexus-publish
+		// and vanniktech maven.publish plugins.
+		Steps: []*JobMatcherStep{
+			{
+				Uses: "actions/setup-java",
+			},
+			{
+				Run: "gradle.*publish",
 			},
-			LogText: "candidate nuget publishing workflow",
 		},
-		{
-			// Docker packages.
-			Steps: []*JobMatcherStep{
-				{
-					Run: "docker.*push",
-				},
+		LogText:   "candidate java publishing workflow using gradle",
+		Ecosystem: checker.PackageEcosystemMaven,
+	},
+	{
+		// https://github.com/samuelmeuli/action-maven-publish
+		Steps: []*JobMatcherStep{
+			{
+				Uses: "samuelmeuli/action-maven-publish",
 			},
-			LogText: "candidate docker publishing workflow",
 		},
-		{
-			// Docker packages.
-			Steps: []*JobMatcherStep{
-				{
-					Uses: "docker/build-push-action",
-				},
+		LogText:   "candidate java publishing workflow using action-maven-publish",
+		Ecosystem: checker.PackageEcosystemMaven,
+	},
+	{
+		// Ruby packages.
+		Steps: []*JobMatcherStep{
+			{
+				Run: "gem.*push",
 			},
-			LogText: "candidate docker publishing workflow",
 		},
-		{
-			// Python packages.
-			Steps: []*JobMatcherStep{
-				{
-					Uses: "pypa/gh-action-pypi-publish",
-				},
+		LogText:   "candidate ruby publishing workflow using gem",
+		Ecosystem: checker.PackageEcosystemRubyGems,
+	},
+	{
+		// NuGet packages.
+		Steps: []*JobMatcherStep{
+			{
+				Run: "nuget.*push",
 			},
-			LogText: "candidate python publishing workflow using pypi",
 		},
-		{
-			// Python packages.
-			// This is a custom Python packaging workflow based on semantic versioning.
-			// TODO(#1642): accept custom workflows through a separate configuration.
-			Steps: []*JobMatcherStep{
-				{
-					Uses: "relekang/python-semantic-release",
-				},
+		LogText:   "candidate nuget publishing workflow",
+		Ecosystem: checker.PackageEcosystemNuGet,
+	},
+	{
+		// Docker packages.
+		Steps: []*JobMatcherStep{
+			{
+				Run: "docker.*push",
 			},
-			LogText: "candidate python publishing workflow using python-semantic-release",
 		},
-		{
-			// Go packages.
-			Steps: []*JobMatcherStep{
-				{
-					Uses: "actions/setup-go",
-				},
-				{
-					Uses: "goreleaser/goreleaser-action",
-				},
+		LogText:   "candidate docker publishing workflow",
+		Ecosystem: checker.PackageEcosystemContainer,
+	},
+	{
+		// Docker packages.
+		Steps: []*JobMatcherStep{
+			{
+				Uses: "docker/build-push-action",
 			},
-			LogText: "candidate golang publishing workflow",
 		},
-		{
-			// Rust packages. https://doc.rust-lang.org/cargo/reference/publishing.html
-			Steps: []*JobMatcherStep{
-				{
-					Run: "cargo.*publish",
-				},
+		LogText:   "candidate docker publishing workflow",
+		Ecosystem: checker.PackageEcosystemContainer,
+	},
+	{
+		// Python packages.
+		Steps: []*JobMatcherStep{
+			{
+				Uses: "pypa/gh-action-pypi-publish",
 			},
-			LogText: "candidate rust publishing workflow using cargo",
 		},
-		{
-			// Ko container action. https://github.com/google/ko
-			Steps: []*JobMatcherStep{
-				{
-					Uses: "imjasonh/setup-ko",
-				},
-				{
-					Uses: "ko-build/setup-ko",
-				},
+		LogText:   "candidate python publishing workflow using pypi",
+		Ecosystem: checker.PackageEcosystemPyPI,
+	},
+	{
+		// Python packages uploaded from scripts.
+		Steps: []*JobMatcherStep{
+			{
+				Run: "(twine.*upload|(poetry|flit|hatch|uv).*publish)",
 			},
-			LogText: "candidate container publishing workflow using ko",
 		},
-		{
-			// Commonly JavaScript packages, but supports multiple ecosystems
-			Steps: []*JobMatcherStep{
-				{
-					Run: "npx.*semantic-release",
-				},
+		LogText:   "candidate python publishing workflow using twine or a build backend",
+		Ecosystem: checker.PackageEcosystemPyPI,
+	},
+	{
+		// Python packages.
+		// This is a custom Python packaging workflow based on semantic versioning.
+		// TODO(#1642): accept custom workflows through a separate configuration.
+		Steps: []*JobMatcherStep{
+			{
+				Uses: "relekang/python-semantic-release",
 			},
-			LogText: "candidate publishing workflow using semantic-release",
 		},
-	}
-
-	return AnyJobsMatch(workflow, jobMatchers, fp, "not a publishing workflow")
+		LogText:   "candidate python publishing workflow using python-semantic-release",
+		Ecosystem: checker.PackageEcosystemPyPI,
+	},
+	{
+		// Go packages.
+		Steps: []*JobMatcherStep{
+			{
+				Uses: "goreleaser/goreleaser-action",
+			},
+		},
+		LogText:   "candidate golang publishing workflow",
+		Ecosystem: checker.PackageEcosystemGo,
+	},
+	{
+		// Go packages.
+		Steps: []*JobMatcherStep{
+			{
+				Run: "goreleaser.*release",
+			},
+		},
+		LogText:   "candidate golang publishing workflow",
+		Ecosystem: checker.PackageEcosystemGo,
+	},
+	{
+		// Rust packages. https://doc.rust-lang.org/cargo/reference/publishing.html
+		Steps: []*JobMatcherStep{
+			{
+				Run: "cargo.*publish",
+			},
+		},
+		LogText:   "candidate rust publishing workflow using cargo",
+		Ecosystem: checker.PackageEcosystemCratesIO,
+	},
+	{
+		// https://github.com/katyo/publish-crates
+		Steps: []*JobMatcherStep{
+			{
+				Uses: "katyo/publish-crates",
+			},
+		},
+		LogText:   "candidate rust publishing workflow using publish-crates",
+		Ecosystem: checker.PackageEcosystemCratesIO,
+	},
+	{
+		// Ko container action. https://github.com/google/ko
+		Steps: []*JobMatcherStep{
+			{
+				Uses: "imjasonh/setup-ko",
+			},
+		},
+		LogText:   "candidate container publishing workflow using ko",
+		Ecosystem: checker.PackageEcosystemContainer,
+	},
+	{
+		// Ko container action. https://github.com/ko-build/setup-ko
+		Steps: []*JobMatcherStep{
+			{
+				Uses: "ko-build/setup-ko",
+			},
+		},
+		LogText:   "candidate container publishing workflow using ko",
+		Ecosystem: checker.PackageEcosystemContainer,
+	},
+	{
+		// Helm charts. https://github.com/helm/chart-releaser-action
+		Steps: []*JobMatcherStep{
+			{
+				Uses: "helm/chart-releaser-action",
+			},
+		},
+		LogText:   "candidate helm publishing workflow using chart-releaser",
+		Ecosystem: checker.PackageEcosystemHelm,
+	},
+	{
+		// Helm charts pushed to OCI registries or ChartMuseum.
+		Steps: []*JobMatcherStep{
+			{
+				Run: "helm.*(push|cm-push)",
+			},
+		},
+		LogText:   "candidate helm publishing workflow",
+		Ecosystem: checker.PackageEcosystemHelm,
+	},
+	{
+		// Commonly JavaScript packages, but supports multiple ecosystems
+		Steps: []*JobMatcherStep{
+			{
+				Run: "npx.*semantic-release",
+			},
+		},
+		LogText: "candidate publishing workflow using semantic-release",
+	},
 }
@@ -22,6 +22,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/rhysd/actionlint"
+
+	"github.com/ossf/scorecard/v4/checker"
 )
 
 func TestGitHubWorkflowShell(t *testing.T) {
@@ -304,6 +306,101 @@ func TestGetJobName(t *testing.T) {
 	}
 }
 
+func TestGetSelfHostedRunnerLabels(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		job  *actionlint.Job
+		want []string
+	}{
+		{
+			name: "self-hosted labels",
+			job: &actionlint.Job{
+				RunsOn: &actionlint.Runner{
+					Labels: []*actionlint.String{{Value: "self-hosted"}, {Value: "linux"}},
+				},
+			},
+			want: []string{"self-hosted", "linux"},
+		},
+		{
+			name: "GitHub-hosted label",
+			job: &actionlint.Job{
+				RunsOn: &actionlint.Runner{
+					Labels: []*actionlint.String{{Value: "ubuntu-latest"}},
+				},
+			},
+		},
+		{
+			name: "larger GitHub-hosted runners",
+			job: &actionlint.Job{
+				RunsOn: &actionlint.Runner{
+					Labels: []*actionlint.String{{Value: "${{ matrix.os }}"}},
+				},
+				Strategy: &actionlint.Strategy{
+					Matrix: &actionlint.Matrix{
+						Rows: map[string]*actionlint.MatrixRow{
+							"os": {
+								Values: []actionlint.RawYAMLValue{
+									&actionlint.RawYAMLString{Value: "ubuntu-24.04-arm"},
+									&actionlint.RawYAMLString{Value: "macos-14-xlarge"},
+									&actionlint.RawYAMLString{Value: "windows-latest-8-cores"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "custom label",
+			job: &actionlint.Job{
+				RunsOn: &actionlint.Runner{
+					Labels: []*actionlint.String{{Value: "linux-gpu"}},
+				},
+			},
+			want: []string{"linux-gpu"},
+		},
+		{
+			name: "GitHub-hosted and custom labels",
+			job: &actionlint.Job{
+				RunsOn: &actionlint.Runner{
+					Labels: []*actionlint.String{{Value: "ubuntu-latest"}, {Value: "gpu"}},
+				},
+			},
+			want: []string{"ubuntu-latest", "gpu"},
+		},
+		{
+			name: "runner group",
+			job: &actionlint.Job{
+				RunsOn: &actionlint.Runner{
+					Group: &actionlint.String{Value: "release-runners"},
+				},
+			},
+			want: []string{"group: release-runners"},
+		},
+		{
+			name: "unresolved matrix",
+			job: &actionlint.Job{
+				RunsOn: &actionlint.Runner{
+					Labels: []*actionlint.String{{Value: "${{ matrix.runner }}"}},
+				},
+			},
+		},
+		{
+			name: "job is nil",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := GetSelfHostedRunnerLabels(tt.job); !cmp.Equal(got, tt.want) {
+				t.Errorf("GetSelfHostedRunnerLabels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestGetStepName(t *testing.T) {
 	t.Parallel()
 	type args struct {
@@ -1003,6 +1100,21 @@ func TestIsPackagingWorkflow(t *testing.T) {
 			filename: "../testdata/.github/workflows/github-workflow-packaging-semantic-release.yaml",
 			expected: true,
 		},
+		{
+			name:     "helm chart-releaser publish",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-helm.yaml",
+			expected: true,
+		},
+		{
+			name:     "twine upload publish",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-twine.yaml",
+			expected: true,
+		},
+		{
+			name:     "jib publish",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-jib.yaml",
+			expected: true,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt // Re-initializing variable so it is not changed while executing the closure below
@@ -1025,3 +1137,201 @@ func TestIsPackagingWorkflow(t *testing.T) {
 		})
 	}
 }
+
+func TestPackagingJobs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		filename string
+		expected []checker.PackageEcosystem
+	}{
+		{
+			name:     "single ecosystem",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-cargo.yaml",
+			expected: []checker.PackageEcosystem{checker.PackageEcosystemCratesIO},
+		},
+		{
+			name:     "several ecosystems",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-multiple.yaml",
+			expected: []checker.PackageEcosystem{
+				checker.PackageEcosystemGo,
+				checker.PackageEcosystemContainer,
+				checker.PackageEcosystemCratesIO,
+			},
+		},
+		{
+			name:     "unknown ecosystem",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-semantic-release.yaml",
+			expected: []checker.PackageEcosystem{""},
+		},
+		{
+			name:     "not a publishing workflow",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-pypi-failing.yaml",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			content, err := stdos.ReadFile(tt.filename)
+			if err != nil {
+				t.Fatalf("cannot read file: %v", err)
+			}
+			workflow, errs := actionlint.Parse(content)
+			if len(errs) > 0 && workflow == nil {
+				t.Fatalf("cannot parse file: %v", errs)
+			}
+			var ecosystems []checker.PackageEcosystem
+			for _, job := range PackagingJobs(workflow, tt.filename) {
+				ecosystems = append(ecosystems, job.Ecosystem)
+			}
+			if diff := cmp.Diff(tt.expected, ecosystems); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPackagingJobsEnvironment(t *testing.T) {
+	t.Parallel()
+	filename := "../testdata/.github/workflows/github-workflow-packaging-environment.yaml"
+	content, err := stdos.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("cannot read file: %v", err)
+	}
+	workflow, errs := actionlint.Parse(content)
+	if len(errs) > 0 && workflow == nil {
+		t.Fatalf("cannot parse file: %v", errs)
+	}
+	type jobEnvironment struct {
+		JobID       string
+		Environment string
+	}
+	var got []jobEnvironment
+	for _, job := range PackagingJobs(workflow, filename) {
+		got = append(got, jobEnvironment{JobID: job.JobID, Environment: job.Environment})
+	}
+	want := []jobEnvironment{
+		{JobID: "publish", Environment: "crates-io"},
+		{JobID: "publish-npm", Environment: "npm"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPackagingJobsCredentials(t *testing.T) {
+	t.Parallel()
+	type credential struct {
+		Type   checker.PublishCredentialType
+		Secret string
+		Line   uint
+	}
+	type job struct {
+		JobID        string
+		Credentials  []credential
+		IDTokenWrite bool
+	}
+	tests := []struct {
+		name     string
+		filename string
+		expected []job
+	}{
+		{
+			name:     "pypi api token",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-pypi.yaml",
+			expected: []job{
+				{
+					JobID: "publish",
+					Credentials: []credential{
+						{Type: checker.PublishCredentialSecret, Secret: "TEST_PYPI_API_TOKEN", Line: 26},
+					},
+				},
+			},
+		},
+		{
+			name:     "pypi trusted publishing",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-pypi-trusted-publishing.yaml",
+			expected: []job{
+				{
+					JobID:        "publish",
+					IDTokenWrite: true,
+					Credentials: []credential{
+						{Type: checker.PublishCredentialOIDC, Line: 34},
+					},
+				},
+			},
+		},
+		{
+			name:     "npm token",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-npm.yaml",
+			expected: []job{
+				{
+					JobID: "publish",
+					Credentials: []credential{
+						{Type: checker.PublishCredentialSecret, Secret: "NPM_TOKEN", Line: 27},
+					},
+				},
+			},
+		},
+		{
+			name:     "npm trusted publishing",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-npm-trusted-publishing.yaml",
+			expected: []job{
+				{
+					JobID:        "publish",
+					IDTokenWrite: true,
+					Credentials: []credential{
+						{Type: checker.PublishCredentialOIDC, Line: 33},
+					},
+				},
+			},
+		},
+		{
+			name:     "cloud workload identity and service account key",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-cloud-oidc.yaml",
+			expected: []job{
+				{
+					JobID:        "publish-ecr",
+					IDTokenWrite: true,
+					Credentials: []credential{
+						{Type: checker.PublishCredentialOIDC, Line: 27},
+					},
+				},
+				{
+					JobID:        "publish-gcr",
+					IDTokenWrite: true,
+					Credentials: []credential{
+						{Type: checker.PublishCredentialSecret, Secret: "GCP_CREDENTIALS", Line: 45},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			content, err := stdos.ReadFile(tt.filename)
+			if err != nil {
+				t.Fatalf("cannot read file: %v", err)
+			}
+			workflow, errs := actionlint.Parse(content)
+			if len(errs) > 0 && workflow == nil {
+				t.Fatalf("cannot parse file: %v", errs)
+			}
+			var got []job
+			for _, result := range PackagingJobs(workflow, tt.filename) {
+				j := job{JobID: result.JobID, IDTokenWrite: result.IDTokenWrite}
+				for _, c := range result.Credentials {
+					j.Credentials = append(j.Credentials, credential{Type: c.Type, Secret: c.Secret, Line: c.File.Offset})
+				}
+				got = append(got, j)
+			}
+			if diff := cmp.Diff(tt.expected, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
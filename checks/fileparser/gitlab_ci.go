@@ -0,0 +1,686 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ossf/scorecard/v4/clients"
+	sce "github.com/ossf/scorecard/v4/errors"
+)
+
+// GitLabCIFile is the path of the GitLab CI/CD configuration in a repository.
+const GitLabCIFile = ".gitlab-ci.yml"
+
+const (
+	// Limits mirror the ones enforced by GitLab.
+	maxGitLabCIIncludes     = 150
+	maxGitLabCIExtendsDepth = 11
+	maxGitLabCINestedArrays = 10
+)
+
+var (
+	errInvalidGitLabCI         = errors.New("invalid GitLab CI configuration")
+	errGitLabCIExtendsCycle    = errors.New("circular or too deep extends")
+	errGitLabCITooManyIncludes = errors.New("too many includes")
+)
+
+// gitlabCIKeywords are the top-level keys that do not define jobs.
+var gitlabCIKeywords = map[string]bool{
+	"after_script":  true,
+	"before_script": true,
+	"cache":         true,
+	"default":       true,
+	"image":         true,
+	"include":       true,
+	"services":      true,
+	"spec":          true,
+	"stages":        true,
+	"types":         true,
+	"variables":     true,
+	"workflow":      true,
+}
+
+// gitlabCIDefaultKeywords are the job keywords that can be set in `default:`.
+var gitlabCIDefaultKeywords = []string{
+	"after_script", "artifacts", "before_script", "cache", "hooks", "id_tokens",
+	"image", "interruptible", "retry", "services", "tags", "timeout",
+}
+
+// GitLabCIIncludeType is the kind of an `include:` entry.
+type GitLabCIIncludeType string
+
+const (
+	// GitLabCIIncludeLocal is a file in the same repository.
+	GitLabCIIncludeLocal GitLabCIIncludeType = "local"
+	// GitLabCIIncludeProject is a file in another project.
+	GitLabCIIncludeProject GitLabCIIncludeType = "project"
+	// GitLabCIIncludeRemote is a file fetched from a URL.
+	GitLabCIIncludeRemote GitLabCIIncludeType = "remote"
+	// GitLabCIIncludeTemplate is a template shipped with GitLab.
+	GitLabCIIncludeTemplate GitLabCIIncludeType = "template"
+	// GitLabCIIncludeComponent is a CI/CD catalog component.
+	GitLabCIIncludeComponent GitLabCIIncludeType = "component"
+)
+
+// GitLabCIString is a scalar value along with its location.
+type GitLabCIString struct {
+	Value string
+	File  string
+	Line  uint
+}
+
+// GitLabCIInclude is an `include:` entry.
+type GitLabCIInclude struct {
+	Type GitLabCIIncludeType
+	// Value is the path, URL, template or component name.
+	Value string
+	// Project is set for project includes.
+	Project string
+	File    string
+	Line    uint
+}
+
+var (
+	// reMergeRequestCondition matches the conditions of `if:` which hold in merge request
+	// pipelines: comparisons of the pipeline source with `==` or `=~`, and the presence
+	// or value of the merge request variables.
+	reMergeRequestCondition = regexp.MustCompile(
+		`\$CI_PIPELINE_SOURCE\s*(?:==|=~)\s*(?:"merge_request_event"|'merge_request_event'|/[^/]*merge_request[^/]*/)|` +
+			`\$CI_MERGE_REQUEST_\w+\s*(?:$|&&|\|\||\)|(?:==|=~)\s*(?:"[^"]+"|'[^']+'|/[^/]*/))`)
+	// reNotMergeRequestPipeline matches an `if:` holding in all pipelines but merge request ones.
+	reNotMergeRequestPipeline = regexp.MustCompile(
+		`^\s*\$CI_PIPELINE_SOURCE\s*(?:!=|!~)\s*(?:"merge_request_event"|'merge_request_event'|/[^/]*merge_request[^/]*/)\s*$`)
+)
+
+// GitLabCIRule is an entry of `rules:`.
+type GitLabCIRule struct {
+	If   string
+	When string
+}
+
+// GitLabCIJob is a job with `extends:`, anchors and `default:` resolved.
+type GitLabCIJob struct {
+	Variables    map[string]string
+	Name         string
+	File         string
+	Stage        string
+	Image        string
+	Environment  string
+	Script       []GitLabCIString
+	BeforeScript []GitLabCIString
+	AfterScript  []GitLabCIString
+	Rules        []GitLabCIRule
+	Only         []string
+	Except       []string
+	Tags         []string
+	Line         uint
+}
+
+// Scripts returns the `before_script:`, `script:` and `after_script:` commands, in execution order.
+func (j *GitLabCIJob) Scripts() []GitLabCIString {
+	ret := make([]GitLabCIString, 0, len(j.BeforeScript)+len(j.Script)+len(j.AfterScript))
+	ret = append(ret, j.BeforeScript...)
+	ret = append(ret, j.Script...)
+	return append(ret, j.AfterScript...)
+}
+
+// GitLabCI is a GitLab CI/CD configuration with local includes merged.
+type GitLabCI struct {
+	Variables     map[string]string
+	Includes      []GitLabCIInclude
+	WorkflowRules []GitLabCIRule
+	Jobs          []*GitLabCIJob
+}
+
+// RunsOnMergeRequests returns true if the job may run in a merge request pipeline.
+func (ci *GitLabCI) RunsOnMergeRequests(job *GitLabCIJob) bool {
+	for _, only := range job.Only {
+		if only == "merge_requests" {
+			return true
+		}
+	}
+	if len(job.Only) > 0 {
+		return false
+	}
+	if len(job.Rules) == 0 {
+		return rulesMatchMergeRequests(ci.WorkflowRules, false)
+	}
+	return rulesMatchMergeRequests(job.Rules, rulesMatchMergeRequests(ci.WorkflowRules, false))
+}
+
+// rulesMatchMergeRequests returns true if the first rule matching merge request
+// pipelines selects them. Rules without `if:` match every pipeline the workflow
+// creates, which is catchAll, or only merge request pipelines once a `when: never`
+// rule has excluded the others.
+func rulesMatchMergeRequests(rules []GitLabCIRule, catchAll bool) bool {
+	mergeRequestsOnly := false
+	for _, rule := range rules {
+		switch {
+		case rule.If == "":
+			return (catchAll || mergeRequestsOnly) && rule.When != "never"
+		case reMergeRequestCondition.MatchString(rule.If):
+			return rule.When != "never"
+		case rule.When == "never" && reNotMergeRequestPipeline.MatchString(rule.If):
+			mergeRequestsOnly = true
+		}
+	}
+	return false
+}
+
+// ParseGitLabCIFromRepo parses the GitLab CI/CD configuration of the repository,
+// following local includes. It returns nil if the repository has none.
+func ParseGitLabCIFromRepo(repoClient clients.RepoClient) (*GitLabCI, error) {
+	files, err := repoClient.ListFiles(func(path string) (bool, error) {
+		return path == GitLabCIFile, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("RepoClient.ListFiles: %w", err)
+	}
+	found := false
+	for _, f := range files {
+		found = found || f == GitLabCIFile
+	}
+	if !found {
+		return nil, nil
+	}
+
+	read := func(path string) ([]byte, error) {
+		r, err := repoClient.GetFileReader(path)
+		if err != nil {
+			return nil, fmt.Errorf("RepoClient.GetFileReader: %w", err)
+		}
+		defer r.Close()
+		content, err := io.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+		return content, nil
+	}
+	content, err := read(GitLabCIFile)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGitLabCI(GitLabCIFile, content, read)
+}
+
+// ParseGitLabCI parses the GitLab CI/CD configuration content read from path.
+// Local includes are read with readFile and ignored when it is nil or fails;
+// other includes are only recorded.
+func ParseGitLabCI(path string, content []byte, readFile func(path string) ([]byte, error)) (*GitLabCI, error) {
+	p := gitlabCIParser{
+		readFile: readFile,
+		nodeFile: make(map[*yaml.Node]string),
+		visited:  make(map[string]bool),
+		jobs:     make(map[string]*yaml.Node),
+		ci: &GitLabCI{
+			Variables: make(map[string]string),
+		},
+	}
+	if err := p.parseFile(path, content); err != nil {
+		return nil, err
+	}
+	if err := p.resolveJobs(); err != nil {
+		return nil, err
+	}
+	return p.ci, nil
+}
+
+type gitlabCIParser struct {
+	readFile func(path string) ([]byte, error)
+	nodeFile map[*yaml.Node]string
+	visited  map[string]bool
+	jobs     map[string]*yaml.Node
+	defaults *yaml.Node
+	ci       *GitLabCI
+	order    []string
+}
+
+func (p *gitlabCIParser) parseFile(path string, content []byte) error {
+	if p.visited[path] {
+		return nil
+	}
+	if len(p.visited) >= maxGitLabCIIncludes {
+		return sce.WithMessage(sce.ErrScorecardInternal,
+			fmt.Sprintf("%v: %v", errInvalidGitLabCI, errGitLabCITooManyIncludes))
+	}
+	p.visited[path] = true
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("%v: %v: %v", errInvalidGitLabCI, path, err))
+	}
+	if len(doc.Content) == 0 {
+		return nil
+	}
+	p.recordFile(&doc, path)
+	root := resolveYAMLNode(doc.Content[0])
+	if root.Kind != yaml.MappingNode {
+		return sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("%v: %v", errInvalidGitLabCI, path))
+	}
+
+	// Included configuration is merged first so that this file takes precedence.
+	if include := mappingValue(root, "include"); include != nil {
+		if err := p.parseIncludes(path, include); err != nil {
+			return err
+		}
+	}
+
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i].Value, resolveYAMLNode(root.Content[i+1])
+		switch {
+		case key == "variables":
+			for name, v := range variablesOf(value) {
+				p.ci.Variables[name] = v
+			}
+		case key == "workflow":
+			p.ci.WorkflowRules = rulesOf(mappingValue(value, "rules"))
+		case key == "default":
+			p.defaults = mergeYAMLNodes(p.defaults, value)
+		case gitlabCIKeywords[key]:
+			continue
+		case value.Kind == yaml.MappingNode:
+			if _, ok := p.jobs[key]; !ok {
+				p.order = append(p.order, key)
+			}
+			p.jobs[key] = mergeYAMLNodes(p.jobs[key], value)
+		}
+	}
+	return nil
+}
+
+func (p *gitlabCIParser) recordFile(n *yaml.Node, path string) {
+	if n == nil {
+		return
+	}
+	if _, ok := p.nodeFile[n]; ok {
+		return
+	}
+	p.nodeFile[n] = path
+	for _, c := range n.Content {
+		p.recordFile(c, path)
+	}
+}
+
+// fileOf returns the file n was read from. Nodes created while merging
+// are attributed to the file of their first key.
+func (p *gitlabCIParser) fileOf(n *yaml.Node) string {
+	if n == nil {
+		return ""
+	}
+	if path, ok := p.nodeFile[n]; ok {
+		return path
+	}
+	for _, c := range n.Content {
+		if path := p.fileOf(c); path != "" {
+			return path
+		}
+	}
+	return ""
+}
+
+func (p *gitlabCIParser) parseIncludes(path string, include *yaml.Node) error {
+	var entries []*yaml.Node
+	if include.Kind == yaml.SequenceNode {
+		entries = include.Content
+	} else {
+		entries = []*yaml.Node{include}
+	}
+
+	for _, entry := range entries {
+		entry = resolveYAMLNode(entry)
+		inc := GitLabCIInclude{
+			File: path,
+			Line: uint(entry.Line),
+		}
+		switch entry.Kind {
+		case yaml.ScalarNode:
+			inc.Value = entry.Value
+			inc.Type = GitLabCIIncludeLocal
+			if strings.HasPrefix(entry.Value, "http://") || strings.HasPrefix(entry.Value, "https://") {
+				inc.Type = GitLabCIIncludeRemote
+			}
+		case yaml.MappingNode:
+			for _, t := range []GitLabCIIncludeType{
+				GitLabCIIncludeLocal, GitLabCIIncludeRemote,
+				GitLabCIIncludeTemplate, GitLabCIIncludeComponent,
+			} {
+				if v := mappingValue(entry, string(t)); v != nil {
+					inc.Type, inc.Value = t, v.Value
+				}
+			}
+			if v := mappingValue(entry, "project"); v != nil {
+				inc.Type, inc.Project = GitLabCIIncludeProject, v.Value
+				// `file:` may list several files of the same project.
+				for _, f := range flattenYAMLSequence(mappingValue(entry, "file"), 0) {
+					inc.Value = f.Value
+					p.ci.Includes = append(p.ci.Includes, inc)
+				}
+				continue
+			}
+		default:
+			continue
+		}
+		if inc.Type == "" {
+			continue
+		}
+		p.ci.Includes = append(p.ci.Includes, inc)
+
+		if inc.Type != GitLabCIIncludeLocal || p.readFile == nil || strings.Contains(inc.Value, "*") {
+			continue
+		}
+		local := strings.TrimPrefix(inc.Value, "/")
+		content, err := p.readFile(local)
+		if err != nil {
+			// A missing include makes the pipeline invalid, but the rest of the configuration is still useful.
+			continue
+		}
+		if err := p.parseFile(local, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *gitlabCIParser) resolveJobs() error {
+	for _, name := range p.order {
+		if strings.HasPrefix(name, ".") {
+			continue
+		}
+		node, err := p.resolveExtends(name, 0)
+		if err != nil {
+			return err
+		}
+		node = p.applyDefaults(node)
+		p.ci.Jobs = append(p.ci.Jobs, p.jobOf(name, node))
+	}
+	return nil
+}
+
+func (p *gitlabCIParser) resolveExtends(name string, depth int) (*yaml.Node, error) {
+	if depth > maxGitLabCIExtendsDepth {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal,
+			fmt.Sprintf("%v: %v: %s", errInvalidGitLabCI, errGitLabCIExtendsCycle, name))
+	}
+	node, ok := p.jobs[name]
+	if !ok {
+		return nil, nil
+	}
+
+	var merged *yaml.Node
+	for _, parent := range flattenYAMLSequence(mappingValue(node, "extends"), 0) {
+		base, err := p.resolveExtends(parent.Value, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		merged = mergeYAMLNodes(merged, base)
+	}
+	return mergeYAMLNodes(merged, node), nil
+}
+
+func (p *gitlabCIParser) applyDefaults(job *yaml.Node) *yaml.Node {
+	if p.defaults == nil {
+		return job
+	}
+	if inherit := mappingValue(job, "inherit"); inherit != nil {
+		if v := mappingValue(inherit, "default"); v != nil && v.Kind == yaml.ScalarNode && v.Value == "false" {
+			return job
+		}
+	}
+	defaults := &yaml.Node{Kind: yaml.MappingNode}
+	for _, key := range gitlabCIDefaultKeywords {
+		if v := mappingValue(p.defaults, key); v != nil && mappingValue(job, key) == nil {
+			defaults.Content = append(defaults.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, v)
+		}
+	}
+	return mergeYAMLNodes(defaults, job)
+}
+
+func (p *gitlabCIParser) jobOf(name string, node *yaml.Node) *GitLabCIJob {
+	job := &GitLabCIJob{
+		Name:         name,
+		File:         p.fileOf(p.jobs[name]),
+		Line:         uint(p.jobs[name].Line),
+		Stage:        scalarValue(mappingValue(node, "stage")),
+		Image:        scalarValue(mappingValue(node, "image")),
+		Environment:  scalarValue(mappingValue(node, "environment")),
+		Script:       p.stringsOf(mappingValue(node, "script")),
+		BeforeScript: p.stringsOf(mappingValue(node, "before_script")),
+		AfterScript:  p.stringsOf(mappingValue(node, "after_script")),
+		Rules:        rulesOf(mappingValue(node, "rules")),
+		Only:         refsOf(mappingValue(node, "only")),
+		Except:       refsOf(mappingValue(node, "except")),
+		Variables:    variablesOf(mappingValue(node, "variables")),
+	}
+	if image := mappingValue(node, "image"); image != nil && image.Kind == yaml.MappingNode {
+		job.Image = scalarValue(mappingValue(image, "name"))
+	}
+	if env := mappingValue(node, "environment"); env != nil && env.Kind == yaml.MappingNode {
+		job.Environment = scalarValue(mappingValue(env, "name"))
+	}
+	for _, tag := range flattenYAMLSequence(mappingValue(node, "tags"), 0) {
+		job.Tags = append(job.Tags, tag.Value)
+	}
+	return job
+}
+
+func (p *gitlabCIParser) stringsOf(n *yaml.Node) []GitLabCIString {
+	var ret []GitLabCIString
+	for _, s := range flattenYAMLSequence(p.expandReferences(n, 0), 0) {
+		ret = append(ret, GitLabCIString{
+			Value: s.Value,
+			File:  p.fileOf(s),
+			Line:  uint(s.Line),
+		})
+	}
+	return ret
+}
+
+// expandReferences replaces the `!reference [job, key, ...]` tags in n.
+func (p *gitlabCIParser) expandReferences(n *yaml.Node, depth int) *yaml.Node {
+	n = resolveYAMLNode(n)
+	if n == nil || n.Kind != yaml.SequenceNode || depth > maxGitLabCINestedArrays {
+		return n
+	}
+	if n.Tag == "!reference" {
+		var target *yaml.Node
+		for i, key := range n.Content {
+			if i == 0 {
+				target = p.jobs[key.Value]
+			} else {
+				target = mappingValue(target, key.Value)
+			}
+		}
+		return p.expandReferences(target, depth+1)
+	}
+	expanded := &yaml.Node{Kind: yaml.SequenceNode, Line: n.Line, Column: n.Column}
+	for _, c := range n.Content {
+		if c := p.expandReferences(c, depth+1); c != nil {
+			expanded.Content = append(expanded.Content, c)
+		}
+	}
+	return expanded
+}
+
+func rulesOf(n *yaml.Node) []GitLabCIRule {
+	var rules []GitLabCIRule
+	for _, r := range flattenYAMLSequence(n, 0) {
+		if r.Kind != yaml.MappingNode {
+			continue
+		}
+		rules = append(rules, GitLabCIRule{
+			If:   scalarValue(mappingValue(r, "if")),
+			When: scalarValue(mappingValue(r, "when")),
+		})
+	}
+	return rules
+}
+
+// refsOf returns the refs of `only:` or `except:`, in either the array or the `refs:` syntax.
+func refsOf(n *yaml.Node) []string {
+	n = resolveYAMLNode(n)
+	if n != nil && n.Kind == yaml.MappingNode {
+		n = mappingValue(n, "refs")
+	}
+	var refs []string
+	for _, r := range flattenYAMLSequence(n, 0) {
+		refs = append(refs, r.Value)
+	}
+	return refs
+}
+
+func variablesOf(n *yaml.Node) map[string]string {
+	n = resolveYAMLNode(n)
+	vars := make(map[string]string)
+	if n == nil || n.Kind != yaml.MappingNode {
+		return vars
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		value := resolveYAMLNode(n.Content[i+1])
+		if value.Kind == yaml.MappingNode {
+			value = mappingValue(value, "value")
+		}
+		vars[n.Content[i].Value] = scalarValue(value)
+	}
+	return vars
+}
+
+// resolveYAMLNode follows aliases and applies `<<` merge keys.
+func resolveYAMLNode(n *yaml.Node) *yaml.Node {
+	for n != nil && n.Kind == yaml.AliasNode {
+		n = n.Alias
+	}
+	if n == nil || n.Kind != yaml.MappingNode {
+		return n
+	}
+
+	hasMerge := false
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Tag == "!!merge" || n.Content[i].Value == "<<" {
+			hasMerge = true
+			break
+		}
+	}
+	if !hasMerge {
+		return n
+	}
+
+	var merged *yaml.Node
+	explicit := &yaml.Node{Kind: yaml.MappingNode, Line: n.Line, Column: n.Column}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Tag != "!!merge" && n.Content[i].Value != "<<" {
+			explicit.Content = append(explicit.Content, n.Content[i], n.Content[i+1])
+			continue
+		}
+		value := resolveYAMLNode(n.Content[i+1])
+		if value.Kind == yaml.SequenceNode {
+			for _, v := range value.Content {
+				merged = mergeYAMLMappings(merged, resolveYAMLNode(v), false)
+			}
+		} else {
+			merged = mergeYAMLMappings(merged, value, false)
+		}
+	}
+	return mergeYAMLMappings(merged, explicit, false)
+}
+
+// mergeYAMLNodes deep-merges override into base, as `extends:` and `include:` do:
+// mappings are merged key by key, any other value is replaced.
+func mergeYAMLNodes(base, override *yaml.Node) *yaml.Node {
+	return mergeYAMLMappings(resolveYAMLNode(base), resolveYAMLNode(override), true)
+}
+
+func mergeYAMLMappings(base, override *yaml.Node, deep bool) *yaml.Node {
+	if base == nil {
+		return override
+	}
+	if override == nil {
+		return base
+	}
+	if base.Kind != yaml.MappingNode || override.Kind != yaml.MappingNode {
+		return override
+	}
+
+	merged := &yaml.Node{Kind: yaml.MappingNode, Line: override.Line, Column: override.Column}
+	index := make(map[string]int)
+	for i := 0; i+1 < len(base.Content); i += 2 {
+		index[base.Content[i].Value] = len(merged.Content)
+		merged.Content = append(merged.Content, base.Content[i], base.Content[i+1])
+	}
+	for i := 0; i+1 < len(override.Content); i += 2 {
+		key, value := override.Content[i], override.Content[i+1]
+		j, ok := index[key.Value]
+		if !ok {
+			index[key.Value] = len(merged.Content)
+			merged.Content = append(merged.Content, key, value)
+			continue
+		}
+		if deep {
+			merged.Content[j+1] = mergeYAMLNodes(merged.Content[j+1], value)
+		} else {
+			merged.Content[j+1] = value
+		}
+	}
+	return merged
+}
+
+func mappingValue(n *yaml.Node, key string) *yaml.Node {
+	n = resolveYAMLNode(n)
+	if n == nil || n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := len(n.Content) - 2; i >= 0; i -= 2 {
+		if n.Content[i].Value == key {
+			return resolveYAMLNode(n.Content[i+1])
+		}
+	}
+	return nil
+}
+
+func scalarValue(n *yaml.Node) string {
+	n = resolveYAMLNode(n)
+	if n == nil || n.Kind != yaml.ScalarNode {
+		return ""
+	}
+	return n.Value
+}
+
+// flattenYAMLSequence returns the items of n, flattening nested sequences
+// which GitLab allows in order to reuse anchored arrays. A scalar is a single item.
+func flattenYAMLSequence(n *yaml.Node, depth int) []*yaml.Node {
+	n = resolveYAMLNode(n)
+	if n == nil || depth > maxGitLabCINestedArrays {
+		return nil
+	}
+	if n.Kind != yaml.SequenceNode {
+		return []*yaml.Node{n}
+	}
+	var ret []*yaml.Node
+	for _, c := range n.Content {
+		c = resolveYAMLNode(c)
+		if c.Kind == yaml.SequenceNode {
+			ret = append(ret, flattenYAMLSequence(c, depth+1)...)
+			continue
+		}
+		ret = append(ret, c)
+	}
+	return ret
+}
@@ -0,0 +1,203 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	stdos "os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseGitLabCI(t *testing.T) {
+	t.Parallel()
+
+	const dir = "testdata/gitlab-ci"
+	readFile := func(path string) ([]byte, error) {
+		//nolint:wrapcheck
+		return stdos.ReadFile(filepath.Join(dir, path))
+	}
+	content, err := readFile(GitLabCIFile)
+	if err != nil {
+		t.Fatalf("cannot read file: %v", err)
+	}
+	ci, err := ParseGitLabCI(GitLabCIFile, content, readFile)
+	if err != nil {
+		t.Fatalf("ParseGitLabCI: %v", err)
+	}
+
+	wantIncludes := []GitLabCIInclude{
+		{Type: GitLabCIIncludeLocal, Value: "/ci/templates.yml", File: GitLabCIFile, Line: 2},
+		{Type: GitLabCIIncludeTemplate, Value: "Security/SAST.gitlab-ci.yml", File: GitLabCIFile, Line: 3},
+		{
+			Type: GitLabCIIncludeProject, Project: "my-group/my-project", Value: "/templates/a.yml",
+			File: GitLabCIFile, Line: 4,
+		},
+		{
+			Type: GitLabCIIncludeProject, Project: "my-group/my-project", Value: "/templates/b.yml",
+			File: GitLabCIFile, Line: 4,
+		},
+		{Type: GitLabCIIncludeRemote, Value: "https://example.com/ci.yml", File: GitLabCIFile, Line: 8},
+	}
+	if diff := cmp.Diff(wantIncludes, ci.Includes); diff != "" {
+		t.Errorf("unexpected includes (-want +got):\n%s", diff)
+	}
+	if ci.Variables["GLOBAL"] != "global" {
+		t.Errorf("unexpected variables: %v", ci.Variables)
+	}
+
+	jobs := make(map[string]*GitLabCIJob)
+	for _, job := range ci.Jobs {
+		jobs[job.Name] = job
+	}
+	if len(jobs) != 4 {
+		t.Fatalf("expected 4 jobs, got %d: %v", len(jobs), jobs)
+	}
+
+	commands := func(strs []GitLabCIString) []string {
+		var ret []string
+		for _, s := range strs {
+			ret = append(ret, s.Value)
+		}
+		return ret
+	}
+
+	test := jobs["test"]
+	if test.Stage != "test" || test.Image != "golang:1.21" || test.File != GitLabCIFile {
+		t.Errorf("unexpected test job: %+v", test)
+	}
+	if diff := cmp.Diff([]string{"docker"}, test.Tags); diff != "" {
+		t.Errorf("unexpected tags (-want +got):\n%s", diff)
+	}
+	wantScripts := []string{"go version", "echo one", "echo two", "make test", "echo done"}
+	if diff := cmp.Diff(wantScripts, commands(test.Scripts())); diff != "" {
+		t.Errorf("unexpected scripts (-want +got):\n%s", diff)
+	}
+	if afterScript := test.AfterScript[0]; afterScript.File != "ci/templates.yml" || afterScript.Line != 4 {
+		t.Errorf("unexpected after_script location: %+v", afterScript)
+	}
+	if script := test.Script[2]; script.File != GitLabCIFile || script.Line != 35 {
+		t.Errorf("unexpected script location: %+v", script)
+	}
+	if test.Variables["LOCAL"] != "local" {
+		t.Errorf("unexpected job variables: %v", test.Variables)
+	}
+
+	lint := jobs["lint"]
+	if lint.Stage != "lint" || lint.Image != "" || len(lint.BeforeScript) != 0 {
+		t.Errorf("unexpected lint job: %+v", lint)
+	}
+
+	deploy := jobs["deploy"]
+	if deploy.File != "ci/templates.yml" || deploy.Environment != "production" {
+		t.Errorf("unexpected deploy job: %+v", deploy)
+	}
+
+	release := jobs["release"]
+	wantScripts = []string{"go version", "echo done", "./release.sh"}
+	if diff := cmp.Diff(wantScripts, commands(release.Scripts())); diff != "" {
+		t.Errorf("unexpected release scripts (-want +got):\n%s", diff)
+	}
+
+	for name, want := range map[string]bool{
+		"test":    true,
+		"lint":    false,
+		"deploy":  false,
+		"release": false,
+	} {
+		if got := ci.RunsOnMergeRequests(jobs[name]); got != want {
+			t.Errorf("RunsOnMergeRequests(%s) = %t, want %t", name, got, want)
+		}
+	}
+}
+
+func TestParseGitLabCIExtendsCycle(t *testing.T) {
+	t.Parallel()
+	content := []byte(`
+a:
+  extends: b
+  script: echo a
+b:
+  extends: a
+`)
+	if _, err := ParseGitLabCI(GitLabCIFile, content, nil); err == nil {
+		t.Error("expected an error for circular extends")
+	}
+}
+
+func TestRulesMatchMergeRequests(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		rules    []GitLabCIRule
+		catchAll bool
+		want     bool
+	}{
+		{
+			name:  "merge request event",
+			rules: []GitLabCIRule{{If: `$CI_PIPELINE_SOURCE == "merge_request_event"`}},
+			want:  true,
+		},
+		{
+			name:  "merge request event pattern",
+			rules: []GitLabCIRule{{If: `$CI_PIPELINE_SOURCE =~ /^merge_request/ && $CI_COMMIT_BRANCH`}},
+			want:  true,
+		},
+		{
+			name:  "merge request variable",
+			rules: []GitLabCIRule{{If: `$CI_MERGE_REQUEST_IID`}},
+			want:  true,
+		},
+		{
+			name:  "negated merge request event",
+			rules: []GitLabCIRule{{If: `$CI_PIPELINE_SOURCE != "merge_request_event"`}},
+			want:  false,
+		},
+		{
+			name:  "missing merge request variable",
+			rules: []GitLabCIRule{{If: `$CI_MERGE_REQUEST_IID == null`}},
+			want:  false,
+		},
+		{
+			name:  "excluded merge requests",
+			rules: []GitLabCIRule{{If: `$CI_PIPELINE_SOURCE == "merge_request_event"`, When: "never"}, {}},
+			want:  false,
+		},
+		{
+			name: "other pipelines excluded",
+			rules: []GitLabCIRule{
+				{If: `$CI_PIPELINE_SOURCE != "merge_request_event"`, When: "never"},
+				{When: "always"},
+			},
+			want: true,
+		},
+		{
+			name:     "catch-all rule",
+			rules:    []GitLabCIRule{{If: `$CI_COMMIT_BRANCH == "main"`}, {}},
+			catchAll: true,
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := rulesMatchMergeRequests(tt.rules, tt.catchAll); got != tt.want {
+				t.Errorf("rulesMatchMergeRequests() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/package-url/packageurl-go"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+var (
+	gemspecName       = regexp.MustCompile(`\.name\s*=\s*["']([^"']+)["']`)
+	goModule          = regexp.MustCompile(`(?m)^module\s+"?([^\s"]+)"?`)
+	pypiNameSeparator = regexp.MustCompile(`[-_.]+`)
+)
+
+// packageManifest is a manifest naming the package of an ecosystem.
+type packageManifest struct {
+	pattern string
+	// purl returns the purl of the package, or an empty string.
+	purl func(content []byte) string
+}
+
+var packageManifests = map[checker.PackageEcosystem][]packageManifest{
+	checker.PackageEcosystemNpm:      {{"package.json", npmPurl}},
+	checker.PackageEcosystemPyPI:     {{"pyproject.toml", pyprojectPurl}, {"setup.cfg", setupCfgPurl}},
+	checker.PackageEcosystemCratesIO: {{"Cargo.toml", cargoPurl}},
+	checker.PackageEcosystemMaven:    {{"pom.xml", mavenPurl}},
+	checker.PackageEcosystemRubyGems: {{"*.gemspec", gemspecPurl}},
+	checker.PackageEcosystemNuGet:    {{"*.nuspec", nuspecPurl}},
+	checker.PackageEcosystemGo:       {{"go.mod", goPurl}},
+	checker.PackageEcosystemHelm:     {{"Chart.yaml", helmPurl}, {"charts/*/Chart.yaml", helmPurl}},
+}
+
+// PackagePurl returns the purl of the package the repository publishes to
+// the ecosystem, derived from the manifests at the root of the repository.
+// It returns an empty string if no manifest names the package.
+func PackagePurl(c clients.RepoClient, ecosystem checker.PackageEcosystem) (string, error) {
+	var purl string
+	for _, m := range packageManifests[ecosystem] {
+		m := m
+		err := OnMatchingFileContentDo(c, PathMatcher{Pattern: m.pattern, CaseSensitive: true},
+			func(p string, content []byte, args ...interface{}) (bool, error) {
+				// PathMatcher also matches the manifests of nested directories,
+				// which may belong to other packages.
+				if matched, err := path.Match(m.pattern, p); err != nil || !matched {
+					return true, nil //nolint:nilerr // the patterns are valid
+				}
+				purl = m.purl(content)
+				return purl == "", nil
+			})
+		if err != nil {
+			return "", err
+		}
+		if purl != "" {
+			break
+		}
+	}
+	return purl, nil
+}
+
+func newPurl(purlType, namespace, name string) string {
+	if name == "" {
+		return ""
+	}
+	return packageurl.NewPackageURL(purlType, namespace, name, "", nil, "").ToString()
+}
+
+func npmPurl(content []byte) string {
+	var manifest struct {
+		Name    string `json:"name"`
+		Private bool   `json:"private"`
+	}
+	if err := json.Unmarshal(content, &manifest); err != nil || manifest.Private {
+		return ""
+	}
+	namespace, name, found := strings.Cut(manifest.Name, "/")
+	if !found {
+		return newPurl(packageurl.TypeNPM, "", manifest.Name)
+	}
+	return newPurl(packageurl.TypeNPM, namespace, name)
+}
+
+func pypiPurl(name string) string {
+	// https://packaging.python.org/en/latest/specifications/name-normalization/
+	name = pypiNameSeparator.ReplaceAllString(strings.ToLower(name), "-")
+	return newPurl(packageurl.TypePyPi, "", name)
+}
+
+func pyprojectPurl(content []byte) string {
+	var manifest struct {
+		Project struct {
+			Name string `toml:"name"`
+		} `toml:"project"`
+		Tool struct {
+			Poetry struct {
+				Name string `toml:"name"`
+			} `toml:"poetry"`
+		} `toml:"tool"`
+	}
+	if _, err := toml.Decode(string(content), &manifest); err != nil {
+		return ""
+	}
+	if manifest.Project.Name != "" {
+		return pypiPurl(manifest.Project.Name)
+	}
+	return pypiPurl(manifest.Tool.Poetry.Name)
+}
+
+func setupCfgPurl(content []byte) string {
+	var section string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") {
+			section = strings.Trim(line, "[]")
+			continue
+		}
+		key, value, found := strings.Cut(line, "=")
+		if section == "metadata" && found && strings.TrimSpace(key) == "name" {
+			return pypiPurl(strings.TrimSpace(value))
+		}
+	}
+	return ""
+}
+
+func cargoPurl(content []byte) string {
+	var manifest struct {
+		Package struct {
+			Name    string `toml:"name"`
+			Publish any    `toml:"publish"`
+		} `toml:"package"`
+	}
+	if _, err := toml.Decode(string(content), &manifest); err != nil {
+		return ""
+	}
+	// `publish = false` prevents publishing the crate.
+	if publish, ok := manifest.Package.Publish.(bool); ok && !publish {
+		return ""
+	}
+	return newPurl(packageurl.TypeCargo, "", manifest.Package.Name)
+}
+
+func mavenPurl(content []byte) string {
+	var pom struct {
+		GroupID    string `xml:"groupId"`
+		ArtifactID string `xml:"artifactId"`
+		Parent     struct {
+			GroupID string `xml:"groupId"`
+		} `xml:"parent"`
+	}
+	if err := xml.Unmarshal(content, &pom); err != nil {
+		return ""
+	}
+	groupID := pom.GroupID
+	if groupID == "" {
+		groupID = pom.Parent.GroupID
+	}
+	// Properties are not resolved.
+	if groupID == "" || strings.Contains(groupID+pom.ArtifactID, "${") {
+		return ""
+	}
+	return newPurl(packageurl.TypeMaven, groupID, pom.ArtifactID)
+}
+
+func gemspecPurl(content []byte) string {
+	m := gemspecName.FindSubmatch(content)
+	if m == nil {
+		return ""
+	}
+	return newPurl(packageurl.TypeGem, "", string(m[1]))
+}
+
+func nuspecPurl(content []byte) string {
+	var nuspec struct {
+		Metadata struct {
+			ID string `xml:"id"`
+		} `xml:"metadata"`
+	}
+	if err := xml.Unmarshal(content, &nuspec); err != nil || strings.Contains(nuspec.Metadata.ID, "$") {
+		return ""
+	}
+	return newPurl(packageurl.TypeNuget, "", nuspec.Metadata.ID)
+}
+
+func goPurl(content []byte) string {
+	m := goModule.FindSubmatch(content)
+	if m == nil {
+		return ""
+	}
+	module := string(m[1])
+	i := strings.LastIndex(module, "/")
+	if i < 0 {
+		return newPurl(packageurl.TypeGolang, "", module)
+	}
+	return newPurl(packageurl.TypeGolang, module[:i], module[i+1:])
+}
+
+func helmPurl(content []byte) string {
+	var chart struct {
+		Name string `yaml:"name"`
+	}
+	if err := yaml.Unmarshal(content, &chart); err != nil {
+		return ""
+	}
+	return newPurl(packageurl.TypeHelm, "", chart.Name)
+}
@@ -0,0 +1,197 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/ossf/scorecard/v4/checker"
+	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
+)
+
+func TestPackagePurlParsers(t *testing.T) {
+	t.Parallel()
+
+	//nolint:govet
+	tests := []struct {
+		name     string
+		parse    func([]byte) string
+		content  string
+		expected string
+	}{
+		{
+			name:     "npm",
+			parse:    npmPurl,
+			content:  `{"name": "left-pad", "version": "1.3.0"}`,
+			expected: "pkg:npm/left-pad",
+		},
+		{
+			name:     "npm scoped",
+			parse:    npmPurl,
+			content:  `{"name": "@angular/core"}`,
+			expected: "pkg:npm/%40angular/core",
+		},
+		{
+			name:    "npm private",
+			parse:   npmPurl,
+			content: `{"name": "monorepo-root", "private": true}`,
+		},
+		{
+			name:     "pyproject",
+			parse:    pyprojectPurl,
+			content:  "[project]\nname = \"Typing_Extensions\"\n",
+			expected: "pkg:pypi/typing-extensions",
+		},
+		{
+			name:     "pyproject poetry",
+			parse:    pyprojectPurl,
+			content:  "[tool.poetry]\nname = \"poetry-core\"\n",
+			expected: "pkg:pypi/poetry-core",
+		},
+		{
+			name:     "setup.cfg",
+			parse:    setupCfgPurl,
+			content:  "[options]\nname = ignored\n[metadata]\nversion = 1.0\nname = requests\n",
+			expected: "pkg:pypi/requests",
+		},
+		{
+			name:     "cargo",
+			parse:    cargoPurl,
+			content:  "[package]\nname = \"serde\"\nversion = \"1.0.0\"\n",
+			expected: "pkg:cargo/serde",
+		},
+		{
+			name:    "cargo unpublished",
+			parse:   cargoPurl,
+			content: "[package]\nname = \"xtask\"\npublish = false\n",
+		},
+		{
+			name:    "cargo workspace",
+			parse:   cargoPurl,
+			content: "[workspace]\nmembers = [\"a\", \"b\"]\n",
+		},
+		{
+			name:  "maven",
+			parse: mavenPurl,
+			content: `<project>
+  <parent><groupId>org.apache</groupId></parent>
+  <artifactId>commons-lang3</artifactId>
+  <dependencies><dependency><groupId>junit</groupId></dependency></dependencies>
+</project>`,
+			expected: "pkg:maven/org.apache/commons-lang3",
+		},
+		{
+			name:    "maven properties",
+			parse:   mavenPurl,
+			content: `<project><groupId>${group}</groupId><artifactId>app</artifactId></project>`,
+		},
+		{
+			name:     "gemspec",
+			parse:    gemspecPurl,
+			content:  "Gem::Specification.new do |spec|\n  spec.name = 'rails'\nend\n",
+			expected: "pkg:gem/rails",
+		},
+		{
+			name:     "nuspec",
+			parse:    nuspecPurl,
+			content:  `<package><metadata><id>Newtonsoft.Json</id></metadata></package>`,
+			expected: "pkg:nuget/Newtonsoft.Json",
+		},
+		{
+			name:     "go",
+			parse:    goPurl,
+			content:  "module github.com/ossf/scorecard/v4\n\ngo 1.21\n",
+			expected: "pkg:golang/github.com/ossf/scorecard/v4",
+		},
+		{
+			name:     "helm",
+			parse:    helmPurl,
+			content:  "apiVersion: v2\nname: ingress-nginx\nversion: 4.0.0\n",
+			expected: "pkg:helm/ingress-nginx",
+		},
+		{
+			name:    "invalid",
+			parse:   cargoPurl,
+			content: "not toml [",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.parse([]byte(tt.content)); got != tt.expected {
+				t.Errorf("got %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPackagePurl(t *testing.T) {
+	t.Parallel()
+
+	//nolint:govet
+	tests := []struct {
+		name     string
+		files    []string
+		expected string
+	}{
+		{
+			name:     "manifest at the root",
+			files:    []string{"examples/app/package.json", "package.json"},
+			expected: "pkg:npm/root",
+		},
+		{
+			name:  "nested manifest only",
+			files: []string{"examples/app/package.json"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockRepo := mockrepo.NewMockRepoClient(ctrl)
+			mockRepo.EXPECT().ListFiles(gomock.Any()).DoAndReturn(
+				func(predicate func(string) (bool, error)) ([]string, error) {
+					var files []string
+					for _, f := range tt.files {
+						if ok, err := predicate(f); err == nil && ok {
+							files = append(files, f)
+						}
+					}
+					return files, nil
+				}).AnyTimes()
+			mockRepo.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(f string) (io.ReadCloser, error) {
+				name := "root"
+				if strings.Contains(f, "/") {
+					name = "example"
+				}
+				return io.NopCloser(strings.NewReader(`{"name": "` + name + `"}`)), nil
+			}).AnyTimes()
+
+			purl, err := PackagePurl(mockRepo, checker.PackageEcosystemNpm)
+			if err != nil {
+				t.Fatalf("PackagePurl: %v", err)
+			}
+			if purl != tt.expected {
+				t.Errorf("PackagePurl() = %q, want %q", purl, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/rhysd/actionlint"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+const permissionIDToken = "id-token"
+
+// secretRegex matches the secrets referenced in expressions,
+// e.g. `secrets.PYPI_TOKEN` or `secrets['PYPI_TOKEN']`.
+var secretRegex = regexp.MustCompile(`secrets(?:\.([A-Za-z0-9_-]+)|\[\s*'([A-Za-z0-9_-]+)'\s*\])`)
+
+// oidcAction is an action authenticating with the OIDC identity token of the job,
+// unless one of its static credential inputs is set.
+type oidcAction struct {
+	uses string
+	// credentialInputs are the inputs taking long-lived credentials instead.
+	credentialInputs []string
+}
+
+var oidcActions = []oidcAction{
+	// https://docs.pypi.org/trusted-publishers/
+	{uses: "pypa/gh-action-pypi-publish", credentialInputs: []string{"password"}},
+	// https://guides.rubygems.org/trusted-publishing/
+	{uses: "rubygems/release-gem"},
+	{uses: "rubygems/configure-rubygems-credentials", credentialInputs: []string{"api-token"}},
+	// https://crates.io/docs/trusted-publishing
+	{uses: "rust-lang/crates-io-auth-action"},
+	// Cloud workload identity federation.
+	{uses: "aws-actions/configure-aws-credentials", credentialInputs: []string{"aws-secret-access-key"}},
+	{uses: "google-github-actions/auth", credentialInputs: []string{"credentials_json"}},
+	{uses: "azure/login", credentialInputs: []string{"creds", "client-secret"}},
+}
+
+// npmPublishRegex matches commands publishing to npm, which authenticate
+// with OIDC when the job has an identity token and no npm token is set.
+var npmPublishRegex = regexp.MustCompile(`(npm|pnpm|yarn)\s+(.*\s+)?publish`)
+
+// GrantsIDToken returns true if the job can request an OIDC identity token.
+// Jobs not declaring permissions get the ones of the workflow.
+func GrantsIDToken(workflow *actionlint.Workflow, job *actionlint.Job) bool {
+	perms := job.Permissions
+	if perms == nil {
+		perms = workflow.Permissions
+	}
+	if perms == nil {
+		// The default permissions never include id-token.
+		return false
+	}
+	if perms.All != nil && strings.EqualFold(perms.All.Value, "write-all") {
+		return true
+	}
+	scope, ok := perms.Scopes[permissionIDToken]
+	return ok && scope.Value != nil && strings.EqualFold(scope.Value.Value, "write")
+}
+
+// PublishCredentials returns the credentials the steps of a release job publish with:
+// the secrets they reference, and the actions and commands authenticating with OIDC.
+func PublishCredentials(workflow *actionlint.Workflow, job *actionlint.Job, fp string) []checker.PublishCredential {
+	var credentials []checker.PublishCredential
+	addSecrets := func(value *actionlint.String) {
+		for _, secret := range referencedSecrets(value) {
+			credentials = append(credentials, checker.PublishCredential{
+				File:   credentialFile(fp, value.Pos),
+				Type:   checker.PublishCredentialSecret,
+				Secret: secret,
+			})
+		}
+	}
+
+	for _, env := range []*actionlint.Env{workflow.Env, job.Env} {
+		for _, value := range envValues(env) {
+			addSecrets(value)
+		}
+	}
+	if call := job.WorkflowCall; call != nil {
+		for _, secret := range call.Secrets {
+			addSecrets(secret.Value)
+		}
+	}
+
+	idToken := GrantsIDToken(workflow, job)
+	for _, step := range job.Steps {
+		for _, value := range envValues(step.Env) {
+			addSecrets(value)
+		}
+		switch exec := step.Exec.(type) {
+		case *actionlint.ExecAction:
+			for _, input := range exec.Inputs {
+				addSecrets(input.Value)
+			}
+			if idToken && usesOIDCAction(exec) {
+				credentials = append(credentials, checker.PublishCredential{
+					File: credentialFile(fp, step.Pos),
+					Type: checker.PublishCredentialOIDC,
+				})
+			}
+		case *actionlint.ExecRun:
+			addSecrets(exec.Run)
+			if idToken && exec.Run != nil && npmPublishRegex.MatchString(exec.Run.Value) {
+				credentials = append(credentials, checker.PublishCredential{
+					File: credentialFile(fp, step.Pos),
+					Type: checker.PublishCredentialOIDC,
+				})
+			}
+		}
+	}
+
+	// Commands may publish with a token set by the job rather than with OIDC,
+	// so npm commands are only credited when the job references no secret.
+	if hasSecretCredential(credentials) {
+		credentials = withoutNpmOIDC(job, credentials)
+	}
+	sort.SliceStable(credentials, func(i, j int) bool {
+		return credentials[i].File.Offset < credentials[j].File.Offset
+	})
+	return credentials
+}
+
+// referencedSecrets returns the secrets referenced by a value, except the
+// GITHUB_TOKEN which expires with the job.
+func referencedSecrets(value *actionlint.String) []string {
+	if value == nil {
+		return nil
+	}
+	var secrets []string
+	for _, m := range secretRegex.FindAllStringSubmatch(value.Value, -1) {
+		name := m[1] + m[2]
+		if strings.EqualFold(name, "GITHUB_TOKEN") {
+			continue
+		}
+		secrets = append(secrets, name)
+	}
+	return secrets
+}
+
+func envValues(env *actionlint.Env) []*actionlint.String {
+	if env == nil {
+		return nil
+	}
+	values := make([]*actionlint.String, 0, len(env.Vars))
+	for _, v := range env.Vars {
+		values = append(values, v.Value)
+	}
+	return values
+}
+
+func usesOIDCAction(exec *actionlint.ExecAction) bool {
+	if exec.Uses == nil {
+		return false
+	}
+	uses := strings.Split(exec.Uses.Value, "@")[0]
+	for _, action := range oidcActions {
+		if !strings.EqualFold(uses, action.uses) {
+			continue
+		}
+		for _, input := range action.credentialInputs {
+			if _, ok := exec.Inputs[input]; ok {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func hasSecretCredential(credentials []checker.PublishCredential) bool {
+	for i := range credentials {
+		if credentials[i].Type == checker.PublishCredentialSecret {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutNpmOIDC removes the npm commands from the OIDC credentials.
+func withoutNpmOIDC(job *actionlint.Job, credentials []checker.PublishCredential) []checker.PublishCredential {
+	npmSteps := map[uint]bool{}
+	for _, step := range job.Steps {
+		if exec, ok := step.Exec.(*actionlint.ExecRun); ok && exec.Run != nil &&
+			npmPublishRegex.MatchString(exec.Run.Value) {
+			npmSteps[GetLineNumber(step.Pos)] = true
+		}
+	}
+	ret := credentials[:0]
+	for _, c := range credentials {
+		if c.Type == checker.PublishCredentialOIDC && npmSteps[c.File.Offset] {
+			continue
+		}
+		ret = append(ret, c)
+	}
+	return ret
+}
+
+func credentialFile(fp string, pos *actionlint.Pos) checker.File {
+	return checker.File{
+		Path:   fp,
+		Type:   finding.FileTypeSource,
+		Offset: GetLineNumber(pos),
+	}
+}
@@ -337,6 +337,8 @@ func Test_Maintained(t *testing.T) {
 				}
 				return tt.isarchived, nil
 			})
+			mockRepo.EXPECT().GetArchivedAt().Return(nil, clients.ErrUnsupportedFeature).AnyTimes()
+			mockRepo.EXPECT().ListTopics().Return(nil, clients.ErrUnsupportedFeature).AnyTimes()
 			//nolint:nestif
 			if tt.archiveerr == nil {
 				mockRepo.EXPECT().ListCommits().DoAndReturn(
@@ -349,6 +351,7 @@ func Test_Maintained(t *testing.T) {
 				).MinTimes(1)
 
 				if tt.commiterr == nil {
+					mockRepo.EXPECT().ListReleases().Return(nil, nil).AnyTimes()
 					mockRepo.EXPECT().ListIssues().DoAndReturn(
 						func() ([]clients.Issue, error) {
 							if tt.issueerr != nil {
@@ -366,6 +369,7 @@ func Test_Maintained(t *testing.T) {
 
 							return tt.createdat, nil
 						})
+						mockRepo.EXPECT().ListFiles(gomock.Any()).Return(nil, nil).AnyTimes()
 					}
 				}
 			}
@@ -431,6 +431,9 @@ func TestGithubTokenPermissions(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 			mockRepo := mockrepo.NewMockRepoClient(ctrl)
+			mockRepo.EXPECT().GetCIJobTokenScope().Return(nil, clients.ErrUnsupportedFeature).AnyTimes()
+			mockRepo.EXPECT().GetWorkflowPermissions().Return(nil, clients.ErrUnsupportedFeature).AnyTimes()
+			mockRepo.EXPECT().GetOrgWorkflowPermissions().Return(nil, clients.ErrUnsupportedFeature).AnyTimes()
 			mockRepo.EXPECT().GetDefaultBranchName().Return("main", nil).AnyTimes()
 
 			main := "main"
@@ -498,6 +501,9 @@ func TestGithubTokenPermissionsLineNumber(t *testing.T) {
 			p := strings.Replace(tt.filename, "./testdata/", "", 1)
 			ctrl := gomock.NewController(t)
 			mockRepo := mockrepo.NewMockRepoClient(ctrl)
+			mockRepo.EXPECT().GetCIJobTokenScope().Return(nil, clients.ErrUnsupportedFeature).AnyTimes()
+			mockRepo.EXPECT().GetWorkflowPermissions().Return(nil, clients.ErrUnsupportedFeature).AnyTimes()
+			mockRepo.EXPECT().GetOrgWorkflowPermissions().Return(nil, clients.ErrUnsupportedFeature).AnyTimes()
 
 			main := "main"
 			mockRepo.EXPECT().URI().Return("github.com/ossf/scorecard").AnyTimes()
@@ -113,11 +113,16 @@ func BranchProtection(cr *checker.CheckRequest) (checker.BranchProtectionsData,
 		return checker.BranchProtectionsData{}, err
 	}
 
-	// No error, return the data.
-	return checker.BranchProtectionsData{
+	data := checker.BranchProtectionsData{
 		Branches:        branches.set,
 		CodeownersFiles: codeownersFiles,
-	}, nil
+	}
+	if err := collectCodeowners(c, codeownersFiles, &data); err != nil {
+		return checker.BranchProtectionsData{}, err
+	}
+
+	// No error, return the data.
+	return data, nil
 }
 
 func collectCodeownersFiles(c clients.RepoClient, codeownersFiles *[]string) error {
@@ -0,0 +1,518 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+)
+
+// buildCommand is a shell command of a build file, e.g. a Makefile recipe line.
+type buildCommand struct {
+	content string
+	// line is the line of the build file the content starts on.
+	line uint
+}
+
+// buildFile is a kind of build entrypoint whose commands run in a shell.
+type buildFile struct {
+	commands func(content []byte) []buildCommand
+	patterns []string
+}
+
+var buildFiles = []buildFile{
+	{
+		patterns: []string{"Makefile", "GNUmakefile", "*.mk", "*.mak"},
+		commands: makefileCommands,
+	},
+	{
+		patterns: []string{"justfile", ".justfile", "*.just"},
+		commands: justfileCommands,
+	},
+	{
+		patterns: []string{"Taskfile.yml", "Taskfile.yaml", "Taskfile.dist.yml", "Taskfile.dist.yaml"},
+		commands: taskfileCommands,
+	},
+}
+
+// maxExpansionDepth bounds the expansion of variables referencing other variables.
+const maxExpansionDepth = 10
+
+var (
+	// reMakeVariable matches the assignment of a Make variable, e.g. `GO ?= go`.
+	reMakeVariable = regexp.MustCompile(`^(?:(?:export|override)\s+)*([A-Za-z_][A-Za-z0-9_.-]*)\s*(:{1,3}=|\?=|\+=|!=|=)\s*(.*)$`)
+	// reMakeDirective matches the lines of a Makefile which are neither rules nor assignments.
+	reMakeDirective = regexp.MustCompile(`^(?:-?include|sinclude|ifn?eq|ifn?def|else|endif|export|unexport|vpath)\b`)
+	// reJustVariable matches the assignment of a just variable, e.g. `version := "1.0"`.
+	reJustVariable = regexp.MustCompile(`^(?:export\s+)?([A-Za-z_][\w-]*)\s*:=\s*(.*)$`)
+	// reJustRecipe matches the header of a just recipe, e.g. `build target='all': deps`.
+	reJustRecipe = regexp.MustCompile(`^@?[A-Za-z_][\w-]*(?:\s[^:]*)?:(?:[^=]|$)`)
+	// reJustInterpolation matches `{{ name }}` in a just recipe.
+	reJustInterpolation = regexp.MustCompile(`\{\{\s*([A-Za-z_][\w-]*)\s*\}\}`)
+	// reTaskTemplate matches `{{.NAME}}` in a Taskfile command.
+	reTaskTemplate = regexp.MustCompile(`\{\{\s*\.([A-Za-z_]\w*)\s*\}\}`)
+	reBacktick     = regexp.MustCompile("`([^`]*)`")
+)
+
+func collectBuildFileInsecureDownloads(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	for i := range buildFiles {
+		for _, pattern := range buildFiles[i].patterns {
+			err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+				Pattern:       pattern,
+				CaseSensitive: false,
+			}, validateBuildFileInsecureDownloads, buildFiles[i].commands, r)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+var validateBuildFileInsecureDownloads fileparser.DoWhileTrueOnFileContent = func(
+	pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 2 {
+		return false, fmt.Errorf(
+			"validateBuildFileInsecureDownloads requires exactly 2 arguments: got %v: %w",
+			len(args), errInvalidArgLength)
+	}
+	commands, ok := args[0].(func(content []byte) []buildCommand)
+	if !ok {
+		return false, fmt.Errorf(
+			"validateBuildFileInsecureDownloads requires argument of type func([]byte) []buildCommand: %w",
+			errInvalidArgType)
+	}
+	pdata := dataAsPinnedDependenciesPointer(args[1])
+
+	if fileIsInVendorDir(pathfn) {
+		return true, nil
+	}
+
+	// Files downloaded by a command may be run by the next ones.
+	taintedFiles := make(map[string]bool)
+	for _, cmd := range commands(content) {
+		// Lines of the shell nodes start at 1.
+		if err := validateShellFileAndRecord(pathfn, cmd.line-1, cmd.line-1,
+			[]byte(cmd.content), taintedFiles, pdata); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// makefile holds the variables of a Makefile whose value is statically known.
+type makefile struct {
+	vars map[string]string
+	// defined are all the variables assigned, including those whose value is not known.
+	defined map[string]bool
+}
+
+// makefileCommands returns the recipe lines of a Makefile, as well as the commands
+// of `$(shell ...)` calls and `!=` assignments outside of recipes.
+func makefileCommands(content []byte) []buildCommand {
+	m := makefile{
+		vars:    make(map[string]string),
+		defined: make(map[string]bool),
+	}
+	var commands []buildCommand
+	lines := strings.Split(string(content), "\n")
+	inRule, inDefine := false, false
+	for i := 0; i < len(lines); i++ {
+		start := uint(i + 1)
+		physical := []string{lines[i]}
+		for strings.HasSuffix(lines[i], `\`) && i+1 < len(lines) {
+			i++
+			physical = append(physical, lines[i])
+		}
+
+		if inDefine {
+			if strings.TrimSpace(physical[0]) == "endef" {
+				inDefine = false
+			}
+			continue
+		}
+
+		if inRule && strings.HasPrefix(physical[0], "\t") {
+			// The shell receives the backslash-newlines of a recipe, without the
+			// recipe prefix of the continuation lines, which keeps lines aligned.
+			for j := range physical {
+				physical[j] = strings.TrimPrefix(physical[j], "\t")
+			}
+			recipe := strings.TrimLeft(strings.Join(physical, "\n"), "@+- \t")
+			if recipe == "" || strings.HasPrefix(recipe, "#") {
+				continue
+			}
+			commands = append(commands, buildCommand{content: m.expand(recipe, 0), line: start})
+			continue
+		}
+
+		var logical []string
+		for _, p := range physical {
+			logical = append(logical, strings.TrimSuffix(strings.TrimSpace(p), `\`))
+		}
+		text, _, _ := strings.Cut(strings.Join(logical, " "), "#")
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+
+		switch match := reMakeVariable.FindStringSubmatch(text); {
+		case strings.HasPrefix(text, "define "):
+			name := strings.Fields(text)[1]
+			m.defined[name] = true
+			delete(m.vars, name)
+			inDefine = true
+		case match != nil:
+			inRule = false
+			commands = append(commands, m.assign(match[1], match[2], match[3], start)...)
+		case reMakeDirective.MatchString(text):
+			commands = append(commands, m.shellCalls(text, start)...)
+		case strings.Contains(text, ":"):
+			inRule = true
+			commands = append(commands, m.shellCalls(text, start)...)
+			// A recipe may start on the line of the rule, e.g. `all: ; echo done`.
+			if _, recipe, found := strings.Cut(text, ";"); found && strings.TrimSpace(recipe) != "" {
+				commands = append(commands, buildCommand{content: m.expand(strings.TrimSpace(recipe), 0), line: start})
+			}
+		default:
+			inRule = false
+			commands = append(commands, m.shellCalls(text, start)...)
+		}
+	}
+	return commands
+}
+
+// assign records the assignment of a variable and returns the commands it runs.
+func (m *makefile) assign(name, op, value string, line uint) []buildCommand {
+	commands := m.shellCalls(value, line)
+	switch {
+	case op == "!=":
+		commands = append(commands, buildCommand{content: m.expand(value, 0), line: line})
+		delete(m.vars, name)
+	case strings.Contains(value, "$(shell") || strings.Contains(value, "${shell"):
+		delete(m.vars, name)
+	case op == "?=" && m.defined[name]:
+	case op == "+=":
+		if v, ok := m.vars[name]; ok {
+			m.vars[name] = strings.TrimSpace(v + " " + value)
+		} else if !m.defined[name] {
+			m.vars[name] = value
+		}
+	case strings.HasPrefix(op, ":"):
+		// Simply expanded variables are expanded when defined. The `$`
+		// of their value are escaped again to be expanded only once.
+		m.vars[name] = strings.ReplaceAll(m.expand(value, 0), "$", "$$")
+	default:
+		m.vars[name] = value
+	}
+	m.defined[name] = true
+	return commands
+}
+
+// shellCalls returns the commands of the `$(shell ...)` calls of a line.
+func (m *makefile) shellCalls(text string, line uint) []buildCommand {
+	var commands []buildCommand
+	for i := 0; i < len(text)-1; i++ {
+		if text[i] != '$' || (text[i+1] != '(' && text[i+1] != '{') {
+			continue
+		}
+		end := matchingParen(text, i+1)
+		if end < 0 {
+			break
+		}
+		if cmd, found := strings.CutPrefix(text[i+2:end], "shell "); found {
+			commands = append(commands, buildCommand{content: m.expand(strings.TrimSpace(cmd), 0), line: line})
+		}
+		i = end
+	}
+	return commands
+}
+
+// expand replaces the references to the variables of the Makefile whose value
+// is known and `$$`, leaving other references and function calls untouched.
+// `$(shell ...)` becomes a command substitution.
+func (m *makefile) expand(s string, depth int) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '$' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		switch s[i+1] {
+		case '$':
+			b.WriteByte('$')
+			i++
+		case '(', '{':
+			end := matchingParen(s, i+1)
+			if end < 0 {
+				b.WriteString(s[i:])
+				return b.String()
+			}
+			ref := s[i+2 : end]
+			value, known := m.vars[ref]
+			switch {
+			case strings.HasPrefix(ref, "shell "):
+				b.WriteString("$(" + m.expand(strings.TrimPrefix(ref, "shell "), depth) + ")")
+			case known && depth < maxExpansionDepth:
+				b.WriteString(m.expand(value, depth+1))
+			default:
+				b.WriteString(s[i : end+1])
+			}
+			i = end
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
+
+// matchingParen returns the index of the parenthesis or brace closing the one at open, or -1.
+func matchingParen(s string, open int) int {
+	closing := byte(')')
+	if s[open] == '{' {
+		closing = '}'
+	}
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch s[i] {
+		case s[open]:
+			depth++
+		case closing:
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// justfileCommands returns the recipe lines of a justfile, and the commands of
+// backticks in its assignments. The body of a recipe with a shebang is returned
+// as a whole if its interpreter is a shell.
+func justfileCommands(content []byte) []buildCommand {
+	vars := make(map[string]string)
+	expand := func(s string) string {
+		return reJustInterpolation.ReplaceAllStringFunc(s, func(ref string) string {
+			name := reJustInterpolation.FindStringSubmatch(ref)[1]
+			if v, ok := vars[name]; ok {
+				return v
+			}
+			return ref
+		})
+	}
+	var commands []buildCommand
+	lines := strings.Split(string(content), "\n")
+	for i := 0; i < len(lines); i++ {
+		text := strings.TrimSpace(lines[i])
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, "[") {
+			continue
+		}
+		if match := reJustVariable.FindStringSubmatch(text); match != nil {
+			name, value := match[1], strings.TrimSpace(match[2])
+			for _, cmd := range reBacktick.FindAllStringSubmatch(value, -1) {
+				commands = append(commands, buildCommand{content: expand(cmd[1]), line: uint(i + 1)})
+			}
+			if s := quotedStrings(value); len(s) == 1 && (value == `"`+s[0]+`"` || value == `'`+s[0]+`'`) {
+				vars[name] = s[0]
+			} else {
+				delete(vars, name)
+			}
+			continue
+		}
+		if !reJustRecipe.MatchString(text) {
+			continue
+		}
+
+		// The body of a recipe is indented.
+		start := i + 1
+		end := start
+		for end < len(lines) && (lines[end] == "" || strings.HasPrefix(lines[end], " ") ||
+			strings.HasPrefix(lines[end], "\t")) {
+			end++
+		}
+		i = end - 1
+		body := lines[start:end]
+		if len(body) == 0 {
+			continue
+		}
+		indent := len(body[0]) - len(strings.TrimLeft(body[0], " \t"))
+
+		if strings.HasPrefix(strings.TrimSpace(body[0]), "#!") {
+			script := make([]string, 0, len(body))
+			for _, l := range body {
+				if len(l) >= indent {
+					l = l[indent:]
+				}
+				script = append(script, l)
+			}
+			content := strings.Join(script, "\n")
+			if isSupportedShellScriptFile("", []byte(content)) {
+				commands = append(commands, buildCommand{content: expand(content), line: uint(start + 1)})
+			}
+			continue
+		}
+
+		for j := 0; j < len(body); j++ {
+			line := uint(start + j + 1)
+			physical := []string{strings.TrimSpace(body[j])}
+			for strings.HasSuffix(body[j], `\`) && j+1 < len(body) {
+				j++
+				physical = append(physical, strings.TrimSpace(body[j]))
+			}
+			recipe := strings.TrimLeft(strings.Join(physical, "\n"), "@-")
+			if recipe == "" || strings.HasPrefix(recipe, "#") {
+				continue
+			}
+			commands = append(commands, buildCommand{content: expand(recipe), line: line})
+		}
+	}
+	return commands
+}
+
+// taskfile holds the variables of a Taskfile whose value is statically known.
+type taskfile struct {
+	vars map[string]string
+}
+
+// taskfileCommands returns the commands of the tasks of a Taskfile, and
+// of its dynamic variables.
+func taskfileCommands(content []byte) []buildCommand {
+	var doc yaml.Node
+	// Taskfiles which do not parse cannot be run either.
+	if err := yaml.Unmarshal(content, &doc); err != nil || len(doc.Content) == 0 {
+		return nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil
+	}
+	t := taskfile{vars: make(map[string]string)}
+	commands := t.assign(mappingValue(root, "vars"))
+	tasks := mappingValue(root, "tasks")
+	if tasks == nil || tasks.Kind != yaml.MappingNode {
+		return commands
+	}
+	for i := 1; i < len(tasks.Content); i += 2 {
+		commands = append(commands, t.taskCommands(tasks.Content[i])...)
+	}
+	return commands
+}
+
+func (t *taskfile) taskCommands(task *yaml.Node) []buildCommand {
+	// A task may be a list of commands.
+	if task.Kind == yaml.SequenceNode {
+		return t.commands(task)
+	}
+	if task.Kind != yaml.MappingNode {
+		return nil
+	}
+	// Variables of a task only apply to its own commands.
+	scoped := taskfile{vars: make(map[string]string, len(t.vars))}
+	for k, v := range t.vars {
+		scoped.vars[k] = v
+	}
+	commands := scoped.assign(mappingValue(task, "vars"))
+	if cmd := mappingValue(task, "cmd"); cmd != nil && cmd.Kind == yaml.ScalarNode {
+		commands = append(commands, scoped.command(cmd))
+	}
+	for _, key := range []string{"cmds", "status", "preconditions"} {
+		commands = append(commands, scoped.commands(mappingValue(task, key))...)
+	}
+	return commands
+}
+
+// commands returns the commands of a list, e.g. of `cmds`. Items are either a
+// command or a mapping with a `cmd`, `sh` or `defer` command; calls to other tasks are skipped.
+func (t *taskfile) commands(list *yaml.Node) []buildCommand {
+	if list == nil || list.Kind != yaml.SequenceNode {
+		return nil
+	}
+	var commands []buildCommand
+	for _, item := range list.Content {
+		switch item.Kind {
+		case yaml.ScalarNode:
+			commands = append(commands, t.command(item))
+		case yaml.MappingNode:
+			for _, key := range []string{"cmd", "sh", "defer"} {
+				if cmd := mappingValue(item, key); cmd != nil && cmd.Kind == yaml.ScalarNode {
+					commands = append(commands, t.command(cmd))
+				}
+			}
+		}
+	}
+	return commands
+}
+
+// assign records the variables of a `vars` mapping and returns the commands of dynamic variables.
+func (t *taskfile) assign(vars *yaml.Node) []buildCommand {
+	if vars == nil || vars.Kind != yaml.MappingNode {
+		return nil
+	}
+	var commands []buildCommand
+	for i := 0; i+1 < len(vars.Content); i += 2 {
+		name, value := vars.Content[i].Value, vars.Content[i+1]
+		if value.Kind == yaml.ScalarNode {
+			t.vars[name] = t.expand(value.Value)
+			continue
+		}
+		delete(t.vars, name)
+		if sh := mappingValue(value, "sh"); sh != nil && sh.Kind == yaml.ScalarNode {
+			commands = append(commands, t.command(sh))
+		}
+	}
+	return commands
+}
+
+func (t *taskfile) command(node *yaml.Node) buildCommand {
+	// The content of block scalars starts on the line after their indicator.
+	line := uint(node.Line)
+	if node.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 {
+		line++
+	}
+	return buildCommand{content: t.expand(node.Value), line: line}
+}
+
+func (t *taskfile) expand(s string) string {
+	return reTaskTemplate.ReplaceAllStringFunc(s, func(ref string) string {
+		name := reTaskTemplate.FindStringSubmatch(ref)[1]
+		if v, ok := t.vars[name]; ok {
+			return v
+		}
+		return ref
+	})
+}
+
+// mappingValue returns the value of a key of a YAML mapping, or nil.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
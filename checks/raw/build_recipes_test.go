@@ -0,0 +1,212 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+)
+
+func TestBuildFileCommands(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		commands func(content []byte) []buildCommand
+		filename string
+		want     []buildCommand
+	}{
+		{
+			filename: "Makefile",
+			commands: makefileCommands,
+			want: []buildCommand{
+				{line: 4, content: "curl -sSL https://example.com/version | sh"},
+				{line: 10, content: "go install golang.org/x/tools/cmd/stringer@latest"},
+				{line: 11, content: "curl -sSL https://example.com/install.sh | bash"},
+				{line: 12, content: "wget -qO- https://example.com/setup.sh \\\n\t| sudo bash"},
+				{line: 15, content: "curl -fsSL https://example.com/fetch.sh | sh"},
+				{line: 16, content: `go build -ldflags "-X main.version=$(VERSION)" ./...`},
+				{line: 17, content: "echo $HOME"},
+				{line: 20, content: "shellcheck scripts/*.sh"},
+			},
+		},
+		{
+			filename: "justfile",
+			commands: justfileCommands,
+			want: []buildCommand{
+				{line: 4, content: "curl -sSL https://example.com/version.sh | sh"},
+				{line: 8, content: "go install github.com/golangci/golangci-lint/cmd/golangci-lint@latest"},
+				{line: 9, content: "curl -sSL https://example.com/install.sh | bash"},
+				{
+					line: 13,
+					content: "#!/usr/bin/env bash\nset -euo pipefail\n" +
+						"wget -qO- https://example.com/{{target}}.sh | bash\n",
+				},
+			},
+		},
+		{
+			filename: "Taskfile.yml",
+			commands: taskfileCommands,
+			want: []buildCommand{
+				{line: 6, content: "git rev-parse HEAD"},
+				{line: 11, content: "go install golang.org/x/vuln/cmd/govulncheck@latest"},
+				{line: 12, content: "curl -sSL https://example.com/install.sh | bash"},
+				{line: 17, content: "echo building {{.COMMIT}}\nwget -qO- https://example.com/setup.sh | sh\n"},
+				{line: 20, content: "shellcheck scripts/*.sh"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.filename, func(t *testing.T) {
+			t.Parallel()
+			content, err := os.ReadFile(filepath.Join("testdata", "build-files", tt.filename))
+			if err != nil {
+				t.Fatalf("cannot read file: %v", err)
+			}
+			got := tt.commands(content)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(buildCommand{})); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMakefileVariables(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "recursively expanded",
+			content: "URL = $(HOST)/install.sh\nHOST = https://example.com\nall:\n\tcurl $(URL)\n",
+			want:    "curl https://example.com/install.sh",
+		},
+		{
+			name:    "simply expanded",
+			content: "HOST := https://example.com\nURL := $(HOST)/install.sh\nHOST := https://example.org\nall:\n\tcurl $(URL)\n",
+			want:    "curl https://example.com/install.sh",
+		},
+		{
+			name:    "conditional assignment",
+			content: "CURL = curl -sSL\nCURL ?= wget\nall:\n\t$(CURL) $${URL}\n",
+			want:    "curl -sSL ${URL}",
+		},
+		{
+			name:    "appended",
+			content: "FLAGS = -s\nFLAGS += -L\nall:\n\tcurl $(FLAGS) $(URL)\n",
+			want:    "curl -s -L $(URL)",
+		},
+		{
+			name:    "shell call",
+			content: "all:\n\techo $(shell uname -s)\n",
+			want:    "echo $(uname -s)",
+		},
+		{
+			name:    "defined variable",
+			content: "define SCRIPT\ncurl $(URL)\nendef\nall:\n\t$(SCRIPT)\n",
+			want:    "$(SCRIPT)",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			commands := makefileCommands([]byte(tt.content))
+			if len(commands) != 1 {
+				t.Fatalf("got %d commands, want 1: %v", len(commands), commands)
+			}
+			if commands[0].content != tt.want {
+				t.Errorf("got %q, want %q", commands[0].content, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildFileDownloads(t *testing.T) {
+	t.Parallel()
+	type download struct {
+		Type checker.DependencyUseType
+		Line uint
+	}
+	tests := []struct {
+		commands func(content []byte) []buildCommand
+		filename string
+		want     []download
+	}{
+		{
+			filename: "Makefile",
+			commands: makefileCommands,
+			want: []download{
+				{Line: 4, Type: checker.DependencyUseTypeDownloadThenRun},
+				{Line: 10, Type: checker.DependencyUseTypeGoCommand},
+				{Line: 11, Type: checker.DependencyUseTypeDownloadThenRun},
+				{Line: 12, Type: checker.DependencyUseTypeDownloadThenRun},
+				{Line: 15, Type: checker.DependencyUseTypeDownloadThenRun},
+			},
+		},
+		{
+			filename: "justfile",
+			commands: justfileCommands,
+			want: []download{
+				{Line: 4, Type: checker.DependencyUseTypeDownloadThenRun},
+				{Line: 8, Type: checker.DependencyUseTypeGoCommand},
+				{Line: 9, Type: checker.DependencyUseTypeDownloadThenRun},
+				{Line: 15, Type: checker.DependencyUseTypeDownloadThenRun},
+			},
+		},
+		{
+			filename: "Taskfile.yml",
+			commands: taskfileCommands,
+			want: []download{
+				{Line: 11, Type: checker.DependencyUseTypeGoCommand},
+				{Line: 12, Type: checker.DependencyUseTypeDownloadThenRun},
+				{Line: 18, Type: checker.DependencyUseTypeDownloadThenRun},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.filename, func(t *testing.T) {
+			t.Parallel()
+			content, err := os.ReadFile(filepath.Join("testdata", "build-files", tt.filename))
+			if err != nil {
+				t.Fatalf("cannot read file: %v", err)
+			}
+			var r checker.PinningDependenciesData
+			if _, err := validateBuildFileInsecureDownloads(tt.filename, content, tt.commands, &r); err != nil {
+				t.Fatalf("validateBuildFileInsecureDownloads: %v", err)
+			}
+			if len(r.ProcessingErrors) != 0 {
+				t.Errorf("unexpected processing errors: %v", r.ProcessingErrors)
+			}
+			var got []download
+			for _, d := range r.Dependencies {
+				if d.Pinned != nil && *d.Pinned {
+					continue
+				}
+				got = append(got, download{Line: d.Location.Offset, Type: d.Type})
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
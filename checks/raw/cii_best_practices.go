@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
 )
 
 var errEmptyClient = errors.New("CII client is nil")
@@ -30,6 +31,17 @@ func CIIBestPractices(c *checker.CheckRequest) (checker.CIIBestPracticesData, er
 		return results, fmt.Errorf("%w", errEmptyClient)
 	}
 
+	// The criteria are left unknown if the client doesn't return them.
+	if client, ok := c.CIIClient.(clients.CIIBestPracticesBadgeClient); ok {
+		badge, criteria, err := client.GetBadge(c.Ctx, c.Repo.URI())
+		if err != nil {
+			return results, fmt.Errorf("%w", err)
+		}
+		results.Badge = badge
+		results.Criteria = criteria
+		return results, nil
+	}
+
 	badge, err := c.CIIClient.GetBadgeLevel(c.Ctx, c.Repo.URI())
 	if err != nil {
 		return results, fmt.Errorf("%w", err)
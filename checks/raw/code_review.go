@@ -15,17 +15,40 @@
 package raw
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/clients/gerrit"
 )
 
+// gerritReviewsEnvVar enables fetching the votes of Gerrit changes from the servers
+// named in Reviewed-on trailers. It is opt-in since these are arbitrary third-party hosts.
+const gerritReviewsEnvVar = "SCORECARD_GERRIT_REVIEWS"
+
+var gerritReviewedOn = regexp.MustCompile(`(?m)^Reviewed-on:\s*(\S+)`)
+
+// gerritChangeClient fetches the Gerrit change of a Reviewed-on trailer.
+type gerritChangeClient interface {
+	GetChange(reviewedOn string) (*gerrit.Change, error)
+}
+
 // CodeReview retrieves the raw data for the Code-Review check.
-func CodeReview(c clients.RepoClient) (checker.CodeReviewData, error) {
+// ctx bounds the requests to Gerrit servers, if enabled.
+func CodeReview(ctx context.Context, c clients.RepoClient) (checker.CodeReviewData, error) {
+	var g gerritChangeClient
+	if value, _ := os.LookupEnv(gerritReviewsEnvVar); value == "1" {
+		g = gerrit.CreateGerritClient(ctx, nil)
+	}
+	return codeReview(c, g)
+}
+
+func codeReview(c clients.RepoClient, g gerritChangeClient) (checker.CodeReviewData, error) {
 	// Look at the latest commits.
 	commits, err := c.ListCommits()
 	if err != nil {
@@ -34,8 +57,8 @@ func CodeReview(c clients.RepoClient) (checker.CodeReviewData, error) {
 
 	changesets := getChangesets(commits)
 
-	if err != nil {
-		return checker.CodeReviewData{}, fmt.Errorf("%w", err)
+	if g != nil {
+		addGerritReviews(changesets, g)
 	}
 
 	return checker.CodeReviewData{
@@ -43,6 +66,28 @@ func CodeReview(c clients.RepoClient) (checker.CodeReviewData, error) {
 	}, nil
 }
 
+// addGerritReviews sets the owner and the Code-Review votes of the Gerrit changesets.
+// The reviews of changes which cannot be fetched, e.g. from private or unreachable
+// servers, are left unknown.
+func addGerritReviews(changesets []checker.Changeset, g gerritChangeClient) {
+	for i := range changesets {
+		changeset := &changesets[i]
+		if changeset.ReviewPlatform != checker.ReviewPlatformGerrit {
+			continue
+		}
+		m := gerritReviewedOn.FindStringSubmatch(changeset.Commits[0].Message)
+		if m == nil {
+			continue
+		}
+		change, err := g.GetChange(m[1])
+		if err != nil {
+			continue
+		}
+		changeset.Author = change.Owner
+		changeset.Reviews = change.Reviews
+	}
+}
+
 func getGithubRevisionID(c *clients.Commit) string {
 	mr := c.AssociatedMergeRequest
 	if !c.AssociatedMergeRequest.MergedAt.IsZero() && mr.Number != 0 {
@@ -171,6 +216,11 @@ func getChangesets(commits []clients.Commit) []checker.Changeset {
 			if rev.Platform == checker.ReviewPlatformGitHub {
 				newChangeset.Reviews = getGithubReviews(&commits[i])
 				newChangeset.Author = getGithubAuthor(&commits[i])
+				mr := &commits[i].AssociatedMergeRequest
+				newChangeset.MergedBy = mr.MergedBy
+				newChangeset.HeadSHA = mr.HeadSHA
+				newChangeset.HeadCommittedAt = mr.HeadCommittedAt
+				newChangeset.CommitAuthors = mr.CommitAuthors
 			}
 
 			changesetsByRevInfo[rev] = newChangeset
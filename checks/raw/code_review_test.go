@@ -15,14 +15,21 @@
 package raw
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/clients/gerrit"
+	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
 )
 
 // TestCodeReviews tests the CodeReviews function.
@@ -371,3 +378,67 @@ func Test_getChangesets(t *testing.T) {
 		}
 	}
 }
+
+func Test_codeReviewGerrit(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/changes/scorecard~12345/detail" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `)]}'
+{
+  "owner": {"_account_id": 1, "username": "alice"},
+  "labels": {"Code-Review": {"all": [{"_account_id": 2, "username": "bob", "value": 2}]}}
+}`)
+	}))
+	defer srv.Close()
+
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	commit := clients.Commit{
+		SHA:     "abc",
+		Message: "change\n\nReviewed-on: " + srv.URL + "/c/scorecard/+/12345\nReviewed-by: Bob <bob@example.com>",
+	}
+	mockRepoClient.EXPECT().ListCommits().Return([]clients.Commit{commit}, nil)
+
+	got, err := codeReview(mockRepoClient, gerrit.CreateGerritClient(context.Background(), srv.Client()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []checker.Changeset{
+		{
+			ReviewPlatform: checker.ReviewPlatformGerrit,
+			RevisionID:     "abc",
+			Commits:        []clients.Commit{commit},
+			Author:         clients.User{Login: "alice", ID: 1},
+			Reviews: []clients.Review{
+				{Author: &clients.User{Login: "bob", ID: 2}, State: "APPROVED"},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got.DefaultBranchChangesets); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+
+	// The reviews of changes the server does not know about are left unknown.
+	unknown := clients.Commit{
+		SHA:     "def",
+		Message: "change\n\nReviewed-on: " + srv.URL + "/c/scorecard/+/999\nReviewed-by: Bob <bob@example.com>",
+	}
+	mockRepoClient.EXPECT().ListCommits().Return([]clients.Commit{unknown}, nil)
+	got, err = codeReview(mockRepoClient, gerrit.CreateGerritClient(context.Background(), srv.Client()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want = []checker.Changeset{
+		{
+			ReviewPlatform: checker.ReviewPlatformGerrit,
+			RevisionID:     "def",
+			Commits:        []clients.Commit{unknown},
+		},
+	}
+	if diff := cmp.Diff(want, got.DefaultBranchChangesets); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,336 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+// codeownersLocations are the locations of the CODEOWNERS file, in the order
+// GitHub and GitLab look for them. Files elsewhere are ignored by the platforms.
+var codeownersLocations = []string{
+	".github/CODEOWNERS",
+	"CODEOWNERS",
+	"docs/CODEOWNERS",
+	".gitlab/CODEOWNERS",
+}
+
+var (
+	// [Section][2] @default-owner, ^[Optional Section].
+	codeownersSection = regexp.MustCompile(`^(\^)?\[([^\]]+)\](?:\[(\d+)\])?(.*)$`)
+	// @user, @org/team, @group/subgroup, @@role or an email address.
+	codeownersOwner = regexp.MustCompile(`^(?:@@?[\w.-]+(?:/[\w.-]+)*|[^@\s]+@[^@\s]+\.[^@\s]+)$`)
+	releaseScript   = regexp.MustCompile(`(?i)(?:^|[-_.])(?:release|publish|deploy)(?:[-_.]|$)`)
+)
+
+// collectCodeowners parses the CODEOWNERS file used by the platform and
+// computes the owners of the security-sensitive files of the repository.
+func collectCodeowners(c clients.RepoClient, codeownersFiles []string, data *checker.BranchProtectionsData) error {
+	files, err := c.ListFiles(func(string) (bool, error) { return true, nil })
+	if err != nil {
+		return fmt.Errorf("RepoClient.ListFiles: %w", err)
+	}
+	for _, name := range files {
+		if kind := sensitivePathKind(name); kind != "" {
+			data.SensitivePaths = append(data.SensitivePaths, checker.SensitivePath{Path: name, Kind: kind})
+		}
+	}
+
+	codeownersPath := ""
+	for _, location := range codeownersLocations {
+		for _, name := range codeownersFiles {
+			if name == location && codeownersPath == "" {
+				codeownersPath = name
+			}
+		}
+	}
+	if codeownersPath == "" {
+		return nil
+	}
+	r, err := c.GetFileReader(codeownersPath)
+	if err != nil {
+		return fmt.Errorf("RepoClient.GetFileReader: %w", err)
+	}
+	content, err := io.ReadAll(r)
+	r.Close()
+	if err != nil {
+		return fmt.Errorf("reading file: %w", err)
+	}
+
+	codeowners, matchers := parseCodeowners(content)
+	codeowners.Path = codeownersPath
+	for i := range codeowners.Rules {
+		rule := &codeowners.Rules[i]
+		if matchers[i] == nil || matchesAnyFile(matchers[i], files) {
+			continue
+		}
+		codeowners.Errors = append(codeowners.Errors, checker.CodeownersError{
+			Line: rule.Line,
+			Text: fmt.Sprintf("pattern '%s' does not match any file", rule.Pattern),
+		})
+	}
+	for i := range data.SensitivePaths {
+		data.SensitivePaths[i].Owners = codeownersOf(codeowners.Rules, matchers, data.SensitivePaths[i].Path)
+	}
+	data.Codeowners = codeowners
+	return nil
+}
+
+// parseCodeowners parses a CODEOWNERS file in the GitHub or GitLab syntax.
+// It returns the rules and, for each rule, the regular expression
+// matching its pattern, or nil if the pattern is invalid.
+// https://docs.github.com/en/repositories/managing-your-repositorys-settings-and-features/customizing-your-repository/about-code-owners
+// https://docs.gitlab.com/ee/user/project/codeowners/reference.html
+func parseCodeowners(content []byte) (*checker.Codeowners, []*regexp.Regexp) {
+	codeowners := &checker.Codeowners{}
+	var matchers []*regexp.Regexp
+	var section string
+	var sectionOwners []string
+	var approvals int
+	var optional bool
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	var line uint
+	for scanner.Scan() {
+		line++
+		text := stripCodeownersComment(scanner.Text())
+		if text == "" {
+			continue
+		}
+		if strings.HasPrefix(text, "[") || strings.HasPrefix(text, "^[") {
+			m := codeownersSection.FindStringSubmatch(text)
+			if m == nil {
+				codeowners.Errors = append(codeowners.Errors, checker.CodeownersError{
+					Line: line,
+					Text: fmt.Sprintf("invalid section header '%s'", text),
+				})
+				continue
+			}
+			optional = m[1] != ""
+			section = strings.TrimSpace(m[2])
+			approvals = 0
+			if m[3] != "" {
+				approvals, _ = strconv.Atoi(m[3])
+			}
+			sectionOwners = strings.Fields(m[4])
+			for _, owner := range sectionOwners {
+				if !codeownersOwner.MatchString(owner) {
+					codeowners.Errors = append(codeowners.Errors, checker.CodeownersError{
+						Line: line,
+						Text: fmt.Sprintf("invalid owner '%s'", owner),
+					})
+				}
+			}
+			continue
+		}
+
+		fields := splitCodeownersLine(text)
+		rule := checker.CodeownersRule{
+			Pattern:   fields[0],
+			Section:   section,
+			Owners:    fields[1:],
+			Approvals: approvals,
+			Line:      line,
+			Optional:  optional,
+		}
+		if len(rule.Owners) == 0 {
+			rule.Owners = sectionOwners
+		}
+		for _, owner := range rule.Owners {
+			if !codeownersOwner.MatchString(owner) {
+				codeowners.Errors = append(codeowners.Errors, checker.CodeownersError{
+					Line: line,
+					Text: fmt.Sprintf("invalid owner '%s'", owner),
+				})
+			}
+		}
+		matcher, err := codeownersPattern(rule.Pattern)
+		if err != nil {
+			codeowners.Errors = append(codeowners.Errors, checker.CodeownersError{
+				Line: line,
+				Text: fmt.Sprintf("invalid pattern '%s': %v", rule.Pattern, err),
+			})
+		}
+		codeowners.Rules = append(codeowners.Rules, rule)
+		matchers = append(matchers, matcher)
+	}
+	return codeowners, matchers
+}
+
+// stripCodeownersComment removes comments and surrounding spaces.
+// A "#" starts a comment at the beginning of a line or after a space.
+func stripCodeownersComment(text string) string {
+	text = strings.TrimSpace(text)
+	if strings.HasPrefix(text, "#") {
+		return ""
+	}
+	for i := 1; i < len(text); i++ {
+		if text[i] == '#' && (text[i-1] == ' ' || text[i-1] == '\t') {
+			return strings.TrimSpace(text[:i])
+		}
+	}
+	return text
+}
+
+// splitCodeownersLine splits a line into a pattern and owners,
+// keeping spaces escaped with a backslash in the pattern.
+func splitCodeownersLine(text string) []string {
+	var fields []string
+	var field strings.Builder
+	for i := 0; i < len(text); i++ {
+		switch {
+		case text[i] == '\\' && i+1 < len(text):
+			i++
+			field.WriteByte(text[i])
+		case text[i] == ' ' || text[i] == '\t':
+			if field.Len() > 0 {
+				fields = append(fields, field.String())
+				field.Reset()
+			}
+		default:
+			field.WriteByte(text[i])
+		}
+	}
+	if field.Len() > 0 {
+		fields = append(fields, field.String())
+	}
+	return fields
+}
+
+// codeownersPattern converts a gitignore-style pattern to a regular expression.
+func codeownersPattern(pattern string) (*regexp.Regexp, error) {
+	if strings.HasPrefix(pattern, "!") {
+		return nil, errNegatedPattern
+	}
+	p := strings.TrimPrefix(pattern, "/")
+	// Patterns with a slash other than a trailing one are relative to the root.
+	anchored := strings.HasPrefix(pattern, "/") || strings.Contains(strings.TrimSuffix(p, "/"), "/")
+	dirOnly := strings.HasSuffix(p, "/")
+	p = strings.TrimSuffix(p, "/")
+
+	var re strings.Builder
+	if anchored {
+		re.WriteString("^")
+	} else {
+		re.WriteString("^(?:.*/)?")
+	}
+	for i := 0; i < len(p); i++ {
+		switch {
+		case strings.HasPrefix(p[i:], "**/"):
+			re.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "**"):
+			re.WriteString(".*")
+			i++
+		case p[i] == '*':
+			re.WriteString("[^/]*")
+		case p[i] == '?':
+			re.WriteString("[^/]")
+		default:
+			re.WriteString(regexp.QuoteMeta(p[i : i+1]))
+		}
+	}
+	switch {
+	case dirOnly:
+		re.WriteString("/.*$")
+	case strings.HasSuffix(p, "*") && !strings.HasSuffix(p, "**"):
+		// GitHub does not match nested files with "docs/*".
+		re.WriteString("$")
+	default:
+		// A pattern matching a directory matches all files in it.
+		re.WriteString("(?:/.*)?$")
+	}
+	//nolint:wrapcheck
+	return regexp.Compile(re.String())
+}
+
+func matchesAnyFile(matcher *regexp.Regexp, files []string) bool {
+	for _, name := range files {
+		if matcher.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// codeownersOf returns the owners of a file. The last matching rule
+// of each section applies, and the owners of all sections are combined.
+func codeownersOf(rules []checker.CodeownersRule, matchers []*regexp.Regexp, name string) []string {
+	sectionOwners := map[string][]string{}
+	var sections []string
+	for i := range rules {
+		if matchers[i] == nil || !matchers[i].MatchString(name) {
+			continue
+		}
+		section := strings.ToLower(rules[i].Section)
+		if _, ok := sectionOwners[section]; !ok {
+			sections = append(sections, section)
+		}
+		sectionOwners[section] = rules[i].Owners
+	}
+	owners := []string{}
+	seen := map[string]bool{}
+	for _, section := range sections {
+		for _, owner := range sectionOwners[section] {
+			if !seen[owner] {
+				seen[owner] = true
+				owners = append(owners, owner)
+			}
+		}
+	}
+	return owners
+}
+
+// sensitivePathKind returns the kind of a security-sensitive file,
+// or an empty kind for other files.
+func sensitivePathKind(name string) checker.SensitivePathKind {
+	base := path.Base(name)
+	ext := path.Ext(base)
+	switch {
+	case path.Dir(name) == ".github/workflows" && (ext == ".yml" || ext == ".yaml"),
+		name == ".gitlab-ci.yml":
+		return checker.SensitivePathWorkflow
+	case base == ".goreleaser.yml", base == ".goreleaser.yaml",
+		releaseScript.MatchString(strings.TrimSuffix(base, ext)) && isScript(ext):
+		return checker.SensitivePathReleaseScript
+	}
+	ecosystem, _ := dependencyManifestEcosystem(name)
+	switch ecosystem {
+	case "":
+		return ""
+	case "docker":
+		return checker.SensitivePathDockerfile
+	default:
+		return checker.SensitivePathDependencyManifest
+	}
+}
+
+func isScript(ext string) bool {
+	switch ext {
+	case "", ".sh", ".bash", ".py", ".js", ".ts", ".ps1", ".rb", ".mk":
+		return true
+	}
+	return false
+}
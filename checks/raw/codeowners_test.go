@@ -0,0 +1,187 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
+)
+
+func Test_codeownersPattern(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	tests := []struct {
+		pattern string
+		matches []string
+		misses  []string
+	}{
+		{
+			pattern: "*",
+			matches: []string{"go.mod", "a/b/c.go"},
+		},
+		{
+			pattern: "*.js",
+			matches: []string{"app.js", "web/app.js"},
+			misses:  []string{"app.jsx"},
+		},
+		{
+			pattern: "/build/logs/",
+			matches: []string{"build/logs/a.log", "build/logs/x/y.log"},
+			misses:  []string{"src/build/logs/a.log", "build/logs"},
+		},
+		{
+			pattern: "docs/*",
+			matches: []string{"docs/getting-started.md"},
+			misses:  []string{"docs/build-app/troubleshooting.md", "src/docs/a.md"},
+		},
+		{
+			pattern: "apps/",
+			matches: []string{"apps/a.go", "src/apps/b/c.go"},
+		},
+		{
+			pattern: "**/logs",
+			matches: []string{"logs/a", "build/logs/b", "a/b/logs/c/d"},
+		},
+		{
+			pattern: ".github/workflows",
+			matches: []string{".github/workflows/ci.yml"},
+			misses:  []string{"x/.github/workflows/ci.yml"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.pattern, func(t *testing.T) {
+			t.Parallel()
+			re, err := codeownersPattern(tt.pattern)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, name := range tt.matches {
+				if !re.MatchString(name) {
+					t.Errorf("%s does not match %s", tt.pattern, name)
+				}
+			}
+			for _, name := range tt.misses {
+				if re.MatchString(name) {
+					t.Errorf("%s matches %s", tt.pattern, name)
+				}
+			}
+		})
+	}
+}
+
+func Test_parseCodeowners(t *testing.T) {
+	t.Parallel()
+	content := `# Comment
+* @org/everyone # default owners
+/docs/  docs@example.com
+
+^[Optional Section][2] @org/security
+.github/
+Dockerfile @alice
+[Broken
+!vendor/ @bob
+go.mod not-an-owner
+`
+	codeowners, matchers := parseCodeowners([]byte(content))
+	wantRules := []checker.CodeownersRule{
+		{Pattern: "*", Owners: []string{"@org/everyone"}, Line: 2},
+		{Pattern: "/docs/", Owners: []string{"docs@example.com"}, Line: 3},
+		{
+			Pattern: ".github/", Section: "Optional Section", Owners: []string{"@org/security"},
+			Approvals: 2, Optional: true, Line: 6,
+		},
+		{
+			Pattern: "Dockerfile", Section: "Optional Section", Owners: []string{"@alice"},
+			Approvals: 2, Optional: true, Line: 7,
+		},
+		{
+			Pattern: "!vendor/", Section: "Optional Section", Owners: []string{"@bob"},
+			Approvals: 2, Optional: true, Line: 9,
+		},
+		{
+			Pattern: "go.mod", Section: "Optional Section", Owners: []string{"not-an-owner"},
+			Approvals: 2, Optional: true, Line: 10,
+		},
+	}
+	if diff := cmp.Diff(wantRules, codeowners.Rules); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+	var errorLines []uint
+	for _, e := range codeowners.Errors {
+		errorLines = append(errorLines, e.Line)
+	}
+	if diff := cmp.Diff([]uint{8, 9, 10}, errorLines); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+	if matchers[4] != nil {
+		t.Errorf("negated pattern has a matcher")
+	}
+}
+
+func Test_collectCodeowners(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{
+		".github/CODEOWNERS",
+		".github/workflows/ci.yml",
+		"CODEOWNERS",
+		"go.mod",
+		"scripts/release.sh",
+		"web/Dockerfile",
+		"README.md",
+	}, nil)
+	mockRepoClient.EXPECT().GetFileReader(".github/CODEOWNERS").Return(io.NopCloser(strings.NewReader(`
+/.github/ @org/security
+go.mod @org/maintainers
+/scripts/ @org/release
+/scripts/release.sh
+/missing/ @org/maintainers
+
+[Containers]
+Dockerfile @org/containers
+`)), nil)
+
+	var data checker.BranchProtectionsData
+	if err := collectCodeowners(mockRepoClient, []string{"CODEOWNERS", ".github/CODEOWNERS"}, &data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.Codeowners.Path != ".github/CODEOWNERS" {
+		t.Errorf("unexpected CODEOWNERS file: %s", data.Codeowners.Path)
+	}
+	wantErrors := []checker.CodeownersError{
+		{Line: 6, Text: "pattern '/missing/' does not match any file"},
+	}
+	if diff := cmp.Diff(wantErrors, data.Codeowners.Errors); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+	wantPaths := []checker.SensitivePath{
+		{Path: ".github/workflows/ci.yml", Kind: checker.SensitivePathWorkflow, Owners: []string{"@org/security"}},
+		{Path: "go.mod", Kind: checker.SensitivePathDependencyManifest, Owners: []string{"@org/maintainers"}},
+		{Path: "scripts/release.sh", Kind: checker.SensitivePathReleaseScript, Owners: []string{}},
+		{Path: "web/Dockerfile", Kind: checker.SensitivePathDockerfile, Owners: []string{"@org/containers"}},
+	}
+	if diff := cmp.Diff(wantPaths, data.SensitivePaths); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
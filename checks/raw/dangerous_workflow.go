@@ -15,6 +15,7 @@
 package raw
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
@@ -23,6 +24,8 @@ import (
 
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/checks/raw/gitlab"
+	"github.com/ossf/scorecard/v4/clients"
 	sce "github.com/ossf/scorecard/v4/errors"
 	"github.com/ossf/scorecard/v4/finding"
 )
@@ -60,10 +63,30 @@ type triggerName string
 var (
 	triggerPullRequestTarget        = triggerName("pull_request_target")
 	triggerWorkflowRun              = triggerName("workflow_run")
+	triggerPullRequest              = triggerName("pull_request")
+	triggerPullRequestReview        = triggerName("pull_request_review")
+	triggerIssueComment             = triggerName("issue_comment")
+	triggerPullRequestReviewComment = triggerName("pull_request_review_comment")
 	checkoutUntrustedPullRequestRef = "github.event.pull_request"
 	checkoutUntrustedWorkflowRunRef = "github.event.workflow_run"
 )
 
+var (
+	// privilegedForkTriggers can be fired from a fork while the workflow
+	// runs with access to the repository's secrets.
+	privilegedForkTriggers = []triggerName{
+		triggerPullRequestTarget,
+		triggerWorkflowRun,
+		triggerIssueComment,
+		triggerPullRequestReviewComment,
+	}
+	// forkTriggers can be fired from a fork.
+	forkTriggers = append([]triggerName{
+		triggerPullRequest,
+		triggerPullRequestReview,
+	}, privilegedForkTriggers...)
+)
+
 // DangerousWorkflow retrieves the raw data for the DangerousWorkflow check.
 func DangerousWorkflow(c *checker.CheckRequest) (checker.DangerousWorkflowData, error) {
 	// data is shared across all GitHub workflows.
@@ -72,8 +95,27 @@ func DangerousWorkflow(c *checker.CheckRequest) (checker.DangerousWorkflowData,
 		Pattern:       ".github/workflows/*",
 		CaseSensitive: false,
 	}, validateGitHubActionWorkflowPatterns, &data)
+	if err != nil {
+		return data, err
+	}
+
+	if err := gitlab.DangerousWorkflow(c, &data); err != nil {
+		return data, err
+	}
 
-	return data, err
+	// Self-hosted runners are only reachable by forks of public repositories.
+	if len(data.SelfHostedRunnerJobs) > 0 {
+		private, err := c.RepoClient.IsPrivate()
+		switch {
+		case errors.Is(err, clients.ErrUnsupportedFeature):
+		case err != nil:
+			return data, fmt.Errorf("RepoClient.IsPrivate: %w", err)
+		default:
+			data.PrivateRepo = &private
+		}
+	}
+
+	return data, nil
 }
 
 // Check file content.
@@ -113,11 +155,18 @@ var validateGitHubActionWorkflowPatterns fileparser.DoWhileTrueOnFileContent = f
 		return false, err
 	}
 
-	// 2. Check for script injection in workflow inline scripts.
+	// 2. Check for script injection in workflow inline scripts, following
+	// untrusted data across the steps of each job.
 	if err := validateScriptInjection(workflow, path, pdata); err != nil {
 		return false, err
 	}
 
+	// 3. Check for secrets inherited by reusable workflows on fork-reachable triggers.
+	validateSecretsInherit(workflow, path, pdata)
+
+	// 4. Record jobs running on self-hosted runners.
+	collectSelfHostedRunnerJobs(workflow, path, pdata)
+
 	// TODO: Check other dangerous patterns.
 	return true, nil
 }
@@ -210,6 +259,192 @@ func checkJobForUntrustedCodeCheckout(job *actionlint.Job, path string,
 	return nil
 }
 
+func validateSecretsInherit(workflow *actionlint.Workflow, path string,
+	pdata *checker.DangerousWorkflowData,
+) {
+	if len(usedTriggers(workflow, privilegedForkTriggers)) == 0 {
+		return
+	}
+
+	for _, job := range workflow.Jobs {
+		if job == nil || job.WorkflowCall == nil || !job.WorkflowCall.InheritSecrets {
+			continue
+		}
+		snippet := "secrets: inherit"
+		if job.WorkflowCall.Uses != nil {
+			snippet = fmt.Sprintf("%s (%s)", snippet, job.WorkflowCall.Uses.Value)
+		}
+		pdata.Workflows = append(pdata.Workflows,
+			checker.DangerousWorkflow{
+				Type: checker.DangerousWorkflowSecretsInherit,
+				File: checker.File{
+					Path:    path,
+					Type:    finding.FileTypeSource,
+					Offset:  fileparser.GetLineNumber(job.Pos),
+					Snippet: snippet,
+				},
+				Job: createJob(job),
+			},
+		)
+	}
+}
+
+// usedTriggers returns the names of the triggers the workflow uses.
+func usedTriggers(workflow *actionlint.Workflow, names []triggerName) []string {
+	var ret []string
+	for _, name := range names {
+		if usesEventTrigger(workflow, name) {
+			ret = append(ret, string(name))
+		}
+	}
+	return ret
+}
+
+func collectSelfHostedRunnerJobs(workflow *actionlint.Workflow, path string,
+	pdata *checker.DangerousWorkflowData,
+) {
+	triggers := usedTriggers(workflow, forkTriggers)
+	for _, job := range workflow.Jobs {
+		labels := fileparser.GetSelfHostedRunnerLabels(job)
+		if len(labels) == 0 {
+			continue
+		}
+		pdata.SelfHostedRunnerJobs = append(pdata.SelfHostedRunnerJobs,
+			checker.SelfHostedRunnerJob{
+				Job:          createJob(job),
+				Labels:       labels,
+				ForkTriggers: triggers,
+				File: checker.File{
+					Path:    path,
+					Type:    finding.FileTypeSource,
+					Offset:  fileparser.GetLineNumber(job.Pos),
+					Snippet: fmt.Sprintf("runs-on: %s", strings.Join(labels, ", ")),
+				},
+			},
+		)
+	}
+}
+
+var (
+	envVarReferencePattern     = regexp.MustCompile(`\benv\.([A-Za-z_][A-Za-z0-9_]*)`)
+	stepOutputReferencePattern = regexp.MustCompile(`\bsteps\.([A-Za-z0-9_-]+)\.outputs\.([A-Za-z0-9_-]+)`)
+	shellVarReferencePattern   = regexp.MustCompile(`\$\{?([A-Za-z_][A-Za-z0-9_]*)`)
+	githubFileWritePattern     = regexp.MustCompile(`>>?\s*"?\$\{?(GITHUB_ENV|GITHUB_PATH|GITHUB_OUTPUT)\b`)
+	githubFileKeyPattern       = regexp.MustCompile(
+		`(?:echo|printf)\s+(?:-[a-zA-Z]+\s+)*["']?([A-Za-z_][A-Za-z0-9_-]*)(?:=|<<)`)
+	setOutputCommandPattern = regexp.MustCompile(`::set-output name=([A-Za-z0-9_-]+)::`)
+)
+
+// jobTaint tracks attacker-controlled data as it flows between the steps of a job.
+type jobTaint struct {
+	// env maps environment variable names to the untrusted expression they hold.
+	env map[string]string
+	// outputs maps `steps.<id>.outputs.<name>` to the untrusted expression they hold.
+	outputs map[string]string
+}
+
+func newJobTaint(envs ...*actionlint.Env) *jobTaint {
+	t := &jobTaint{
+		env:     make(map[string]string),
+		outputs: make(map[string]string),
+	}
+	for name, src := range t.taintedEnv(envs...) {
+		t.env[name] = src
+	}
+	return t
+}
+
+// source returns the untrusted expression that the expression variable is derived from, if any.
+func (t *jobTaint) source(variable string) (string, bool) {
+	if containsUntrustedContextPattern(variable) {
+		return variable, true
+	}
+	for _, m := range envVarReferencePattern.FindAllStringSubmatch(variable, -1) {
+		if src, ok := t.env[m[1]]; ok {
+			return src, true
+		}
+	}
+	for _, m := range stepOutputReferencePattern.FindAllString(variable, -1) {
+		if src, ok := t.outputs[m]; ok {
+			return src, true
+		}
+	}
+	return "", false
+}
+
+// expressionsSource returns the untrusted expression referenced by any `${{ }}` expression in s.
+func (t *jobTaint) expressionsSource(s string) (string, bool) {
+	for _, variable := range expressions(s) {
+		if src, ok := t.source(variable); ok {
+			return src, true
+		}
+	}
+	return "", false
+}
+
+// taintedEnv returns the environment variables whose values are derived from untrusted expressions.
+func (t *jobTaint) taintedEnv(envs ...*actionlint.Env) map[string]string {
+	tainted := make(map[string]string)
+	for _, env := range envs {
+		if env == nil {
+			continue
+		}
+		for _, v := range env.Vars {
+			if v == nil || v.Name == nil || v.Value == nil {
+				continue
+			}
+			if src, ok := t.expressionsSource(v.Value.Value); ok {
+				tainted[v.Name.Value] = src
+			}
+		}
+	}
+	return tainted
+}
+
+// withStepEnv returns the taint seen by the expressions of a step, whose `env:`
+// overrides the variables of the workflow and the job.
+func (t *jobTaint) withStepEnv(env *actionlint.Env) *jobTaint {
+	if env == nil || len(env.Vars) == 0 {
+		return t
+	}
+	step := &jobTaint{
+		env:     make(map[string]string, len(t.env)),
+		outputs: t.outputs,
+	}
+	for name, src := range t.env {
+		step.env[name] = src
+	}
+	for _, v := range env.Vars {
+		if v == nil || v.Name == nil || v.Value == nil {
+			continue
+		}
+		if src, ok := t.expressionsSource(v.Value.Value); ok {
+			step.env[v.Name.Value] = src
+		} else {
+			delete(step.env, v.Name.Value)
+		}
+	}
+	return step
+}
+
+// expressions returns the content of every `${{ }}` expression in s.
+// Unterminated expressions are ignored.
+func expressions(s string) []string {
+	var ret []string
+	for {
+		start := strings.Index(s, "${{")
+		if start == -1 {
+			return ret
+		}
+		end := strings.Index(s[start:], "}}")
+		if end == -1 {
+			return ret
+		}
+		ret = append(ret, s[start+3:start+end])
+		s = s[start+end:]
+	}
+}
+
 func validateScriptInjection(workflow *actionlint.Workflow, path string,
 	pdata *checker.DangerousWorkflowData,
 ) error {
@@ -217,17 +452,34 @@ func validateScriptInjection(workflow *actionlint.Workflow, path string,
 		if job == nil {
 			continue
 		}
+		taint := newJobTaint(workflow.Env, job.Env)
 		for _, step := range job.Steps {
 			if step == nil {
 				continue
 			}
-			run, ok := step.Exec.(*actionlint.ExecRun)
-			if !ok || run.Run == nil {
-				continue
-			}
-			// Check Run *String for user-controllable (untrustworthy) properties.
-			if err := checkVariablesInScript(run.Run.Value, run.Run.Pos, job, path, pdata); err != nil {
-				return err
+			stepTaint := taint.withStepEnv(step.Env)
+			switch e := step.Exec.(type) {
+			case *actionlint.ExecRun:
+				if e.Run == nil {
+					continue
+				}
+				// Check Run *String for user-controllable (untrustworthy) properties.
+				if err := checkVariablesInScript(e.Run.Value, e.Run.Pos, job, path, stepTaint, pdata); err != nil {
+					return err
+				}
+				checkGitHubFileWrites(e.Run, step, job, path, taint, stepTaint, pdata)
+			case *actionlint.ExecAction:
+				if e.Uses == nil || !strings.Contains(e.Uses.Value, "actions/github-script") {
+					continue
+				}
+				script, ok := e.Inputs["script"]
+				if !ok || script.Value == nil {
+					continue
+				}
+				// The script is evaluated as JavaScript, so expressions are as dangerous as in `run:`.
+				if err := checkVariablesInScript(script.Value.Value, script.Value.Pos, job, path, stepTaint, pdata); err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -235,7 +487,7 @@ func validateScriptInjection(workflow *actionlint.Workflow, path string,
 }
 
 func checkVariablesInScript(script string, pos *actionlint.Pos,
-	job *actionlint.Job, path string,
+	job *actionlint.Job, path string, taint *jobTaint,
 	pdata *checker.DangerousWorkflowData,
 ) error {
 	for {
@@ -251,7 +503,7 @@ func checkVariablesInScript(script string, pos *actionlint.Pos,
 
 		// Check if the variable may be untrustworthy.
 		variable := script[s+3 : s+e]
-		if containsUntrustedContextPattern(variable) {
+		if _, ok := taint.source(variable); ok {
 			line := fileparser.GetLineNumber(pos)
 			pdata.Workflows = append(pdata.Workflows,
 				checker.DangerousWorkflow{
@@ -270,3 +522,72 @@ func checkVariablesInScript(script string, pos *actionlint.Pos,
 	}
 	return nil
 }
+
+// checkGitHubFileWrites records untrusted data written to the files the runner
+// reads between steps: GITHUB_ENV and GITHUB_PATH alter the environment of every
+// later step, while GITHUB_OUTPUT values taint the expressions that consume them.
+// Sources are looked up in the taint seen by the step, and the propagated taint
+// is recorded in the taint of the job.
+func checkGitHubFileWrites(run *actionlint.String,
+	step *actionlint.Step, job *actionlint.Job, path string, taint, stepTaint *jobTaint,
+	pdata *checker.DangerousWorkflowData,
+) {
+	for i, line := range strings.Split(run.Value, "\n") {
+		target := ""
+		if m := githubFileWritePattern.FindStringSubmatch(line); m != nil {
+			target = m[1]
+		} else if setOutputCommandPattern.MatchString(line) {
+			target = "GITHUB_OUTPUT"
+		} else {
+			continue
+		}
+
+		src, ok := stepTaint.expressionsSource(line)
+		if !ok {
+			src, ok = shellVarsSource(line, stepTaint.env)
+		}
+		if !ok {
+			continue
+		}
+
+		switch target {
+		case "GITHUB_ENV", "GITHUB_PATH":
+			pdata.Workflows = append(pdata.Workflows,
+				checker.DangerousWorkflow{
+					File: checker.File{
+						Path:    path,
+						Type:    finding.FileTypeSource,
+						Offset:  runLineOffset(run, i),
+						Snippet: strings.TrimSpace(line),
+					},
+					Job:  createJob(job),
+					Type: checker.DangerousWorkflowGitHubEnvInjection,
+				},
+			)
+			if m := githubFileKeyPattern.FindStringSubmatch(line); target == "GITHUB_ENV" && m != nil {
+				taint.env[m[1]] = src
+			}
+		case "GITHUB_OUTPUT":
+			if step.ID == nil {
+				continue
+			}
+			m := githubFileKeyPattern.FindStringSubmatch(line)
+			if m == nil {
+				m = setOutputCommandPattern.FindStringSubmatch(line)
+			}
+			if m != nil {
+				taint.outputs[fmt.Sprintf("steps.%s.outputs.%s", step.ID.Value, m[1])] = src
+			}
+		}
+	}
+}
+
+// shellVarsSource returns the untrusted expression held by a shell variable referenced in line.
+func shellVarsSource(line string, env map[string]string) (string, bool) {
+	for _, m := range shellVarReferencePattern.FindAllStringSubmatch(line, -1) {
+		if src, ok := env[m[1]]; ok {
+			return src, true
+		}
+	}
+	return "", false
+}
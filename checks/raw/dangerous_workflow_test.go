@@ -26,6 +26,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
 	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
 )
 
@@ -93,7 +94,9 @@ func TestGithubDangerousWorkflow(t *testing.T) {
 
 	type ret struct {
 		err error
-		nb  int
+		// lines are the lines of the findings, if checked.
+		lines []uint
+		nb    int
 	}
 	tests := []struct {
 		name     string
@@ -150,6 +153,46 @@ func TestGithubDangerousWorkflow(t *testing.T) {
 			filename: ".github/workflows/github-workflow-dangerous-pattern-untrusted-script-injection-wildcard.yml",
 			expected: ret{nb: 1},
 		},
+		{
+			name:     "run untrusted data written to GITHUB_ENV",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-untrusted-github-env.yml",
+			expected: ret{nb: 2, lines: []uint{25, 28}},
+		},
+		{
+			name:     "run trusted step environment written to GITHUB_ENV",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-trusted-step-env-github-env.yml",
+			expected: ret{nb: 0},
+		},
+		{
+			name:     "run untrusted data written to GITHUB_OUTPUT",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-untrusted-github-output.yml",
+			expected: ret{nb: 2},
+		},
+		{
+			name:     "run trusted data written to GITHUB_OUTPUT",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-trusted-github-output.yml",
+			expected: ret{nb: 0},
+		},
+		{
+			name:     "run untrusted data in the step environment",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-untrusted-step-env.yml",
+			expected: ret{nb: 2},
+		},
+		{
+			name:     "run github-script injection",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-untrusted-github-script.yml",
+			expected: ret{nb: 1},
+		},
+		{
+			name:     "run secrets inherit on pull_request_target",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-secrets-inherit.yml",
+			expected: ret{nb: 1},
+		},
+		{
+			name:     "run secrets inherit on safe trigger",
+			filename: ".github/workflows/github-workflow-dangerous-pattern-safe-secrets-inherit.yml",
+			expected: ret{nb: 0},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt // Re-initializing variable so it is not changed while executing the closure below
@@ -158,10 +201,16 @@ func TestGithubDangerousWorkflow(t *testing.T) {
 
 			ctrl := gomock.NewController(t)
 			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
-			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{tt.filename}, nil)
+			mockRepoClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(
+				func(predicate func(string) (bool, error)) ([]string, error) {
+					if ok, err := predicate(tt.filename); !ok || err != nil {
+						return nil, err
+					}
+					return []string{tt.filename}, nil
+				}).AnyTimes()
 			mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
 				return os.Open("../testdata/" + file)
-			})
+			}).AnyTimes()
 
 			req := &checker.CheckRequest{
 				Ctx:        context.Background(),
@@ -181,6 +230,100 @@ func TestGithubDangerousWorkflow(t *testing.T) {
 			if nb != tt.expected.nb {
 				t.Errorf(cmp.Diff(nb, tt.expected.nb))
 			}
+			if tt.expected.lines != nil {
+				var lines []uint
+				for i := range dw.Workflows {
+					lines = append(lines, dw.Workflows[i].File.Offset)
+				}
+				if diff := cmp.Diff(tt.expected.lines, lines); diff != "" {
+					t.Errorf("lines mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestSelfHostedRunnerJobs(t *testing.T) {
+	t.Parallel()
+
+	//nolint:govet
+	tests := []struct {
+		name       string
+		filename   string
+		private    bool
+		privateErr error
+		jobs       int
+		triggers   int
+		wantErr    bool
+	}{
+		{
+			name:     "self-hosted runner on pull_request",
+			filename: ".github/workflows/github-workflow-self-hosted-runner-pull-request.yml",
+			jobs:     2,
+			triggers: 1,
+		},
+		{
+			name:     "self-hosted runner on push",
+			filename: ".github/workflows/github-workflow-self-hosted-runner-push.yml",
+			private:  true,
+			jobs:     1,
+		},
+		{
+			name:       "visibility unsupported",
+			filename:   ".github/workflows/github-workflow-self-hosted-runner-push.yml",
+			privateErr: clients.ErrUnsupportedFeature,
+			jobs:       1,
+		},
+		{
+			name:       "visibility error",
+			filename:   ".github/workflows/github-workflow-self-hosted-runner-push.yml",
+			privateErr: errors.New("some error"),
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+			mockRepoClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(
+				func(predicate func(string) (bool, error)) ([]string, error) {
+					if ok, err := predicate(tt.filename); !ok || err != nil {
+						return nil, err
+					}
+					return []string{tt.filename}, nil
+				}).AnyTimes()
+			mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
+				return os.Open("../testdata/" + file)
+			})
+			mockRepoClient.EXPECT().IsPrivate().Return(tt.private, tt.privateErr)
+
+			req := &checker.CheckRequest{
+				Ctx:        context.Background(),
+				RepoClient: mockRepoClient,
+			}
+
+			dw, err := DangerousWorkflow(req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if len(dw.SelfHostedRunnerJobs) != tt.jobs {
+				t.Errorf(cmp.Diff(len(dw.SelfHostedRunnerJobs), tt.jobs))
+			}
+			if len(dw.SelfHostedRunnerJobs[0].ForkTriggers) != tt.triggers {
+				t.Errorf(cmp.Diff(dw.SelfHostedRunnerJobs[0].ForkTriggers, tt.triggers))
+			}
+			if tt.privateErr == nil && (dw.PrivateRepo == nil || *dw.PrivateRepo != tt.private) {
+				t.Errorf("unexpected PrivateRepo: %v", dw.PrivateRepo)
+			} else if tt.privateErr != nil && dw.PrivateRepo != nil {
+				t.Errorf("expected unknown visibility, got %v", *dw.PrivateRepo)
+			}
 		})
 	}
 }
@@ -0,0 +1,269 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+var (
+	renovateEnabledManagers = regexp.MustCompile(`"?enabledManagers"?\s*:\s*\[([^\]]*)\]`)
+	quotedString            = regexp.MustCompile(`["']([^"']+)["']`)
+	pipRequirements         = regexp.MustCompile(`^requirements.*\.(txt|in)$`)
+)
+
+// renovateManagers maps Renovate managers to Dependabot ecosystems.
+// https://docs.renovatebot.com/modules/manager/
+var renovateManagers = map[string]string{
+	"bundler":          "bundler",
+	"cargo":            "cargo",
+	"composer":         "composer",
+	"dockerfile":       "docker",
+	"github-actions":   "github-actions",
+	"gomod":            "gomod",
+	"gradle":           "gradle",
+	"maven":            "maven",
+	"mix":              "mix",
+	"npm":              "npm",
+	"nuget":            "nuget",
+	"pep621":           "pip",
+	"pip_requirements": "pip",
+	"pip_setup":        "pip",
+	"pipenv":           "pip",
+	"poetry":           "pip",
+	"pub":              "pub",
+	"setup-cfg":        "pip",
+	"terraform":        "terraform",
+}
+
+// toolBots are the github.com accounts authoring the updates of the tools.
+var toolBots = map[string]struct {
+	login string
+	id    int64
+}{
+	"Dependabot":  {"dependabot[bot]", dependabotID},
+	"RenovateBot": {"renovate[bot]", renovateID},
+}
+
+var collectDependencyFiles fileparser.DoWhileTrueOnFilename = func(name string, args ...interface{}) (bool, error) {
+	if len(args) != 1 {
+		return false, fmt.Errorf("collectDependencyFiles requires exactly one argument: %w", errInvalidArgLength)
+	}
+	data, ok := args[0].(*checker.DependencyUpdateToolData)
+	if !ok {
+		return false, fmt.Errorf(
+			"collectDependencyFiles requires an argument of type: *checker.DependencyUpdateToolData: %w",
+			errInvalidArgType)
+	}
+	if _, err := checkDependencyFileExists(name, &data.Tools); err != nil {
+		return false, err
+	}
+	addDependencyManifest(data, name)
+	return true, nil
+}
+
+// addDependencyManifest adds the file to the manifests, unless there
+// is already one for its ecosystem and directory.
+func addDependencyManifest(data *checker.DependencyUpdateToolData, name string) {
+	ecosystem, dir := dependencyManifestEcosystem(name)
+	if ecosystem == "" {
+		return
+	}
+	for i := range data.Manifests {
+		if data.Manifests[i].Ecosystem == ecosystem && data.Manifests[i].Directory == dir {
+			return
+		}
+	}
+	data.Manifests = append(data.Manifests, checker.DependencyManifest{
+		File: checker.File{
+			Path:   name,
+			Type:   finding.FileTypeSource,
+			Offset: checker.OffsetDefault,
+		},
+		Ecosystem: ecosystem,
+		Directory: dir,
+	})
+}
+
+// dependencyManifestEcosystem returns the Dependabot ecosystem of a manifest
+// and the directory Dependabot expects in its configuration to update it.
+func dependencyManifestEcosystem(name string) (ecosystem, dir string) {
+	for _, segment := range strings.Split(path.Dir(name), "/") {
+		switch segment {
+		case "node_modules", "vendor", "testdata":
+			return "", ""
+		}
+	}
+	dir = "/" + strings.TrimPrefix(path.Dir(name), ".")
+	dir = path.Clean(dir)
+	base := path.Base(name)
+	switch {
+	case path.Dir(name) == ".github/workflows" && (path.Ext(base) == ".yml" || path.Ext(base) == ".yaml"):
+		// Dependabot looks for workflows in .github/workflows for the "/" directory.
+		return "github-actions", "/"
+	case base == "go.mod":
+		return "gomod", dir
+	case base == "package.json":
+		return "npm", dir
+	case pipRequirements.MatchString(base),
+		base == "pyproject.toml", base == "setup.py", base == "setup.cfg", base == "Pipfile":
+		return "pip", dir
+	case base == "Gemfile":
+		return "bundler", dir
+	case base == "Cargo.toml":
+		return "cargo", dir
+	case base == "pom.xml":
+		return "maven", dir
+	case base == "build.gradle", base == "build.gradle.kts":
+		return "gradle", dir
+	case path.Ext(base) == ".csproj", path.Ext(base) == ".fsproj", base == "packages.config":
+		return "nuget", dir
+	case base == "composer.json":
+		return "composer", dir
+	case base == "Dockerfile", strings.HasPrefix(base, "Dockerfile."), path.Ext(base) == ".dockerfile":
+		return "docker", dir
+	case path.Ext(base) == ".tf":
+		return "terraform", dir
+	case base == "mix.exs":
+		return "mix", dir
+	case base == "pubspec.yaml":
+		return "pub", dir
+	}
+	return "", ""
+}
+
+// setToolCoverage parses the configuration of the tool. The coverage
+// is left unknown if the configuration can't be read.
+func setToolCoverage(c clients.RepoClient, tool *checker.Tool) {
+	if len(tool.Files) == 0 || tool.Files[0].Path == "" {
+		return
+	}
+	var parse func([]byte) []checker.DependencyUpdateCoverage
+	switch tool.Name {
+	case "Dependabot":
+		parse = dependabotCoverage
+	case "RenovateBot":
+		parse = renovateCoverage
+	case "PyUp":
+		tool.Coverage = []checker.DependencyUpdateCoverage{{Ecosystem: "pip", Directory: "/**"}}
+		return
+	default:
+		return
+	}
+	r, err := c.GetFileReader(tool.Files[0].Path)
+	if err != nil {
+		return
+	}
+	content, err := io.ReadAll(r)
+	r.Close()
+	if err != nil {
+		return
+	}
+	tool.Coverage = parse(content)
+}
+
+// dependabotCoverage parses a dependabot.yml. It returns nil if the
+// configuration is invalid.
+// https://docs.github.com/en/code-security/dependabot/dependabot-version-updates/configuration-options-for-the-dependabot.yml-file
+func dependabotCoverage(content []byte) []checker.DependencyUpdateCoverage {
+	var config struct {
+		Updates []struct {
+			PackageEcosystem string   `yaml:"package-ecosystem"`
+			Directory        string   `yaml:"directory"`
+			Directories      []string `yaml:"directories"`
+		} `yaml:"updates"`
+	}
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return nil
+	}
+	coverage := []checker.DependencyUpdateCoverage{}
+	for _, update := range config.Updates {
+		dirs := update.Directories
+		if update.Directory != "" {
+			dirs = append(dirs, update.Directory)
+		}
+		for _, dir := range dirs {
+			coverage = append(coverage, checker.DependencyUpdateCoverage{
+				Ecosystem: update.PackageEcosystem,
+				Directory: path.Clean("/" + dir),
+			})
+		}
+	}
+	return coverage
+}
+
+// renovateCoverage parses a Renovate configuration. Renovate updates all
+// directories with all its managers, unless `enabledManagers` is set.
+// https://docs.renovatebot.com/configuration-options/#enabledmanagers
+func renovateCoverage(content []byte) []checker.DependencyUpdateCoverage {
+	// The configuration may be JSON5, so only look for the option.
+	m := renovateEnabledManagers.FindSubmatch(content)
+	if m == nil {
+		return []checker.DependencyUpdateCoverage{{Directory: "/**"}}
+	}
+	coverage := []checker.DependencyUpdateCoverage{}
+	for _, manager := range quotedString.FindAllSubmatch(m[1], -1) {
+		if ecosystem, ok := renovateManagers[string(manager[1])]; ok {
+			coverage = append(coverage, checker.DependencyUpdateCoverage{
+				Ecosystem: ecosystem,
+				Directory: "/**",
+			})
+		}
+	}
+	return coverage
+}
+
+// setToolActivity looks for commits authored by the tool. The activity is
+// left unknown if the account of the tool on the forge isn't known.
+func setToolActivity(c clients.RepoClient, tool *checker.Tool) error {
+	bot, ok := toolBots[tool.Name]
+	if !ok || !strings.HasPrefix(c.URI(), "github.com/") {
+		return nil
+	}
+	commits, err := c.SearchCommits(clients.SearchCommitsOptions{Author: bot.login})
+	switch {
+	// Some repo clients (e.g. local) can't search commits.
+	case errors.Is(err, clients.ErrUnsupportedFeature):
+		return nil
+	case err != nil:
+		return fmt.Errorf("%s commit search: %w", bot.login, err)
+	}
+	tool.Activity = &checker.ToolActivity{Commits: botCommits(commits, bot.id)}
+	return nil
+}
+
+// botCommits returns the commits actually authored by the bot account,
+// as other accounts may use its name.
+func botCommits(commits []clients.Commit, id int64) []clients.Commit {
+	ret := []clients.Commit{}
+	for i := range commits {
+		if commits[i].Committer.ID == id {
+			ret = append(ret, commits[i])
+		}
+	}
+	return ret
+}
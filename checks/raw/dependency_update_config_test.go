@@ -0,0 +1,144 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+)
+
+func Test_dependabotCoverage(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	tests := []struct {
+		name    string
+		content string
+		want    []checker.DependencyUpdateCoverage
+	}{
+		{
+			name: "directory and directories",
+			content: `version: 2
+updates:
+  - package-ecosystem: gomod
+    directory: /
+    schedule:
+      interval: daily
+  - package-ecosystem: docker
+    directories:
+      - /images/*
+      - tools/
+`,
+			want: []checker.DependencyUpdateCoverage{
+				{Ecosystem: "gomod", Directory: "/"},
+				{Ecosystem: "docker", Directory: "/images/*"},
+				{Ecosystem: "docker", Directory: "/tools"},
+			},
+		},
+		{
+			name:    "no updates",
+			content: "version: 2\n",
+			want:    []checker.DependencyUpdateCoverage{},
+		},
+		{
+			name:    "invalid",
+			content: "updates: [",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if diff := cmp.Diff(tt.want, dependabotCoverage([]byte(tt.content))); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_renovateCoverage(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	tests := []struct {
+		name    string
+		content string
+		want    []checker.DependencyUpdateCoverage
+	}{
+		{
+			name:    "all managers",
+			content: `{"extends": ["config:recommended"]}`,
+			want:    []checker.DependencyUpdateCoverage{{Directory: "/**"}},
+		},
+		{
+			name: "enabled managers in json5",
+			content: `{
+  // Only update these.
+  enabledManagers: ['gomod', "dockerfile", 'regex'],
+}`,
+			want: []checker.DependencyUpdateCoverage{
+				{Ecosystem: "gomod", Directory: "/**"},
+				{Ecosystem: "docker", Directory: "/**"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if diff := cmp.Diff(tt.want, renovateCoverage([]byte(tt.content))); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_addDependencyManifest(t *testing.T) {
+	t.Parallel()
+	var data checker.DependencyUpdateToolData
+	for _, name := range []string{
+		"go.mod",
+		"cmd/tool/go.mod",
+		"web/package.json",
+		"web/node_modules/left-pad/package.json",
+		"testdata/go.mod",
+		"requirements-dev.txt",
+		"requirements.txt",
+		"images/base/Dockerfile",
+		"images/base/Dockerfile.debug",
+		".github/workflows/ci.yml",
+		".github/workflows/release.yaml",
+		"README.md",
+	} {
+		addDependencyManifest(&data, name)
+	}
+	type manifest struct{ Path, Ecosystem, Directory string }
+	var got []manifest
+	for _, m := range data.Manifests {
+		got = append(got, manifest{m.File.Path, m.Ecosystem, m.Directory})
+	}
+	want := []manifest{
+		{"go.mod", "gomod", "/"},
+		{"cmd/tool/go.mod", "gomod", "/cmd/tool"},
+		{"web/package.json", "npm", "/web"},
+		{"requirements-dev.txt", "pip", "/"},
+		{"images/base/Dockerfile", "docker", "/images/base"},
+		{".github/workflows/ci.yml", "github-actions", "/"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -27,44 +27,50 @@ import (
 
 const (
 	dependabotID = 49699333
+	renovateID   = 29139614
 )
 
 // DependencyUpdateTool is the exported name for Dependency-Update-Tool.
-func DependencyUpdateTool(c clients.RepoClient) (checker.DependencyUpdateToolData, error) {
-	var tools []checker.Tool
-	err := fileparser.OnAllFilesDo(c, checkDependencyFileExists, &tools)
+func DependencyUpdateTool(c *checker.CheckRequest) (checker.DependencyUpdateToolData, error) {
+	data := checker.DependencyUpdateToolData{LookBackDays: c.LookBackDays}
+	err := fileparser.OnAllFilesDo(c.RepoClient, collectDependencyFiles, &data)
 	if err != nil {
 		return checker.DependencyUpdateToolData{}, fmt.Errorf("%w", err)
 	}
 
-	if len(tools) != 0 {
-		return checker.DependencyUpdateToolData{Tools: tools}, nil
+	for i := range data.Tools {
+		setToolCoverage(c.RepoClient, &data.Tools[i])
+		if err := setToolActivity(c.RepoClient, &data.Tools[i]); err != nil {
+			return checker.DependencyUpdateToolData{}, err
+		}
+	}
+
+	if len(data.Tools) != 0 {
+		return data, nil
 	}
 
-	commits, err := c.SearchCommits(clients.SearchCommitsOptions{Author: "dependabot[bot]"})
+	commits, err := c.RepoClient.SearchCommits(clients.SearchCommitsOptions{Author: "dependabot[bot]"})
 	if err != nil {
 		// TODO https://github.com/ossf/scorecard/issues/1709
 		// some repo clients (e.g. local) don't currently have the ability to search commits,
 		// but some data is better than none.
 		if errors.Is(err, clients.ErrUnsupportedFeature) {
-			return checker.DependencyUpdateToolData{Tools: tools}, nil
+			return data, nil
 		}
 		return checker.DependencyUpdateToolData{}, fmt.Errorf("dependabot commit search: %w", err)
 	}
 
-	for i := range commits {
-		if commits[i].Committer.ID == dependabotID {
-			tools = append(tools, checker.Tool{
-				Name:  "Dependabot",
-				URL:   asPointer("https://github.com/dependabot"),
-				Desc:  asPointer("Automated dependency updates built into GitHub"),
-				Files: []checker.File{{}},
-			})
-			break
-		}
+	if commits := botCommits(commits, dependabotID); len(commits) > 0 {
+		data.Tools = append(data.Tools, checker.Tool{
+			Name:     "Dependabot",
+			URL:      asPointer("https://github.com/dependabot"),
+			Desc:     asPointer("Automated dependency updates built into GitHub"),
+			Files:    []checker.File{{}},
+			Activity: &checker.ToolActivity{Commits: commits},
+		})
 	}
 
-	return checker.DependencyUpdateToolData{Tools: tools}, nil
+	return data, nil
 }
 
 var checkDependencyFileExists fileparser.DoWhileTrueOnFilename = func(name string, args ...interface{}) (bool, error) {
@@ -15,6 +15,9 @@
 package raw
 
 import (
+	"errors"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -154,7 +157,7 @@ func TestDependencyUpdateTool(t *testing.T) {
 			name:              "dependency update tool",
 			wantErr:           false,
 			want:              1,
-			CallSearchCommits: 0,
+			CallSearchCommits: 1,
 			files: []string{
 				".github/dependabot.yml",
 			},
@@ -163,7 +166,7 @@ func TestDependencyUpdateTool(t *testing.T) {
 			name:              "dependency update tool",
 			wantErr:           false,
 			want:              1,
-			CallSearchCommits: 0,
+			CallSearchCommits: 1,
 			files: []string{
 				".github/dependabot.yaml",
 			},
@@ -206,9 +209,13 @@ func TestDependencyUpdateTool(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			mockRepo := mockrepo.NewMockRepoClient(ctrl)
 			mockRepo.EXPECT().ListFiles(gomock.Any()).Return(tt.files, nil)
+			mockRepo.EXPECT().URI().Return("github.com/ossf/scorecard").AnyTimes()
 			mockRepo.EXPECT().SearchCommits(gomock.Any()).Return(tt.SearchCommits, nil).Times(tt.CallSearchCommits)
+			mockRepo.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(string) (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader("")), nil
+			}).AnyTimes()
 
-			got, err := DependencyUpdateTool(mockRepo)
+			got, err := DependencyUpdateTool(&checker.CheckRequest{RepoClient: mockRepo})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("DependencyUpdateTool() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -221,3 +228,30 @@ func TestDependencyUpdateTool(t *testing.T) {
 		})
 	}
 }
+
+func TestDependencyUpdateTool_unknownActivityAndCoverage(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mockRepo := mockrepo.NewMockRepoClient(ctrl)
+	mockRepo.EXPECT().ListFiles(gomock.Any()).Return([]string{".github/dependabot.yml"}, nil)
+	// The bot accounts are only known on github.com.
+	mockRepo.EXPECT().URI().Return("gitlab.com/ossf-tests/scorecard").AnyTimes()
+	mockRepo.EXPECT().GetFileReader(gomock.Any()).Return(nil, errors.New("unreadable"))
+
+	got, err := DependencyUpdateTool(&checker.CheckRequest{RepoClient: mockRepo, LookBackDays: 30})
+	if err != nil {
+		t.Fatalf("DependencyUpdateTool: %v", err)
+	}
+	if len(got.Tools) != 1 {
+		t.Fatalf("got %d tools, want 1", len(got.Tools))
+	}
+	if got.Tools[0].Coverage != nil {
+		t.Errorf("Coverage = %v, want nil", got.Tools[0].Coverage)
+	}
+	if got.Tools[0].Activity != nil {
+		t.Errorf("Activity = %v, want nil", got.Tools[0].Activity)
+	}
+	if got.LookBack() != 30 {
+		t.Errorf("LookBack() = %d, want 30", got.LookBack())
+	}
+}
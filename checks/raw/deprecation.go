@@ -0,0 +1,180 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+// readmeBannerLines is the number of lines at the top of a README searched for deprecation notices.
+const readmeBannerLines = 30
+
+// maxDeprecationMessageLength limits the length of the notices recorded from READMEs.
+const maxDeprecationMessageLength = 200
+
+var readmeDeprecationPatterns = []*regexp.Regexp{
+	// This project is deprecated, This repository is no longer maintained, ...
+	regexp.MustCompile(`(?i)\b(?:this|the)\s+(?:project|repository|repo|package|library|module|crate|gem|tool|plugin|action|extension)` +
+		`\s+(?:is|has\s+been)\s+(?:now\s+)?(?:officially\s+)?(?:deprecated|unmaintained|abandoned|end[- ]of[- ]life|` +
+		`no\s+longer\s+(?:maintained|supported|under\s+active\s+development))`),
+	// # DEPRECATED, > **Deprecated**: ..., ⚠️ No longer maintained.
+	regexp.MustCompile(`(?i)^\s*(?:#{1,6}|>)?\s*(?:\[!(?:WARNING|CAUTION|IMPORTANT|NOTE)\]\s*)?[^\w\s]*\s*` +
+		`(?:deprecated|deprecation\s+notice|unmaintained|no\s+longer\s+maintained)(?:\s*[^\w\s]|\s*$)`),
+	// Status badges.
+	regexp.MustCompile(`(?i)img\.shields\.io/badge/(?:status|maintenance|maintained|project)(?:%3F)?-` +
+		`(?:deprecated|unmaintained|no--longer--maintained|abandoned|no)\b`),
+	regexp.MustCompile(`(?i)repostatus\.org/badges/latest/(?:abandoned|unsupported|moved)`),
+}
+
+// deprecationReplacement matches the project a deprecation notice points to:
+// "use [bar](https://...) instead", "superseded by `bar`", "moved to https://...".
+var deprecationReplacement = regexp.MustCompile(`(?i)\b(?:use|using|moved\s+to|replaced\s+by|superseded\s+by|` +
+	`in\s+favou?r\s+of|successor(?:\s+is)?|migrate\s+to|switch\s+to)\s*:?\s+(?:the\s+|our\s+)?` +
+	"(?:\\[[^\\]]*\\]\\(([^)\\s]+)\\)|`([^`]+)`|(https?://[^\\s)>\\]]+))")
+
+// deprecationTopics are the repository topics which mark a project as deprecated.
+var deprecationTopics = map[string]bool{
+	"deprecated":           true,
+	"unmaintained":         true,
+	"no-longer-maintained": true,
+	"abandoned":            true,
+	"obsolete":             true,
+}
+
+// getDeprecations returns the deprecation notices in the README and the topics of
+// the repository, along with the ones found outside of the repository by the caller.
+func getDeprecations(c *checker.CheckRequest) ([]checker.Deprecation, error) {
+	var deprecations []checker.Deprecation
+	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       "README*",
+		CaseSensitive: false,
+	}, getReadmeDeprecations, &deprecations)
+	if err != nil {
+		return nil, err
+	}
+
+	topics, err := c.RepoClient.ListTopics()
+	switch {
+	case errors.Is(err, clients.ErrUnsupportedFeature):
+	case err != nil:
+		return nil, fmt.Errorf("ListTopics: %w", err)
+	default:
+		for _, topic := range topics {
+			if deprecationTopics[strings.ToLower(topic)] {
+				deprecations = append(deprecations, checker.Deprecation{
+					Source:   checker.DeprecationSourceTopic,
+					Location: topic,
+					Message:  fmt.Sprintf("repository has the %q topic", topic),
+				})
+			}
+		}
+	}
+
+	deprecations = append(deprecations, c.Deprecations...)
+	return deprecations, nil
+}
+
+var getReadmeDeprecations fileparser.DoWhileTrueOnFileContent = func(
+	path string, content []byte, args ...interface{},
+) (bool, error) {
+	if len(args) != 1 {
+		return false, fmt.Errorf("getReadmeDeprecations requires exactly one argument: %w", errInvalidArgLength)
+	}
+	pdata, ok := args[0].(*[]checker.Deprecation)
+	if !ok {
+		return false, fmt.Errorf("getReadmeDeprecations expects arg[0] of type *[]checker.Deprecation: %w",
+			errInvalidArgType)
+	}
+
+	if deprecation, ok := readmeDeprecation(content); ok {
+		deprecation.Location = path
+		*pdata = append(*pdata, deprecation)
+	}
+	return true, nil
+}
+
+// readmeDeprecation returns the first deprecation notice at the top of a README.
+// The replacement is looked for in the paragraph of the notice, or the one
+// following it if the notice is a heading.
+func readmeDeprecation(content []byte) (checker.Deprecation, bool) {
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() && len(lines) < readmeBannerLines {
+		lines = append(lines, scanner.Text())
+	}
+
+	for i, line := range lines {
+		if !matchesAny(line, readmeDeprecationPatterns) {
+			continue
+		}
+		deprecation := checker.Deprecation{
+			Source:  checker.DeprecationSourceReadme,
+			Message: truncate(strings.TrimSpace(line), maxDeprecationMessageLength),
+		}
+		paragraph := lines[i+1:]
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			// A heading introduces the paragraph which follows it.
+			for len(paragraph) > 0 && strings.TrimSpace(paragraph[0]) == "" {
+				paragraph = paragraph[1:]
+			}
+		}
+		for _, l := range append([]string{line}, paragraph...) {
+			if strings.TrimSpace(l) == "" {
+				break
+			}
+			if m := deprecationReplacement.FindStringSubmatch(l); m != nil {
+				deprecation.Replacement = firstNonEmpty(m[1:]...)
+				break
+			}
+		}
+		return deprecation, true
+	}
+	return checker.Deprecation{}, false
+}
+
+func matchesAny(s string, patterns []*regexp.Regexp) bool {
+	for _, p := range patterns {
+		if p.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n]) + "..."
+}
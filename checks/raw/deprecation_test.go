@@ -0,0 +1,149 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
+)
+
+func Test_readmeDeprecation(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	tests := []struct {
+		name    string
+		content string
+		want    checker.Deprecation
+		found   bool
+	}{
+		{
+			name:    "not deprecated",
+			content: "# foo\n\nA library which does foo.\n\n## Deprecated APIs\n\n`Bar` is deprecated, use `Baz` instead.\n",
+		},
+		{
+			name:    "heading",
+			content: "# DEPRECATED\n\nThis library is superseded by [bar](https://github.com/foo/bar).\n",
+			want: checker.Deprecation{
+				Source:      checker.DeprecationSourceReadme,
+				Message:     "# DEPRECATED",
+				Replacement: "https://github.com/foo/bar",
+			},
+			found: true,
+		},
+		{
+			name:    "sentence with replacement",
+			content: "# foo\n\n> This project is no longer maintained. Please use `bar` instead.\n",
+			want: checker.Deprecation{
+				Source:      checker.DeprecationSourceReadme,
+				Message:     "> This project is no longer maintained. Please use `bar` instead.",
+				Replacement: "bar",
+			},
+			found: true,
+		},
+		{
+			name:    "bold banner",
+			content: "**Deprecated**: moved to https://gitlab.com/foo/bar\n",
+			want: checker.Deprecation{
+				Source:      checker.DeprecationSourceReadme,
+				Message:     "**Deprecated**: moved to https://gitlab.com/foo/bar",
+				Replacement: "https://gitlab.com/foo/bar",
+			},
+			found: true,
+		},
+		{
+			name:    "badge",
+			content: "# foo\n[![status](https://img.shields.io/badge/status-deprecated-red)](#)\n\nUse it at your own risk.\n",
+			want: checker.Deprecation{
+				Source:  checker.DeprecationSourceReadme,
+				Message: "[![status](https://img.shields.io/badge/status-deprecated-red)](#)",
+			},
+			found: true,
+		},
+		{
+			name:    "replacement in another paragraph",
+			content: "This package has been deprecated.\n\nUse `bar` for new code.\n",
+			want: checker.Deprecation{
+				Source:  checker.DeprecationSourceReadme,
+				Message: "This package has been deprecated.",
+			},
+			found: true,
+		},
+		{
+			name:    "notice below the banner lines",
+			content: strings.Repeat("text\n", readmeBannerLines) + "This project is deprecated.\n",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, found := readmeDeprecation([]byte(tt.content))
+			if found != tt.found {
+				t.Fatalf("found: got %v, want %v", found, tt.found)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_getDeprecations(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{"README.md"}, nil)
+	mockRepoClient.EXPECT().GetFileReader("README.md").Return(
+		io.NopCloser(strings.NewReader("# foo\n\nThis repository is deprecated.\n")), nil)
+	mockRepoClient.EXPECT().ListTopics().Return([]string{"go", "Deprecated"}, nil)
+
+	registry := checker.Deprecation{
+		Source:      checker.DeprecationSourceRegistry,
+		Location:    "pkg:npm/foo",
+		Message:     "use bar",
+		Replacement: "bar",
+	}
+	c := checker.CheckRequest{
+		RepoClient:   mockRepoClient,
+		Deprecations: []checker.Deprecation{registry},
+	}
+	got, err := getDeprecations(&c)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []checker.Deprecation{
+		{
+			Source:   checker.DeprecationSourceReadme,
+			Location: "README.md",
+			Message:  "This repository is deprecated.",
+		},
+		{
+			Source:   checker.DeprecationSourceTopic,
+			Location: "Deprecated",
+			Message:  `repository has the "Deprecated" topic`,
+		},
+		registry,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -17,9 +17,13 @@ package raw
 import (
 	"bytes"
 	"fmt"
+	"path"
 	"regexp"
+	"sort"
 	"strings"
 
+	"github.com/rhysd/actionlint"
+
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/checks/fileparser"
 	"github.com/ossf/scorecard/v4/clients"
@@ -38,6 +42,9 @@ type languageFuzzConfig struct {
 	URL, Desc *string
 
 	funcPattern, Name string
+	// targetName returns the name of the fuzz target in a file matching funcPattern,
+	// nil for languages without fuzz targets, such as property-based testing.
+	targetName func(file *checker.File) string
 	// TODO: add more language fuzzing-related fields.
 
 	// Patterns are according to path.Match.
@@ -52,6 +59,7 @@ var languageFuzzSpecs = map[clients.LanguageName]languageFuzzConfig{
 		filePatterns: []string{"*_test.go"},
 		funcPattern:  `func\s+Fuzz\w+\s*\(\w+\s+\*testing.F\)`,
 		Name:         fuzzers.BuiltInGo,
+		targetName:   goFuzzTarget,
 		URL:          asPointer("https://go.dev/doc/fuzz/"),
 		Desc: asPointer(
 			"Go fuzzing intelligently walks through the source code to report failures and find vulnerabilities."),
@@ -106,6 +114,7 @@ var languageFuzzSpecs = map[clients.LanguageName]languageFuzzConfig{
 		filePatterns: []string{"*.py"},
 		funcPattern:  `import atheris`,
 		Name:         fuzzers.PythonAtheris,
+		targetName:   fileFuzzTarget,
 		Desc: asPointer(
 			"Python fuzzing by way of Atheris"),
 	},
@@ -113,6 +122,7 @@ var languageFuzzSpecs = map[clients.LanguageName]languageFuzzConfig{
 		filePatterns: []string{"*.c"},
 		funcPattern:  `LLVMFuzzerTestOneInput`,
 		Name:         fuzzers.CLibFuzzer,
+		targetName:   libFuzzerTarget,
 		Desc: asPointer(
 			"Fuzzed with C LibFuzzer"),
 	},
@@ -120,6 +130,7 @@ var languageFuzzSpecs = map[clients.LanguageName]languageFuzzConfig{
 		filePatterns: []string{"*.cc", "*.cpp"},
 		funcPattern:  `LLVMFuzzerTestOneInput`,
 		Name:         fuzzers.CppLibFuzzer,
+		targetName:   libFuzzerTarget,
 		Desc: asPointer(
 			"Fuzzed with cpp LibFuzzer"),
 	},
@@ -127,6 +138,7 @@ var languageFuzzSpecs = map[clients.LanguageName]languageFuzzConfig{
 		filePatterns: []string{"*.rs"},
 		funcPattern:  `libfuzzer_sys`,
 		Name:         fuzzers.RustCargoFuzz,
+		targetName:   fileFuzzTarget,
 		Desc: asPointer(
 			"Fuzzed with Cargo-fuzz"),
 	},
@@ -134,6 +146,7 @@ var languageFuzzSpecs = map[clients.LanguageName]languageFuzzConfig{
 		filePatterns: []string{"*.java"},
 		funcPattern:  `com.code_intelligence.jazzer.api.FuzzedDataProvider;`,
 		Name:         fuzzers.JavaJazzerFuzzer,
+		targetName:   fileFuzzTarget,
 		Desc: asPointer(
 			"Fuzzed with Jazzer fuzzer"),
 	},
@@ -141,6 +154,7 @@ var languageFuzzSpecs = map[clients.LanguageName]languageFuzzConfig{
 		filePatterns: []string{"*.swift"},
 		funcPattern:  `LLVMFuzzerTestOneInput`,
 		Name:         fuzzers.SwiftLibFuzzer,
+		targetName:   libFuzzerTarget,
 		Desc: asPointer(
 			"Fuzzed with Swift LibFuzzer"),
 	},
@@ -180,6 +194,7 @@ func Fuzzing(c *checker.CheckRequest) (checker.FuzzingData, error) {
 		)
 	}
 
+	var targets []checker.FuzzTarget
 	langs, err := c.RepoClient.ListProgrammingLanguages()
 	if err != nil {
 		return checker.FuzzingData{}, fmt.Errorf("cannot get langs of repo: %w", err)
@@ -199,9 +214,193 @@ func Fuzzing(c *checker.CheckRequest) (checker.FuzzingData, error) {
 					Files: files,
 				},
 			)
+			targets = append(targets, getFuzzTargets(lang, files)...)
+		}
+	}
+
+	executions, err := getFuzzExecutions(c)
+	if err != nil {
+		return checker.FuzzingData{}, err
+	}
+	if usingOSSFuzz {
+		executions = append(executions, checker.FuzzExecution{Type: checker.FuzzExecutionOSSFuzz})
+	}
+
+	return checker.FuzzingData{
+		Fuzzers:    detectedFuzzers,
+		Targets:    targets,
+		Executions: executions,
+	}, nil
+}
+
+var goFuzzFuncName = regexp.MustCompile(`Fuzz\w+`)
+
+func goFuzzTarget(file *checker.File) string {
+	return goFuzzFuncName.FindString(file.Snippet)
+}
+
+func libFuzzerTarget(*checker.File) string {
+	return "LLVMFuzzerTestOneInput"
+}
+
+// fileFuzzTarget names a fuzz target after its file, as cargo-fuzz
+// targets and Jazzer classes are.
+func fileFuzzTarget(file *checker.File) string {
+	name := path.Base(file.Path)
+	return strings.TrimSuffix(name, path.Ext(name))
+}
+
+// getFuzzTargets returns the fuzz targets defined in the files matching the
+// fuzz func pattern of lang. A target matched on several lines is returned once.
+func getFuzzTargets(lang clients.LanguageName, files []checker.File) []checker.FuzzTarget {
+	targetName := languageFuzzSpecs[lang].targetName
+	if targetName == nil {
+		return nil
+	}
+	var targets []checker.FuzzTarget
+	seen := map[string]bool{}
+	for i := range files {
+		name := targetName(&files[i])
+		key := files[i].Path + ":" + name
+		if name == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		targets = append(targets, checker.FuzzTarget{
+			Function: name,
+			Language: lang,
+			File:     files[i],
+		})
+	}
+	return targets
+}
+
+var (
+	// fuzzActions are the GitHub Actions running fuzzers.
+	fuzzActions = map[string]checker.FuzzExecutionType{
+		"google/oss-fuzz/infra/cifuzz/actions/run_fuzzers": checker.FuzzExecutionCIFuzz,
+		"google/clusterfuzzlite/actions/run_fuzzers":       checker.FuzzExecutionClusterFuzzLite,
+	}
+	goTestFuzzCommand   = regexp.MustCompile(`(^|[\s;&|(])go\s+test\s.*-{1,2}fuzz[=\s]`)
+	cargoFuzzRunCommand = regexp.MustCompile(`(^|[\s;&|(])cargo\s+(\+\S+\s+)?fuzz\s+run\b`)
+)
+
+// fuzzCommand returns how a script line runs fuzz targets, if it does.
+func fuzzCommand(line string) (checker.FuzzExecutionType, bool) {
+	switch {
+	case goTestFuzzCommand.MatchString(line):
+		return checker.FuzzExecutionGoTest, true
+	case cargoFuzzRunCommand.MatchString(line):
+		return checker.FuzzExecutionCargoFuzz, true
+	default:
+		return "", false
+	}
+}
+
+// getFuzzExecutions returns the GitHub workflow steps and GitLab jobs running fuzz targets.
+func getFuzzExecutions(c *checker.CheckRequest) ([]checker.FuzzExecution, error) {
+	var executions []checker.FuzzExecution
+	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       ".github/workflows/*",
+		CaseSensitive: false,
+	}, getWorkflowFuzzExecutions, &executions)
+	if err != nil {
+		return nil, err
+	}
+
+	ci, err := fileparser.ParseGitLabCIFromRepo(c.RepoClient)
+	// Fuzzers are not run by configurations which do not parse.
+	if err != nil || ci == nil {
+		return executions, nil //nolint:nilerr // the other executions are still valid
+	}
+	for _, job := range ci.Jobs {
+		for _, cmd := range job.Scripts() {
+			if t, ok := fuzzCommand(cmd.Value); ok {
+				executions = append(executions, checker.FuzzExecution{
+					File: &checker.File{
+						Path:    cmd.File,
+						Offset:  cmd.Line,
+						Type:    finding.FileTypeSource,
+						Snippet: cmd.Value,
+					},
+					Type: t,
+				})
+			}
 		}
 	}
-	return checker.FuzzingData{Fuzzers: detectedFuzzers}, nil
+	return executions, nil
+}
+
+var getWorkflowFuzzExecutions fileparser.DoWhileTrueOnFileContent = func(
+	path string, content []byte, args ...interface{},
+) (bool, error) {
+	if !fileparser.IsWorkflowFile(path) {
+		return true, nil
+	}
+	if len(args) != 1 {
+		return false, fmt.Errorf("getWorkflowFuzzExecutions requires exactly one argument: %w", errInvalidArgLength)
+	}
+	pdata, ok := args[0].(*[]checker.FuzzExecution)
+	if !ok {
+		return false, errInvalidArgType
+	}
+
+	workflow, errs := actionlint.Parse(content)
+	// Fuzzers are not run by workflows which do not parse.
+	if len(errs) > 0 && workflow == nil {
+		return true, nil
+	}
+
+	start := len(*pdata)
+	for _, job := range workflow.Jobs {
+		for _, step := range job.Steps {
+			switch e := step.Exec.(type) {
+			case *actionlint.ExecAction:
+				if e.Uses == nil {
+					continue
+				}
+				action, _, _ := strings.Cut(e.Uses.Value, "@")
+				t, ok := fuzzActions[action]
+				if !ok {
+					continue
+				}
+				*pdata = append(*pdata, checker.FuzzExecution{
+					File: &checker.File{
+						Path:    path,
+						Offset:  fileparser.GetLineNumber(e.Uses.Pos),
+						Type:    finding.FileTypeSource,
+						Snippet: e.Uses.Value,
+					},
+					Type: t,
+				})
+			case *actionlint.ExecRun:
+				if e.Run == nil {
+					continue
+				}
+				for i, line := range strings.Split(e.Run.Value, "\n") {
+					t, ok := fuzzCommand(line)
+					if !ok {
+						continue
+					}
+					*pdata = append(*pdata, checker.FuzzExecution{
+						File: &checker.File{
+							Path:    path,
+							Offset:  runLineOffset(e.Run, i),
+							Type:    finding.FileTypeSource,
+							Snippet: strings.TrimSpace(line),
+						},
+						Type: t,
+					})
+				}
+			}
+		}
+	}
+	// Jobs are a map, so report the executions in the order of the file.
+	executions := (*pdata)[start:]
+	sort.SliceStable(executions, func(i, j int) bool {
+		return executions[i].File.Offset < executions[j].File.Offset
+	})
+	return true, nil
 }
 
 func checkCFLite(c *checker.CheckRequest) (bool, error) {
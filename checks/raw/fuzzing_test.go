@@ -17,16 +17,19 @@ package raw
 import (
 	"errors"
 	"io"
+	"os"
 	"path"
 	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
 
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/clients"
 	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
+	"github.com/ossf/scorecard/v4/finding"
 )
 
 // Test_checkOSSFuzz is a test function for checkOSSFuzz.
@@ -507,6 +510,173 @@ func Test_checkFuzzFunc(t *testing.T) {
 	}
 }
 
+func Test_getFuzzTargets(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		lang  clients.LanguageName
+		files []checker.File
+		want  []checker.FuzzTarget
+	}{
+		{
+			name: "go fuzz functions",
+			lang: clients.Go,
+			files: []checker.File{
+				{Path: "parser/parser_test.go", Snippet: "func FuzzParse(f *testing.F)", Offset: 10},
+				{Path: "parser/parser_test.go", Snippet: "func FuzzLex(f *testing.F)", Offset: 20},
+			},
+			want: []checker.FuzzTarget{
+				{
+					Function: "FuzzParse",
+					Language: clients.Go,
+					File:     checker.File{Path: "parser/parser_test.go", Snippet: "func FuzzParse(f *testing.F)", Offset: 10},
+				},
+				{
+					Function: "FuzzLex",
+					Language: clients.Go,
+					File:     checker.File{Path: "parser/parser_test.go", Snippet: "func FuzzLex(f *testing.F)", Offset: 20},
+				},
+			},
+		},
+		{
+			name: "libFuzzer entrypoint declared and defined",
+			lang: clients.C,
+			files: []checker.File{
+				{Path: "fuzz/parse.c", Snippet: "LLVMFuzzerTestOneInput", Offset: 3},
+				{Path: "fuzz/parse.c", Snippet: "LLVMFuzzerTestOneInput", Offset: 5},
+			},
+			want: []checker.FuzzTarget{
+				{
+					Function: "LLVMFuzzerTestOneInput",
+					Language: clients.C,
+					File:     checker.File{Path: "fuzz/parse.c", Snippet: "LLVMFuzzerTestOneInput", Offset: 3},
+				},
+			},
+		},
+		{
+			name: "cargo-fuzz target",
+			lang: clients.Rust,
+			files: []checker.File{
+				{Path: "fuzz/fuzz_targets/parse.rs", Snippet: "libfuzzer_sys", Offset: 2},
+			},
+			want: []checker.FuzzTarget{
+				{
+					Function: "parse",
+					Language: clients.Rust,
+					File:     checker.File{Path: "fuzz/fuzz_targets/parse.rs", Snippet: "libfuzzer_sys", Offset: 2},
+				},
+			},
+		},
+		{
+			name: "property-based testing",
+			lang: clients.Haskell,
+			files: []checker.File{
+				{Path: "test/Spec.hs", Snippet: "import Test.QuickCheck", Offset: 1},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := getFuzzTargets(tt.lang, tt.files)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_getFuzzExecutions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		gitlabCI string
+		files    []string
+		want     []checker.FuzzExecution
+	}{
+		{
+			name:  "CIFuzz and go test -fuzz",
+			files: []string{".github/workflows/github-workflow-fuzzing.yaml"},
+			want: []checker.FuzzExecution{
+				{
+					File: &checker.File{
+						Path:    ".github/workflows/github-workflow-fuzzing.yaml",
+						Offset:  14,
+						Type:    finding.FileTypeSource,
+						Snippet: "google/oss-fuzz/infra/cifuzz/actions/run_fuzzers@master",
+					},
+					Type: checker.FuzzExecutionCIFuzz,
+				},
+				{
+					File: &checker.File{
+						Path:    ".github/workflows/github-workflow-fuzzing.yaml",
+						Offset:  25,
+						Type:    finding.FileTypeSource,
+						Snippet: "go test -run=^$ -fuzz=FuzzParse -fuzztime=1m ./parser",
+					},
+					Type: checker.FuzzExecutionGoTest,
+				},
+			},
+		},
+		{
+			name:  "cargo fuzz run in GitLab CI",
+			files: []string{".gitlab-ci.yml"},
+			want: []checker.FuzzExecution{
+				{
+					File: &checker.File{
+						Path:    ".gitlab-ci.yml",
+						Offset:  9,
+						Type:    finding.FileTypeSource,
+						Snippet: "cargo +nightly fuzz run parse -- -max_total_time=300",
+					},
+					Type: checker.FuzzExecutionCargoFuzz,
+				},
+			},
+		},
+		{
+			name:  "no fuzzing in CI",
+			files: []string{".github/workflows/github-workflow-snyk.yaml"},
+			want:  nil,
+		},
+		{
+			name:     "unparsable CI configurations",
+			files:    []string{".github/workflows/github-workflow-invalid.yaml", ".gitlab-ci.yml"},
+			gitlabCI: "gitlab-ci-invalid.yml",
+			want:     nil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockClient := mockrepo.NewMockRepoClient(ctrl)
+			mockClient.EXPECT().ListFiles(gomock.Any()).Return(tt.files, nil).AnyTimes()
+			mockClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(f string) (io.ReadCloser, error) {
+				if f == ".gitlab-ci.yml" {
+					f = "gitlab-ci-fuzzing.yml"
+					if tt.gitlabCI != "" {
+						f = tt.gitlabCI
+					}
+				}
+				return os.Open("./testdata/" + f)
+			}).AnyTimes()
+			req := checker.CheckRequest{
+				RepoClient: mockClient,
+			}
+			got, err := getFuzzExecutions(&req)
+			if err != nil {
+				t.Fatalf("getFuzzExecutions: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func Test_getProminentLanguages(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -15,6 +15,7 @@
 package github
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"path/filepath"
@@ -23,6 +24,7 @@ import (
 
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/clients"
 	"github.com/ossf/scorecard/v4/finding"
 )
 
@@ -37,6 +39,9 @@ func Packaging(c *checker.CheckRequest) (checker.PackagingData, error) {
 		return data, fmt.Errorf("RepoClient.ListFiles: %w", err)
 	}
 
+	// The runs are only listed for the workflows publishing to ecosystems
+	// without a package yet, which bounds the calls to the API.
+	published := make(map[checker.PackageEcosystem]bool)
 	for _, fp := range matchedFiles {
 		fr, err := c.RepoClient.GetFileReader(fp)
 		if err != nil {
@@ -71,25 +76,64 @@ func Packaging(c *checker.CheckRequest) (checker.PackagingData, error) {
 			continue
 		}
 
+		jobs := fileparser.PackagingJobs(workflow, fp)
+		for _, job := range jobs {
+			data.ReleaseJobs = append(data.ReleaseJobs, checker.ReleaseJob{
+				File:         job.File,
+				ID:           job.JobID,
+				Environment:  job.Environment,
+				Credentials:  job.Credentials,
+				IDTokenWrite: job.IDTokenWrite,
+			})
+		}
+		if allPublished(jobs, published) {
+			continue
+		}
+
 		runs, err := c.RepoClient.ListSuccessfulWorkflowRuns(filepath.Base(fp))
 		if err != nil {
 			return data, fmt.Errorf("Client.Actions.ListWorkflowRunsByFileName: %w", err)
 		}
 
-		if len(runs) > 0 {
-			// Create package.
+		if len(runs) == 0 {
+			data.Packages = append(data.Packages,
+				checker.Package{
+					// Debug message.
+					Msg: StringPointer(fmt.Sprintf("GitHub publishing workflow not used in runs: %v", fp)),
+					File: &checker.File{
+						Path:   fp,
+						Type:   finding.FileTypeSource,
+						Offset: checker.OffsetDefault,
+					},
+					// TODO: Job
+				},
+			)
+			continue
+		}
+
+		// Create one package for each publishing job.
+		for _, job := range jobs {
+			published[job.Ecosystem] = true
+			name, err := fileparser.PackagePurl(c.RepoClient, job.Ecosystem)
+			if err != nil {
+				return data, fmt.Errorf("deriving package name: %w", err)
+			}
 			pkg := checker.Package{
 				File: &checker.File{
 					Path:   fp,
 					Type:   finding.FileTypeSource,
-					Offset: match.File.Offset,
+					Offset: job.File.Offset,
 				},
+				Ecosystem: job.Ecosystem,
 				Runs: []checker.Run{
 					{
 						URL: runs[0].URL,
 					},
 				},
 			}
+			if name != "" {
+				pkg.Name = &name
+			}
 			// Create runs.
 			for _, run := range runs {
 				pkg.Runs = append(pkg.Runs,
@@ -99,28 +143,44 @@ func Packaging(c *checker.CheckRequest) (checker.PackagingData, error) {
 				)
 			}
 			data.Packages = append(data.Packages, pkg)
-
-			return data, nil
 		}
+	}
 
-		data.Packages = append(data.Packages,
-			checker.Package{
-				// Debug message.
-				Msg: StringPointer(fmt.Sprintf("GitHub publishing workflow not used in runs: %v", fp)),
-				File: &checker.File{
-					Path:   fp,
-					Type:   finding.FileTypeSource,
-					Offset: checker.OffsetDefault,
-				},
-				// TODO: Job
-			},
-		)
+	if len(data.ReleaseJobs) > 0 {
+		data.Environments, err = listEnvironments(c.RepoClient)
+		if err != nil {
+			return data, err
+		}
 	}
 
 	// Return raw results.
 	return data, nil
 }
 
+// allPublished returns whether packages were already found for the ecosystems of all the jobs.
+func allPublished(jobs []fileparser.JobMatchResult, published map[checker.PackageEcosystem]bool) bool {
+	for _, job := range jobs {
+		if !published[job.Ecosystem] {
+			return false
+		}
+	}
+	return len(jobs) > 0
+}
+
+// listEnvironments returns the deployment environments of the repository,
+// or nil if they cannot be listed.
+func listEnvironments(c clients.RepoClient) ([]clients.Environment, error) {
+	environments, err := c.ListEnvironments()
+	switch {
+	case errors.Is(err, clients.ErrUnsupportedFeature):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("ListEnvironments: %w", err)
+	default:
+		return environments, nil
+	}
+}
+
 func StringPointer(s string) *string {
 	return &s
 }
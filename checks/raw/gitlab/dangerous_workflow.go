@@ -0,0 +1,268 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+// untrustedPredefinedVariables are set by GitLab from data controlled by
+// whoever pushes a commit or opens a merge request.
+var untrustedPredefinedVariables = []string{
+	"CI_COMMIT_AUTHOR",
+	"CI_COMMIT_BRANCH",
+	"CI_COMMIT_DESCRIPTION",
+	"CI_COMMIT_MESSAGE",
+	"CI_COMMIT_REF_NAME",
+	"CI_COMMIT_TAG",
+	"CI_COMMIT_TAG_MESSAGE",
+	"CI_COMMIT_TITLE",
+	"CI_EXTERNAL_PULL_REQUEST_SOURCE_BRANCH_NAME",
+	"CI_MERGE_REQUEST_DESCRIPTION",
+	"CI_MERGE_REQUEST_LABELS",
+	"CI_MERGE_REQUEST_MILESTONE",
+	"CI_MERGE_REQUEST_SOURCE_BRANCH_NAME",
+	"CI_MERGE_REQUEST_TITLE",
+	"GITLAB_USER_NAME",
+}
+
+var (
+	variableReferencePattern = regexp.MustCompile(
+		`\$\{?([A-Za-z_][A-Za-z0-9_]*)\}?|\$env:([A-Za-z_][A-Za-z0-9_]*)|%([A-Za-z_][A-Za-z0-9_]*)%`)
+	// codeEvaluationPattern matches the commands that run their arguments, or
+	// their input for the first group, as code. Variables are otherwise expanded
+	// by the shell without being evaluated.
+	codeEvaluationPattern = regexp.MustCompile(
+		`(\|\s*(?:ba|da|k|z)?sh\b)|(?:^|[\s;&|(])(?:eval|(?:ba|da|k|z)?sh\s+-c|python[0-9.]*\s+-c|` +
+			`node\s+-e|perl\s+-e|ruby\s+-e|Invoke-Expression|iex)\b`)
+)
+
+// DangerousWorkflow adds the dangerous patterns of the GitLab CI/CD configuration to data.
+func DangerousWorkflow(c *checker.CheckRequest, data *checker.DangerousWorkflowData) error {
+	ci, err := fileparser.ParseGitLabCIFromRepo(c.RepoClient)
+	if err != nil {
+		// A configuration GitLab cannot run either does not hide the findings of other files.
+		data.ProcessingErrors = append(data.ProcessingErrors, checker.ElementError{
+			Err: fmt.Errorf("parsing %s: %w", fileparser.GitLabCIFile, err),
+			Location: finding.Location{
+				Type: finding.FileTypeSource,
+				Path: fileparser.GitLabCIFile,
+			},
+		})
+		return nil
+	}
+	if ci == nil {
+		return nil
+	}
+	data.NumWorkflows += 1
+
+	validateScriptInjection(ci, data)
+
+	// Project variables are only known to the GitLab API.
+	variables, err := c.RepoClient.ListCIVariables()
+	switch {
+	case errors.Is(err, clients.ErrUnsupportedFeature):
+		return nil
+	case err != nil:
+		return fmt.Errorf("ListCIVariables: %w", err)
+	}
+	validateUnprotectedVariables(ci, variables, data)
+	return nil
+}
+
+// validateScriptInjection records the untrusted variables evaluated as code
+// by job scripts, including the ones reaching them through `variables:`.
+func validateScriptInjection(ci *fileparser.GitLabCI, data *checker.DangerousWorkflowData) {
+	for _, job := range ci.Jobs {
+		untrusted := untrustedVariables(ci, job)
+		for _, cmd := range job.Scripts() {
+			name := evaluatedVariable(cmd.Value, untrusted)
+			if name == "" {
+				continue
+			}
+			data.Workflows = append(data.Workflows, checker.DangerousWorkflow{
+				File: checker.File{
+					Path:    cmd.File,
+					Type:    finding.FileTypeSource,
+					Offset:  cmd.Line,
+					Snippet: name,
+				},
+				Job:  createJob(job),
+				Type: checker.DangerousWorkflowScriptInjection,
+			})
+		}
+	}
+}
+
+// validateUnprotectedVariables records the project variables which are not
+// protected and used by jobs running on merge requests. Protected variables
+// are withheld from merge request pipelines, which run code of the merge request,
+// so only unprotected ones can leak. Variables consumed by tools without being
+// referenced in the configuration are not detected.
+func validateUnprotectedVariables(ci *fileparser.GitLabCI, variables []clients.CIVariable,
+	data *checker.DangerousWorkflowData,
+) {
+	for _, job := range ci.Jobs {
+		if !ci.RunsOnMergeRequests(job) {
+			continue
+		}
+		defined := jobVariables(ci, job)
+		for _, v := range variables {
+			if v.Protected || !matchesEnvironmentScope(v.EnvironmentScope, job.Environment) {
+				continue
+			}
+			// Variables defined in the configuration take precedence over project ones.
+			if _, ok := defined[v.Key]; ok {
+				continue
+			}
+			for _, cmd := range job.Scripts() {
+				if !referencesVariable(cmd.Value, v.Key, defined) {
+					continue
+				}
+				data.Workflows = append(data.Workflows, checker.DangerousWorkflow{
+					File: checker.File{
+						Path:    cmd.File,
+						Type:    finding.FileTypeSource,
+						Offset:  cmd.Line,
+						Snippet: v.Key,
+					},
+					Job:  createJob(job),
+					Type: checker.DangerousWorkflowUnprotectedVariables,
+				})
+				break
+			}
+		}
+	}
+}
+
+// jobVariables returns the variables defined in the configuration for the job.
+func jobVariables(ci *fileparser.GitLabCI, job *fileparser.GitLabCIJob) map[string]string {
+	vars := make(map[string]string, len(ci.Variables)+len(job.Variables))
+	for name, value := range ci.Variables {
+		vars[name] = value
+	}
+	for name, value := range job.Variables {
+		vars[name] = value
+	}
+	return vars
+}
+
+// untrustedVariables returns the variables holding untrusted data in the job.
+func untrustedVariables(ci *fileparser.GitLabCI, job *fileparser.GitLabCIJob) map[string]bool {
+	vars := jobVariables(ci, job)
+	untrusted := make(map[string]bool)
+	for _, name := range untrustedPredefinedVariables {
+		if _, overridden := vars[name]; !overridden {
+			untrusted[name] = true
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for name, value := range vars {
+			if untrusted[name] {
+				continue
+			}
+			for _, ref := range referencedVariables(value) {
+				if untrusted[ref] {
+					untrusted[name] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	return untrusted
+}
+
+// evaluatedVariable returns the first untrusted variable that the command evaluates as code.
+func evaluatedVariable(command string, untrusted map[string]bool) string {
+	for _, m := range codeEvaluationPattern.FindAllStringSubmatchIndex(command, -1) {
+		code := command[m[1]:]
+		if m[2] != -1 {
+			// The input of the shell is written by the preceding commands.
+			code = command[:m[0]]
+		}
+		for _, name := range referencedVariables(code) {
+			if untrusted[name] {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// referencesVariable returns true if s references name, directly or through
+// the variables defined in the configuration.
+func referencesVariable(s, name string, defined map[string]string) bool {
+	seen := make(map[string]bool)
+	refs := referencedVariables(s)
+	for len(refs) > 0 {
+		ref := refs[0]
+		refs = refs[1:]
+		if ref == name {
+			return true
+		}
+		if seen[ref] {
+			continue
+		}
+		seen[ref] = true
+		if value, ok := defined[ref]; ok {
+			refs = append(refs, referencedVariables(value)...)
+		}
+	}
+	return false
+}
+
+func referencedVariables(s string) []string {
+	var names []string
+	for _, m := range variableReferencePattern.FindAllStringSubmatch(s, -1) {
+		for _, name := range m[1:] {
+			if name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// matchesEnvironmentScope returns true if a variable with the environment
+// scope is passed to a job deploying to the environment.
+func matchesEnvironmentScope(scope, environment string) bool {
+	if scope == "" || scope == "*" {
+		return true
+	}
+	if environment == "" {
+		return false
+	}
+	pattern := "^" + strings.ReplaceAll(regexp.QuoteMeta(scope), `\*`, ".*") + "$"
+	matched, err := regexp.MatchString(pattern, environment)
+	return err == nil && matched
+}
+
+func createJob(job *fileparser.GitLabCIJob) *checker.WorkflowJob {
+	name := job.Name
+	return &checker.WorkflowJob{
+		Name: &name,
+		ID:   &name,
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/clients"
+	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
+)
+
+type dangerousPattern struct {
+	Type    checker.DangerousWorkflowType
+	Job     string
+	Snippet string
+	Line    uint
+}
+
+func patternsOf(data *checker.DangerousWorkflowData) []dangerousPattern {
+	var ret []dangerousPattern
+	for _, w := range data.Workflows {
+		ret = append(ret, dangerousPattern{
+			Type:    w.Type,
+			Job:     *w.Job.Name,
+			Snippet: w.File.Snippet,
+			Line:    w.File.Offset,
+		})
+	}
+	return ret
+}
+
+func TestGitlabDangerousWorkflow(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(func(predicate func(string) (bool, error)) ([]string, error) {
+		var files []string
+		for _, f := range []string{fileparser.GitLabCIFile, "README.md"} {
+			if ok, _ := predicate(f); ok {
+				files = append(files, f)
+			}
+		}
+		return files, nil
+	})
+	mockRepoClient.EXPECT().GetFileReader(fileparser.GitLabCIFile).DoAndReturn(func(string) (io.ReadCloser, error) {
+		return os.Open("./testdata/dangerous-workflow.yaml")
+	})
+	mockRepoClient.EXPECT().ListCIVariables().Return([]clients.CIVariable{
+		{Key: "NPM_TOKEN", EnvironmentScope: "*"},
+	}, nil)
+
+	var data checker.DangerousWorkflowData
+	if err := DangerousWorkflow(&checker.CheckRequest{RepoClient: mockRepoClient}, &data); err != nil {
+		t.Fatalf("DangerousWorkflow: %v", err)
+	}
+	if data.NumWorkflows != 1 {
+		t.Errorf("expected 1 workflow, got %d", data.NumWorkflows)
+	}
+	want := []dangerousPattern{
+		{Type: checker.DangerousWorkflowScriptInjection, Job: "greet", Snippet: "CI_COMMIT_MESSAGE", Line: 13},
+		{Type: checker.DangerousWorkflowScriptInjection, Job: "greet", Snippet: "TITLE", Line: 14},
+		{Type: checker.DangerousWorkflowScriptInjection, Job: "greet", Snippet: "CI_COMMIT_TITLE", Line: 16},
+		{Type: checker.DangerousWorkflowUnprotectedVariables, Job: "publish", Snippet: "NPM_TOKEN", Line: 28},
+	}
+	if diff := cmp.Diff(want, patternsOf(&data)); diff != "" {
+		t.Errorf("unexpected patterns (-want +got):\n%s", diff)
+	}
+}
+
+func TestGitlabDangerousWorkflowNoConfiguration(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(nil, nil)
+
+	var data checker.DangerousWorkflowData
+	if err := DangerousWorkflow(&checker.CheckRequest{RepoClient: mockRepoClient}, &data); err != nil {
+		t.Fatalf("DangerousWorkflow: %v", err)
+	}
+	if data.NumWorkflows != 0 || len(data.Workflows) != 0 {
+		t.Errorf("unexpected data: %+v", data)
+	}
+}
+
+func TestGitlabDangerousWorkflowInvalidConfiguration(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{fileparser.GitLabCIFile}, nil)
+	mockRepoClient.EXPECT().GetFileReader(fileparser.GitLabCIFile).DoAndReturn(func(string) (io.ReadCloser, error) {
+		return os.Open("./testdata/invalid.yaml")
+	})
+
+	var data checker.DangerousWorkflowData
+	if err := DangerousWorkflow(&checker.CheckRequest{RepoClient: mockRepoClient}, &data); err != nil {
+		t.Fatalf("DangerousWorkflow: %v", err)
+	}
+	if len(data.ProcessingErrors) != 1 || data.ProcessingErrors[0].Location.Path != fileparser.GitLabCIFile {
+		t.Errorf("expected a processing error for %s, got %+v", fileparser.GitLabCIFile, data.ProcessingErrors)
+	}
+	if data.NumWorkflows != 0 || len(data.Workflows) != 0 {
+		t.Errorf("unexpected data: %+v", data)
+	}
+}
+
+func TestValidateUnprotectedVariables(t *testing.T) {
+	t.Parallel()
+	content, err := os.ReadFile("./testdata/dangerous-workflow.yaml")
+	if err != nil {
+		t.Fatalf("cannot read file: %v", err)
+	}
+	ci, err := fileparser.ParseGitLabCI(fileparser.GitLabCIFile, content, nil)
+	if err != nil {
+		t.Fatalf("ParseGitLabCI: %v", err)
+	}
+
+	var data checker.DangerousWorkflowData
+	validateUnprotectedVariables(ci, []clients.CIVariable{
+		{Key: "NPM_TOKEN", EnvironmentScope: "*"},
+		{Key: "DEPLOY_TOKEN", EnvironmentScope: "*", Protected: true},
+		{Key: "PROD_TOKEN", EnvironmentScope: "production"},
+		{Key: "TITLE", EnvironmentScope: "*"},
+	}, &data)
+	want := []dangerousPattern{
+		{Type: checker.DangerousWorkflowUnprotectedVariables, Job: "publish", Snippet: "NPM_TOKEN", Line: 28},
+	}
+	if diff := cmp.Diff(want, patternsOf(&data)); diff != "" {
+		t.Errorf("unexpected patterns (-want +got):\n%s", diff)
+	}
+}
+
+func TestMatchesEnvironmentScope(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		scope       string
+		environment string
+		want        bool
+	}{
+		{scope: "*", environment: "", want: true},
+		{scope: "production", environment: "production", want: true},
+		{scope: "production", environment: "", want: false},
+		{scope: "review/*", environment: "review/feature/x", want: true},
+		{scope: "review/*", environment: "staging", want: false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(strings.Join([]string{tt.scope, tt.environment}, "|"), func(t *testing.T) {
+			t.Parallel()
+			if got := matchesEnvironmentScope(tt.scope, tt.environment); got != tt.want {
+				t.Errorf("matchesEnvironmentScope(%q, %q) = %t, want %t", tt.scope, tt.environment, got, tt.want)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ package gitlab
 import (
 	"fmt"
 	"io"
+	"regexp"
 	"strings"
 
 	"github.com/ossf/scorecard/v4/checker"
@@ -43,17 +44,23 @@ func Packaging(c *checker.CheckRequest) (checker.PackagingData, error) {
 			return data, fmt.Errorf("reading from file: %w", err)
 		}
 
-		file, found := isGitlabPackagingWorkflow(fc, fp)
-
-		if found {
-			data.Packages = append(data.Packages, checker.Package{
-				Name: new(string),
-				Job:  &checker.WorkflowJob{},
-				File: &file,
-				Msg:  nil,
-				Runs: []checker.Run{{URL: c.Repo.URI()}},
-			})
-			return data, nil
+		for _, match := range packagingCommandsIn(fc, fp) {
+			match := match
+			pkg := checker.Package{
+				Job:       &checker.WorkflowJob{},
+				File:      &match.File,
+				Msg:       nil,
+				Ecosystem: match.Ecosystem,
+				Runs:      []checker.Run{{URL: c.Repo.URI()}},
+			}
+			name, err := fileparser.PackagePurl(c.RepoClient, match.Ecosystem)
+			if err != nil {
+				return data, fmt.Errorf("deriving package name: %w", err)
+			}
+			if name != "" {
+				pkg.Name = &name
+			}
+			data.Packages = append(data.Packages, pkg)
 		}
 	}
 
@@ -64,29 +71,48 @@ func StringPointer(s string) *string {
 	return &s
 }
 
-func isGitlabPackagingWorkflow(fc []byte, fp string) (checker.File, bool) {
-	lineNumber := checker.OffsetDefault
+// packagingCommands are the commands publishing packages, by ecosystem.
+var packagingCommands = []struct {
+	pattern   *regexp.Regexp
+	ecosystem checker.PackageEcosystem
+}{
+	{regexp.MustCompile(`(docker|podman|buildah) push|docker buildx build.*--push|/kaniko/executor`),
+		checker.PackageEcosystemContainer},
+	{regexp.MustCompile(`\bko (build|publish|resolve|apply)|(mvn|gradle).*jib`), checker.PackageEcosystemContainer},
+	{regexp.MustCompile(`nuget push`), checker.PackageEcosystemNuGet},
+	{regexp.MustCompile(`(poetry|flit|hatch|uv) publish|twine upload`), checker.PackageEcosystemPyPI},
+	{regexp.MustCompile(`(npm|yarn|pnpm) publish`), checker.PackageEcosystemNpm},
+	{regexp.MustCompile(`gem push`), checker.PackageEcosystemRubyGems},
+	{regexp.MustCompile(`cargo publish`), checker.PackageEcosystemCratesIO},
+	{regexp.MustCompile(`mvn .*deploy|gradle.*publish`), checker.PackageEcosystemMaven},
+	{regexp.MustCompile(`goreleaser release`), checker.PackageEcosystemGo},
+	{regexp.MustCompile(`helm (push|cm-push)|cr upload`), checker.PackageEcosystemHelm},
+}
 
-	packagingStrings := []string{
-		"docker push",
-		"nuget push",
-		"poetry publish",
-		"twine upload",
-	}
+type packagingCommand struct {
+	File      checker.File
+	Ecosystem checker.PackageEcosystem
+}
 
-ParseLines:
-	for idx, val := range strings.Split(string(fc[:]), "\n") {
-		for _, element := range packagingStrings {
-			if strings.Contains(val, element) {
-				lineNumber = uint(idx + 1)
-				break ParseLines
+// packagingCommandsIn returns the first line publishing to each ecosystem.
+func packagingCommandsIn(fc []byte, fp string) []packagingCommand {
+	var commands []packagingCommand
+	found := map[checker.PackageEcosystem]bool{}
+	for idx, val := range strings.Split(string(fc), "\n") {
+		for _, command := range packagingCommands {
+			if found[command.ecosystem] || !command.pattern.MatchString(val) {
+				continue
 			}
+			found[command.ecosystem] = true
+			commands = append(commands, packagingCommand{
+				File: checker.File{
+					Path:   fp,
+					Offset: uint(idx + 1),
+					Type:   finding.FileTypeSource,
+				},
+				Ecosystem: command.ecosystem,
+			})
 		}
 	}
-
-	return checker.File{
-		Path:   fp,
-		Offset: lineNumber,
-		Type:   finding.FileTypeSource,
-	}, lineNumber != checker.OffsetDefault
+	return commands
 }
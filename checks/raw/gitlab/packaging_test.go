@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
 
 	"github.com/ossf/scorecard/v4/checker"
 	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
@@ -34,6 +35,7 @@ func TestGitlabPackagingYamlCheck(t *testing.T) {
 		lineNumber uint
 		filename   string
 		exists     bool
+		ecosystems []checker.PackageEcosystem
 	}{
 		{
 			name:       "No Publishing Detected",
@@ -46,24 +48,41 @@ func TestGitlabPackagingYamlCheck(t *testing.T) {
 			filename:   "./testdata/docker.yaml",
 			lineNumber: 31,
 			exists:     true,
+			ecosystems: []checker.PackageEcosystem{checker.PackageEcosystemContainer},
 		},
 		{
 			name:       "Nuget",
 			filename:   "./testdata/nuget.yaml",
 			lineNumber: 21,
 			exists:     true,
+			ecosystems: []checker.PackageEcosystem{
+				checker.PackageEcosystemNuGet,
+				checker.PackageEcosystemContainer,
+			},
 		},
 		{
 			name:       "Poetry",
 			filename:   "./testdata/poetry.yaml",
 			lineNumber: 30,
 			exists:     true,
+			ecosystems: []checker.PackageEcosystem{checker.PackageEcosystemPyPI},
 		},
 		{
 			name:       "Twine",
 			filename:   "./testdata/twine.yaml",
 			lineNumber: 26,
 			exists:     true,
+			ecosystems: []checker.PackageEcosystem{checker.PackageEcosystemPyPI},
+		},
+		{
+			name:       "Cargo and Helm",
+			filename:   "./testdata/cargo-helm.yaml",
+			lineNumber: 20,
+			exists:     true,
+			ecosystems: []checker.PackageEcosystem{
+				checker.PackageEcosystemCratesIO,
+				checker.PackageEcosystemHelm,
+			},
 		},
 	}
 
@@ -79,7 +98,16 @@ func TestGitlabPackagingYamlCheck(t *testing.T) {
 				t.Errorf("cannot read file: %v", err)
 			}
 
-			file, found := isGitlabPackagingWorkflow(content, tt.filename)
+			commands := packagingCommandsIn(content, tt.filename)
+			found := len(commands) > 0
+			file := checker.File{Offset: checker.OffsetDefault}
+			var ecosystems []checker.PackageEcosystem
+			for _, command := range commands {
+				ecosystems = append(ecosystems, command.Ecosystem)
+			}
+			if found {
+				file = commands[0].File
+			}
 
 			if tt.exists && !found {
 				t.Errorf("Packaging %q should exist", tt.name)
@@ -91,6 +119,10 @@ func TestGitlabPackagingYamlCheck(t *testing.T) {
 				t.Errorf("Expected line number: %d != %d", tt.lineNumber, file.Offset)
 			}
 
+			if diff := cmp.Diff(tt.ecosystems, ecosystems); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+
 			if err != nil {
 				return
 			}
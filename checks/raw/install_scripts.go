@@ -0,0 +1,396 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+// installManifest is a package manifest able to run code at install time.
+type installManifest struct {
+	// collect returns the install scripts of the manifest, and whether
+	// the manifest describes a published package at all.
+	collect func(c clients.RepoClient, path string, content []byte) ([]checker.InstallScript, bool, error)
+	pattern string
+}
+
+var installManifests = []installManifest{
+	{pattern: "package.json", collect: npmInstallScripts},
+	{pattern: "setup.py", collect: setupPyInstallScripts},
+	{pattern: "pyproject.toml", collect: pyprojectInstallScripts},
+	{pattern: "*.gemspec", collect: gemspecInstallScripts},
+}
+
+// npmInstallLifecycleScripts are the scripts npm runs when installing a package.
+var npmInstallLifecycleScripts = []string{"preinstall", "install", "postinstall"}
+
+// knownPythonBuildBackends are the build backends published on PyPI,
+// which do not run code of the package itself when building it.
+var knownPythonBuildBackends = map[string]bool{
+	"flit_core.buildapi":               true,
+	"hatchling.build":                  true,
+	"maturin":                          true,
+	"mesonpy":                          true,
+	"pdm.backend":                      true,
+	"pdm.pep517.api":                   true,
+	"poetry.core.masonry.api":          true,
+	"scikit_build_core.build":          true,
+	"setuptools.build_meta":            true,
+	"setuptools.build_meta:__legacy__": true,
+	"sipbuild.api":                     true,
+}
+
+var (
+	// reSetupPyCall matches the subprocess and network calls of a `setup.py`.
+	reSetupPyCall = regexp.MustCompile(`\b(subprocess\.\w+|os\.(?:system|popen|exec\w*|spawn\w*)|` +
+		`urllib\.request\.\w+|urllib2\.urlopen|urlopen|urlretrieve|requests\.(?:get|post|request)|` +
+		`http\.client\.\w+|socket\.(?:socket|create_connection))\s*\(`)
+	// reSetupPyBlock matches the statements opening a block whose body does not run at import time.
+	reSetupPyBlock = regexp.MustCompile(`^(?:async\s+def|def|class)\b`)
+	// reGemspecExtensions matches `spec.extensions = [...]` and `spec.extensions << "..."`.
+	reGemspecExtensions = regexp.MustCompile(`\.extensions\s*(?:=|<<|\+=|\.push\(|\.concat\()\s*(.+)$`)
+	// reRubyShellCommand matches `system("...")`, `%x(...)` and backticks in an `extconf.rb`.
+	reRubyShellCommand = regexp.MustCompile("(?:\\bsystem\\s*\\(?\\s*(?:\"([^\"]*)\"|'([^']*)')|%x\\(([^)]*)\\)|`([^`]*)`)")
+)
+
+// InstallScripts retrieves the raw data for the Install-Scripts check.
+func InstallScripts(c *checker.CheckRequest) (checker.InstallScriptsData, error) {
+	var results checker.InstallScriptsData
+	for _, manifest := range installManifests {
+		err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+			Pattern:       manifest.pattern,
+			CaseSensitive: true,
+		}, collectInstallScripts, c.RepoClient, manifest, &results)
+		if err != nil {
+			return checker.InstallScriptsData{}, err
+		}
+	}
+	return results, nil
+}
+
+var collectInstallScripts fileparser.DoWhileTrueOnFileContent = func(path string, content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 3 {
+		return false, fmt.Errorf("collectInstallScripts requires exactly 3 arguments: %w", errInvalidArgLength)
+	}
+	client, ok := args[0].(clients.RepoClient)
+	if !ok {
+		return false, fmt.Errorf("collectInstallScripts requires argument of type clients.RepoClient: %w", errInvalidArgType)
+	}
+	manifest, ok := args[1].(installManifest)
+	if !ok {
+		return false, fmt.Errorf("collectInstallScripts requires argument of type installManifest: %w", errInvalidArgType)
+	}
+	results, ok := args[2].(*checker.InstallScriptsData)
+	if !ok {
+		return false, fmt.Errorf(
+			"collectInstallScripts requires argument of type *checker.InstallScriptsData: %w", errInvalidArgType)
+	}
+	// Vendored packages are installed from their own registry, not from this repository.
+	if isVendoredPath(path) {
+		return true, nil
+	}
+	scripts, isPackage, err := manifest.collect(client, path, content)
+	if err != nil {
+		return false, err
+	}
+	if !isPackage {
+		return true, nil
+	}
+	results.Manifests = append(results.Manifests, checker.File{
+		Path:   path,
+		Type:   finding.FileTypeSource,
+		Offset: checker.OffsetDefault,
+	})
+	results.Scripts = append(results.Scripts, scripts...)
+	return true, nil
+}
+
+func npmInstallScripts(_ clients.RepoClient, path string, content []byte) ([]checker.InstallScript, bool, error) {
+	var manifest struct {
+		Scripts map[string]string `json:"scripts"`
+		Private bool              `json:"private"`
+	}
+	// Manifests which do not parse cannot be installed either.
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil, false, nil
+	}
+	// Private packages cannot be published.
+	if manifest.Private {
+		return nil, false, nil
+	}
+	var scripts []checker.InstallScript
+	for _, name := range npmInstallLifecycleScripts {
+		command, ok := manifest.Scripts[name]
+		if !ok {
+			continue
+		}
+		line := lineContaining(content, fmt.Sprintf("%q", name))
+		downloads, errs, err := shellDownloads(path, line, command)
+		if err != nil {
+			return nil, false, err
+		}
+		scripts = append(scripts, checker.InstallScript{
+			Name:             name,
+			Type:             checker.InstallScriptNpmLifecycle,
+			File:             newInstallScriptFile(path, line, command),
+			Downloads:        downloads,
+			ProcessingErrors: errs,
+		})
+	}
+	return scripts, true, nil
+}
+
+// setupPyInstallScripts returns the subprocess and network calls run when
+// a `setup.py` is imported, i.e. those outside of function and class bodies.
+func setupPyInstallScripts(_ clients.RepoClient, path string, content []byte) ([]checker.InstallScript, bool, error) {
+	var scripts []checker.InstallScript
+	inBlock := false
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for line := uint(1); scanner.Scan(); line++ {
+		text := scanner.Text()
+		trimmed := strings.TrimSpace(text)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if !strings.HasPrefix(text, " ") && !strings.HasPrefix(text, "\t") && !strings.HasPrefix(trimmed, "@") {
+			inBlock = reSetupPyBlock.MatchString(trimmed)
+		}
+		if inBlock {
+			continue
+		}
+		match := reSetupPyCall.FindStringSubmatch(trimmed)
+		if match == nil {
+			continue
+		}
+		var downloads []checker.Dependency
+		var errs []checker.ElementError
+		if command := firstQuotedString(trimmed); command != "" {
+			var err error
+			downloads, errs, err = shellDownloads(path, line, command)
+			if err != nil {
+				return nil, false, err
+			}
+		}
+		scripts = append(scripts, checker.InstallScript{
+			Name:             match[1],
+			Type:             checker.InstallScriptSetupPy,
+			File:             newInstallScriptFile(path, line, trimmed),
+			Downloads:        downloads,
+			ProcessingErrors: errs,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, false, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return scripts, true, nil
+}
+
+// pyprojectInstallScripts returns the build backend of a `pyproject.toml`
+// if it is part of the repository or is not a well-known one.
+func pyprojectInstallScripts(_ clients.RepoClient, path string, content []byte) ([]checker.InstallScript, bool, error) {
+	var manifest struct {
+		Project     map[string]interface{} `toml:"project"`
+		BuildSystem struct {
+			BuildBackend string   `toml:"build-backend"`
+			BackendPath  []string `toml:"backend-path"`
+		} `toml:"build-system"`
+	}
+	md, err := toml.Decode(string(content), &manifest)
+	if err != nil {
+		return nil, false, nil
+	}
+	// Configuration-only files, e.g. for linters, are not packages.
+	if manifest.Project == nil && !md.IsDefined("build-system") {
+		return nil, false, nil
+	}
+	// Without a backend, pip falls back to `setup.py`, which is looked at on its own.
+	backend := manifest.BuildSystem.BuildBackend
+	if backend == "" {
+		return nil, true, nil
+	}
+	if len(manifest.BuildSystem.BackendPath) == 0 && knownPythonBuildBackends[backend] {
+		return nil, true, nil
+	}
+	line := lineContaining(content, "build-backend")
+	return []checker.InstallScript{
+		{
+			Name: backend,
+			Type: checker.InstallScriptPythonBuildBackend,
+			File: newInstallScriptFile(path, line, strings.TrimSpace(lineAt(content, line))),
+		},
+	}, true, nil
+}
+
+// gemspecInstallScripts returns the native extensions of a gemspec. The shell
+// commands of the `extconf.rb` of an extension are looked at for downloads.
+func gemspecInstallScripts(c clients.RepoClient, path string, content []byte) ([]checker.InstallScript, bool, error) {
+	var scripts []checker.InstallScript
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for line := uint(1); scanner.Scan(); line++ {
+		trimmed := strings.TrimSpace(scanner.Text())
+		match := reGemspecExtensions.FindStringSubmatch(trimmed)
+		if match == nil {
+			continue
+		}
+		extensions := quotedStrings(match[1])
+		// Extensions listed with a glob, e.g. `Dir["ext/**/extconf.rb"]`, are reported as a whole.
+		if len(extensions) == 0 || strings.Contains(match[1], "Dir") {
+			extensions = []string{strings.TrimSpace(match[1])}
+		}
+		for _, extension := range extensions {
+			downloads, errs, err := extconfDownloads(c, path, extension)
+			if err != nil {
+				return nil, false, err
+			}
+			scripts = append(scripts, checker.InstallScript{
+				Name:             extension,
+				Type:             checker.InstallScriptGemExtension,
+				File:             newInstallScriptFile(path, line, trimmed),
+				Downloads:        downloads,
+				ProcessingErrors: errs,
+			})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, false, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return scripts, true, nil
+}
+
+// extconfDownloads returns the unpinned downloads of the shell commands of an `extconf.rb`,
+// along with the commands which could not be parsed.
+func extconfDownloads(c clients.RepoClient, gemspec, extension string,
+) ([]checker.Dependency, []checker.ElementError, error) {
+	if !strings.HasSuffix(extension, ".rb") {
+		return nil, nil, nil
+	}
+	extconf := path.Join(path.Dir(gemspec), extension)
+	reader, err := c.GetFileReader(extconf)
+	if err != nil {
+		// The extension may be generated when the gem is built.
+		return nil, nil, nil
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading %s: %w", extconf, err)
+	}
+	var downloads []checker.Dependency
+	var errs []checker.ElementError
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for line := uint(1); scanner.Scan(); line++ {
+		for _, match := range reRubyShellCommand.FindAllStringSubmatch(scanner.Text(), -1) {
+			command := strings.Join(match[1:], "")
+			d, e, err := shellDownloads(extconf, line, command)
+			if err != nil {
+				return nil, nil, err
+			}
+			downloads = append(downloads, d...)
+			errs = append(errs, e...)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("reading %s: %w", extconf, err)
+	}
+	return downloads, errs, nil
+}
+
+// shellDownloads feeds a shell command through the download analysis of
+// Pinned-Dependencies and returns the unpinned dependencies it finds,
+// along with the parts of the command which could not be parsed.
+func shellDownloads(path string, line uint, command string,
+) ([]checker.Dependency, []checker.ElementError, error) {
+	var r checker.PinningDependenciesData
+	// Lines of the shell nodes start at 1.
+	err := validateShellFile(path, line-1, line-1, []byte(command), map[string]bool{}, &r)
+	if err != nil {
+		return nil, nil, err
+	}
+	var downloads []checker.Dependency
+	for i := range r.Dependencies {
+		d := r.Dependencies[i]
+		if d.Pinned != nil && *d.Pinned {
+			continue
+		}
+		downloads = append(downloads, d)
+	}
+	// Parse errors are located at the start line given above; point them at the script instead.
+	for i := range r.ProcessingErrors {
+		r.ProcessingErrors[i].Location.LineStart = &line
+		r.ProcessingErrors[i].Location.LineEnd = &line
+	}
+	return downloads, r.ProcessingErrors, nil
+}
+
+func newInstallScriptFile(path string, line uint, snippet string) checker.File {
+	return checker.File{
+		Path:      path,
+		Type:      finding.FileTypeSource,
+		Offset:    line,
+		EndOffset: line,
+		Snippet:   snippet,
+	}
+}
+
+// lineContaining returns the number of the first line containing s.
+func lineContaining(content []byte, s string) uint {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for line := uint(1); scanner.Scan(); line++ {
+		if strings.Contains(scanner.Text(), s) {
+			return line
+		}
+	}
+	return checker.OffsetDefault
+}
+
+func lineAt(content []byte, line uint) string {
+	lines := strings.Split(string(content), "\n")
+	if line == 0 || int(line) > len(lines) {
+		return ""
+	}
+	return lines[line-1]
+}
+
+func firstQuotedString(s string) string {
+	strs := quotedStrings(s)
+	if len(strs) == 0 {
+		return ""
+	}
+	return strs[0]
+}
+
+func quotedStrings(s string) []string {
+	var strs []string
+	for _, match := range reQuotedString.FindAllStringSubmatch(s, -1) {
+		strs = append(strs, match[1]+match[2])
+	}
+	return strs
+}
@@ -0,0 +1,194 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
+)
+
+// installScriptSummary is the part of an install script compared by the tests.
+type installScriptSummary struct {
+	Name             string
+	Type             checker.InstallScriptType
+	Path             string
+	Line             uint
+	Downloads        []uint
+	ProcessingErrors []uint
+}
+
+func TestInstallScripts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		files         map[string]string
+		wantManifests []string
+		want          []installScriptSummary
+	}{
+		{
+			name: "no manifests",
+		},
+		{
+			name:          "npm lifecycle scripts",
+			files:         map[string]string{"package.json": "package.json"},
+			wantManifests: []string{"package.json"},
+			want: []installScriptSummary{
+				{Name: "preinstall", Type: checker.InstallScriptNpmLifecycle, Path: "package.json", Line: 6},
+				{Name: "postinstall", Type: checker.InstallScriptNpmLifecycle, Path: "package.json", Line: 7, Downloads: []uint{7}},
+			},
+		},
+		{
+			name:          "npm lifecycle script which does not parse",
+			files:         map[string]string{"package.json": "unparsable-package.json"},
+			wantManifests: []string{"package.json"},
+			want: []installScriptSummary{
+				{Name: "install", Type: checker.InstallScriptNpmLifecycle, Path: "package.json", Line: 5},
+				{Name: "postinstall", Type: checker.InstallScriptNpmLifecycle, Path: "package.json", Line: 6, ProcessingErrors: []uint{6}},
+			},
+		},
+		{
+			name:  "private npm package",
+			files: map[string]string{"website/package.json": "private-package.json"},
+		},
+		{
+			name:  "vendored npm package",
+			files: map[string]string{"node_modules/example/package.json": "package.json"},
+		},
+		{
+			name:          "setup.py module level calls",
+			files:         map[string]string{"setup.py": "setup.py"},
+			wantManifests: []string{"setup.py"},
+			want: []installScriptSummary{
+				{Name: "os.system", Type: checker.InstallScriptSetupPy, Path: "setup.py", Line: 11, Downloads: []uint{11}},
+				{Name: "subprocess.check_call", Type: checker.InstallScriptSetupPy, Path: "setup.py", Line: 12},
+			},
+		},
+		{
+			name:          "in-tree build backend",
+			files:         map[string]string{"pyproject.toml": "pyproject.toml"},
+			wantManifests: []string{"pyproject.toml"},
+			want: []installScriptSummary{
+				{Name: "backend", Type: checker.InstallScriptPythonBuildBackend, Path: "pyproject.toml", Line: 7},
+			},
+		},
+		{
+			name:          "known build backend",
+			files:         map[string]string{"pyproject.toml": "pyproject-known.toml"},
+			wantManifests: []string{"pyproject.toml"},
+		},
+		{
+			name:  "pyproject.toml without package",
+			files: map[string]string{"pyproject.toml": "pyproject-tools.toml"},
+		},
+		{
+			name: "gem extension",
+			files: map[string]string{
+				"example.gemspec":       "example.gemspec",
+				"ext/native/extconf.rb": "ext/native/extconf.rb",
+			},
+			wantManifests: []string{"example.gemspec"},
+			want: []installScriptSummary{
+				{
+					Name:      "ext/native/extconf.rb",
+					Type:      checker.InstallScriptGemExtension,
+					Path:      "example.gemspec",
+					Line:      4,
+					Downloads: []uint{3},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+			mockRepoClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(
+				func(predicate func(string) (bool, error)) ([]string, error) {
+					var files []string
+					for file := range tt.files {
+						match, err := predicate(file)
+						if err != nil {
+							return nil, err
+						}
+						if match {
+							files = append(files, file)
+						}
+					}
+					return files, nil
+				}).AnyTimes()
+			mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
+				testdata, ok := tt.files[file]
+				if !ok {
+					return nil, os.ErrNotExist
+				}
+				return os.Open(filepath.Join("testdata", "install_scripts", testdata))
+			}).AnyTimes()
+
+			got, err := InstallScripts(&checker.CheckRequest{RepoClient: mockRepoClient})
+			if err != nil {
+				t.Fatalf("InstallScripts: %v", err)
+			}
+			var manifests []string
+			for _, m := range got.Manifests {
+				manifests = append(manifests, m.Path)
+			}
+			if diff := cmp.Diff(tt.wantManifests, manifests); diff != "" {
+				t.Errorf("manifests mismatch (-want +got):\n%s", diff)
+			}
+			var scripts []installScriptSummary
+			for _, s := range got.Scripts {
+				scripts = append(scripts, installScriptSummary{
+					Name:             s.Name,
+					Type:             s.Type,
+					Path:             s.File.Path,
+					Line:             s.File.Offset,
+					Downloads:        downloadLines(s.Downloads),
+					ProcessingErrors: processingErrorLines(s.ProcessingErrors),
+				})
+			}
+			if diff := cmp.Diff(tt.want, scripts); diff != "" {
+				t.Errorf("scripts mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func downloadLines(downloads []checker.Dependency) []uint {
+	var lines []uint
+	for _, d := range downloads {
+		lines = append(lines, d.Location.Offset)
+	}
+	return lines
+}
+
+func processingErrorLines(errs []checker.ElementError) []uint {
+	var lines []uint
+	for _, e := range errs {
+		if e.Location.LineStart != nil {
+			lines = append(lines, *e.Location.LineStart)
+		}
+	}
+	return lines
+}
@@ -106,6 +106,22 @@ var reLicenseFileExts = regexp.MustCompile(
 
 // License retrieves the raw data for the License check.
 func License(c *checker.CheckRequest) (checker.LicenseData, error) {
+	results, err := getLicenseFiles(c)
+	if err != nil {
+		return results, err
+	}
+	// Unlike license files, the declared licenses are read from the repository
+	// content for all clients.
+	results.ManifestLicenses, results.HeaderLicenses, err = getLicenseDeclarations(c.RepoClient)
+	if err != nil {
+		return results, fmt.Errorf("getLicenseDeclarations: %w", err)
+	}
+	return results, nil
+}
+
+// getLicenseFiles looks for the license files using the API of the repository
+// or, when it is not available, by their names.
+func getLicenseFiles(c *checker.CheckRequest) (checker.LicenseData, error) {
 	var results checker.LicenseData
 
 	// prepare case insensitive map to map approved licenses matched in repo.
@@ -0,0 +1,341 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+// licenseManifests maps the patterns of the package manifests declaring
+// a license to the function extracting the license expression.
+var licenseManifests = map[string]func(content []byte) string{
+	"package.json":   packageJSONLicense,
+	"Cargo.toml":     cargoTOMLLicense,
+	"pyproject.toml": pyprojectTOMLLicense,
+	"*.gemspec":      gemspecLicense,
+	"pom.xml":        pomXMLLicense,
+}
+
+// licenseHeaderExtensions are the extensions of the source files looked at for
+// SPDX-License-Identifier headers.
+var licenseHeaderExtensions = map[string]bool{
+	".c": true, ".cc": true, ".cpp": true, ".cs": true, ".go": true, ".h": true, ".hpp": true,
+	".java": true, ".js": true, ".kt": true, ".php": true, ".py": true, ".rb": true, ".rs": true,
+	".scala": true, ".sh": true, ".swift": true, ".ts": true,
+}
+
+// licenseHeaderLines is how many lines at the top of a file are searched for a header.
+const licenseHeaderLines = 20
+
+var (
+	reSPDXHeader = regexp.MustCompile(`SPDX-License-Identifier:\s*(.+)$`)
+	// reGemspecLicense matches `spec.license = "MIT"` and `spec.licenses = ["MIT", "Apache-2.0"]`.
+	reGemspecLicense = regexp.MustCompile(`\.licenses?\s*=\s*(\[[^\]]*\]|"[^"]*"|'[^']*')`)
+	reQuotedString   = regexp.MustCompile(`"([^"]*)"|'([^']*)'`)
+)
+
+// getLicenseDeclarations returns the licenses declared in package manifests and source file headers.
+// The files are listed once, and only the top of the source files is read.
+func getLicenseDeclarations(c clients.RepoClient) (manifests, headers []checker.LicenseDeclaration, err error) {
+	var manifestFiles, headerFiles []string
+	err = fileparser.OnAllFilesDo(c, func(p string, args ...interface{}) (bool, error) {
+		switch {
+		case manifestLicenseParser(p) != nil:
+			manifestFiles = append(manifestFiles, p)
+		case isLicenseHeaderFile(p):
+			headerFiles = append(headerFiles, p)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, p := range manifestFiles {
+		expression, err := readManifestLicense(c, p)
+		if err != nil {
+			return nil, nil, err
+		}
+		if expression != "" {
+			manifests = append(manifests, newLicenseDeclaration(p, expression, checker.OffsetDefault))
+		}
+	}
+	for _, p := range headerFiles {
+		declaration, err := readHeaderLicense(c, p)
+		if err != nil {
+			return nil, nil, err
+		}
+		if declaration != nil {
+			headers = append(headers, *declaration)
+		}
+	}
+	return manifests, headers, nil
+}
+
+// manifestLicenseParser returns the function extracting the license of the
+// manifest at p, or nil if p is not a manifest of the project.
+func manifestLicenseParser(p string) func(content []byte) string {
+	// Manifests in subdirectories describe other packages than the project itself.
+	if strings.Contains(p, "/") {
+		return nil
+	}
+	for pattern, parse := range licenseManifests {
+		if match, err := path.Match(pattern, p); err == nil && match {
+			return parse
+		}
+	}
+	return nil
+}
+
+func isLicenseHeaderFile(p string) bool {
+	// Vendored code keeps the license of its authors.
+	if isVendoredPath(p) || strings.HasPrefix(p, "testdata/") || strings.Contains(p, "/testdata/") {
+		return false
+	}
+	return licenseHeaderExtensions[strings.ToLower(path.Ext(p))]
+}
+
+func readManifestLicense(c clients.RepoClient, p string) (string, error) {
+	reader, err := c.GetFileReader(p)
+	if err != nil {
+		return "", fmt.Errorf("GetFileReader: %w", err)
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("reading %s: %w", p, err)
+	}
+	return manifestLicenseParser(p)(content), nil
+}
+
+// readHeaderLicense returns the license declared by the SPDX-License-Identifier
+// header of the file at p, or nil if its first licenseHeaderLines lines have none.
+func readHeaderLicense(c clients.RepoClient, p string) (*checker.LicenseDeclaration, error) {
+	reader, err := c.GetFileReader(p)
+	if err != nil {
+		return nil, fmt.Errorf("GetFileReader: %w", err)
+	}
+	defer reader.Close()
+	scanner := bufio.NewScanner(reader)
+	for line := uint(1); line <= licenseHeaderLines && scanner.Scan(); line++ {
+		match := reSPDXHeader.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+		expression := trimCommentEnd(match[1])
+		if expression == "" {
+			return nil, nil
+		}
+		declaration := newLicenseDeclaration(p, expression, line)
+		return &declaration, nil
+	}
+	return nil, nil
+}
+
+func isVendoredPath(path string) bool {
+	for _, dir := range []string{"vendor/", "third_party/", "third-party/", "node_modules/"} {
+		if strings.HasPrefix(path, dir) || strings.Contains(path, "/"+dir) {
+			return true
+		}
+	}
+	return false
+}
+
+// trimCommentEnd removes the end of a block comment following a header, e.g. in `/* SPDX-License-Identifier: MIT */`.
+func trimCommentEnd(s string) string {
+	s = strings.TrimSpace(s)
+	for _, end := range []string{"*/", "-->", "*)"} {
+		s = strings.TrimSpace(strings.TrimSuffix(s, end))
+	}
+	return s
+}
+
+func newLicenseDeclaration(path, expression string, line uint) checker.LicenseDeclaration {
+	declaration := checker.LicenseDeclaration{
+		File: checker.File{
+			Path:   path,
+			Type:   finding.FileTypeSource,
+			Offset: line,
+		},
+		Expression: expression,
+	}
+	if expr, err := parseSPDXExpression(expression); err == nil {
+		declaration.Valid = true
+		declaration.SpdxIDs = expr.licenses
+		declaration.Approved = expr.approved
+	}
+	return declaration
+}
+
+// packageJSONLicense reads the license of an npm package. The deprecated
+// `licenses` array and object forms are supported as well.
+// See https://docs.npmjs.com/cli/configuring-npm/package-json#license.
+func packageJSONLicense(content []byte) string {
+	type licenseObject struct {
+		Type string `json:"type"`
+	}
+	var manifest struct {
+		License  json.RawMessage `json:"license"`
+		Licenses []licenseObject `json:"licenses"`
+	}
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return ""
+	}
+	var license string
+	if err := json.Unmarshal(manifest.License, &license); err == nil {
+		return license
+	}
+	var object licenseObject
+	if err := json.Unmarshal(manifest.License, &object); err == nil && object.Type != "" {
+		return object.Type
+	}
+	var types []string
+	for _, l := range manifest.Licenses {
+		if l.Type != "" {
+			types = append(types, l.Type)
+		}
+	}
+	return joinLicenses(types)
+}
+
+// cargoTOMLLicense reads the license of a Rust crate.
+// See https://doc.rust-lang.org/cargo/reference/manifest.html#the-license-and-license-file-fields.
+func cargoTOMLLicense(content []byte) string {
+	var manifest struct {
+		Package struct {
+			// License is a table when inherited from the workspace.
+			License any `toml:"license"`
+		} `toml:"package"`
+		Workspace struct {
+			Package struct {
+				License string `toml:"license"`
+			} `toml:"package"`
+		} `toml:"workspace"`
+	}
+	if err := toml.Unmarshal(content, &manifest); err != nil {
+		return ""
+	}
+	if license, ok := manifest.Package.License.(string); ok {
+		return license
+	}
+	return manifest.Workspace.Package.License
+}
+
+// pyprojectTOMLLicense reads the license of a Python project, either an
+// expression (PEP 639) or the text of the legacy table (PEP 621).
+func pyprojectTOMLLicense(content []byte) string {
+	var manifest struct {
+		Project struct {
+			License any `toml:"license"`
+		} `toml:"project"`
+	}
+	if err := toml.Unmarshal(content, &manifest); err != nil {
+		return ""
+	}
+	switch license := manifest.Project.License.(type) {
+	case string:
+		return license
+	case map[string]any:
+		if text, ok := license["text"].(string); ok && !strings.Contains(text, "\n") {
+			return strings.TrimSpace(text)
+		}
+	}
+	return ""
+}
+
+// gemspecLicense reads the license of a Ruby gem.
+func gemspecLicense(content []byte) string {
+	match := reGemspecLicense.FindSubmatch(content)
+	if match == nil {
+		return ""
+	}
+	var licenses []string
+	for _, m := range reQuotedString.FindAllSubmatch(match[1], -1) {
+		licenses = append(licenses, string(m[1])+string(m[2]))
+	}
+	return joinLicenses(licenses)
+}
+
+// pomXMLLicense reads the licenses of a Maven project. Maven uses license
+// names rather than identifiers, so known names are mapped to SPDX identifiers.
+func pomXMLLicense(content []byte) string {
+	var manifest struct {
+		Licenses []struct {
+			Name string `xml:"name"`
+		} `xml:"licenses>license"`
+	}
+	if err := xml.Unmarshal(content, &manifest); err != nil {
+		return ""
+	}
+	var licenses []string
+	for _, l := range manifest.Licenses {
+		if name := strings.TrimSpace(l.Name); name != "" {
+			licenses = append(licenses, spdxIDFromName(name))
+		}
+	}
+	return joinLicenses(licenses)
+}
+
+// joinLicenses returns an expression for a list of licenses the user
+// can choose from, which is how npm, RubyGems and Maven interpret them.
+func joinLicenses(licenses []string) string {
+	if len(licenses) > 1 {
+		for i := range licenses {
+			if strings.Contains(licenses[i], " ") {
+				licenses[i] = "(" + licenses[i] + ")"
+			}
+		}
+	}
+	return strings.Join(licenses, " OR ")
+}
+
+// commonLicenseNames are license names frequently found in pom.xml files
+// which differ from the SPDX full names.
+var commonLicenseNames = map[string]string{
+	"the apache software license, version 2.0": "Apache-2.0",
+	"apache license, version 2.0":              "Apache-2.0",
+	"apache 2.0":                               "Apache-2.0",
+	"the mit license":                          "MIT",
+	"bsd license":                              "BSD-3-Clause",
+	"eclipse public license - v 2.0":           "EPL-2.0",
+	"eclipse public license - v 1.0":           "EPL-1.0",
+}
+
+// spdxIDFromName returns the identifier of the license with the given full name,
+// or the name itself if it is not known.
+func spdxIDFromName(name string) string {
+	if id, ok := commonLicenseNames[strings.ToLower(name)]; ok {
+		return id
+	}
+	for id, license := range fsfOsiApprovedLicenseMap {
+		if strings.EqualFold(license.Name, name) {
+			return id
+		}
+	}
+	return name
+}
@@ -0,0 +1,181 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+func Test_getLicenseDeclarations(t *testing.T) {
+	t.Parallel()
+	files := []string{
+		"package.json", "Cargo.toml", "pom.xml", "main.go", "lib.c",
+		"nested/package.json", "vendor/dep/dep.go",
+	}
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(
+		func(predicate func(string) (bool, error)) ([]string, error) {
+			var matched []string
+			for _, f := range files {
+				if ok, err := predicate(f); err == nil && ok {
+					matched = append(matched, f)
+				}
+			}
+			return matched, nil
+		}).Times(1)
+	mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(fn string) (io.ReadCloser, error) {
+		return os.Open("./testdata/license-declarations/" + fn)
+	}).AnyTimes()
+
+	manifests, headers, err := getLicenseDeclarations(mockRepoClient)
+	if err != nil {
+		t.Fatalf("getLicenseDeclarations: %v", err)
+	}
+	wantManifests := map[string]checker.LicenseDeclaration{
+		"package.json": {
+			File:       checker.File{Path: "package.json", Type: finding.FileTypeSource, Offset: checker.OffsetDefault},
+			Expression: "(MIT OR Apache-2.0)",
+			SpdxIDs:    []string{"MIT", "Apache-2.0"},
+			Valid:      true,
+			Approved:   true,
+		},
+		"Cargo.toml": {
+			File:       checker.File{Path: "Cargo.toml", Type: finding.FileTypeSource, Offset: checker.OffsetDefault},
+			Expression: "MIT OR Apache-2.0",
+			SpdxIDs:    []string{"MIT", "Apache-2.0"},
+			Valid:      true,
+			Approved:   true,
+		},
+		"pom.xml": {
+			File:       checker.File{Path: "pom.xml", Type: finding.FileTypeSource, Offset: checker.OffsetDefault},
+			Expression: "Apache-2.0",
+			SpdxIDs:    []string{"Apache-2.0"},
+			Valid:      true,
+			Approved:   true,
+		},
+	}
+	gotManifests := make(map[string]checker.LicenseDeclaration)
+	for _, m := range manifests {
+		gotManifests[m.File.Path] = m
+	}
+	if diff := cmp.Diff(wantManifests, gotManifests); diff != "" {
+		t.Errorf("manifests mismatch (-want +got):\n%s", diff)
+	}
+
+	wantHeaders := map[string]checker.LicenseDeclaration{
+		"main.go": {
+			File:       checker.File{Path: "main.go", Type: finding.FileTypeSource, Offset: 1},
+			Expression: "Apache-2.0",
+			SpdxIDs:    []string{"Apache-2.0"},
+			Valid:      true,
+			Approved:   true,
+		},
+		"lib.c": {
+			File:       checker.File{Path: "lib.c", Type: finding.FileTypeSource, Offset: 1},
+			Expression: "MIT/X11",
+		},
+	}
+	gotHeaders := make(map[string]checker.LicenseDeclaration)
+	for _, h := range headers {
+		gotHeaders[h.File.Path] = h
+	}
+	if diff := cmp.Diff(wantHeaders, gotHeaders); diff != "" {
+		t.Errorf("headers mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_manifestLicenses(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		parse   func([]byte) string
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "package.json legacy object",
+			parse:   packageJSONLicense,
+			content: `{"license": {"type": "ISC", "url": "https://opensource.org/licenses/ISC"}}`,
+			want:    "ISC",
+		},
+		{
+			name:    "package.json legacy array",
+			parse:   packageJSONLicense,
+			content: `{"licenses": [{"type": "MIT"}, {"type": "Apache-2.0"}]}`,
+			want:    "MIT OR Apache-2.0",
+		},
+		{
+			name:    "Cargo.toml workspace",
+			parse:   cargoTOMLLicense,
+			content: "[package]\nname = \"a\"\nlicense.workspace = true\n\n[workspace.package]\nlicense = \"MPL-2.0\"\n",
+			want:    "MPL-2.0",
+		},
+		{
+			name:    "pyproject.toml expression",
+			parse:   pyprojectTOMLLicense,
+			content: "[project]\nname = \"a\"\nlicense = \"BSD-3-Clause\"\n",
+			want:    "BSD-3-Clause",
+		},
+		{
+			name:    "pyproject.toml text table",
+			parse:   pyprojectTOMLLicense,
+			content: "[project]\nname = \"a\"\nlicense = {text = \"MIT\"}\n",
+			want:    "MIT",
+		},
+		{
+			name:    "pyproject.toml file table",
+			parse:   pyprojectTOMLLicense,
+			content: "[project]\nname = \"a\"\nlicense = {file = \"LICENSE\"}\n",
+			want:    "",
+		},
+		{
+			name:    "gemspec license",
+			parse:   gemspecLicense,
+			content: "Gem::Specification.new do |spec|\n  spec.name = 'a'\n  spec.license = 'MIT'\nend\n",
+			want:    "MIT",
+		},
+		{
+			name:    "gemspec licenses",
+			parse:   gemspecLicense,
+			content: "Gem::Specification.new do |s|\n  s.licenses = [\"Ruby\", \"BSD-2-Clause\"]\nend\n",
+			want:    "Ruby OR BSD-2-Clause",
+		},
+		{
+			name:    "invalid content",
+			parse:   packageJSONLicense,
+			content: `{"license": `,
+			want:    "",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.parse([]byte(tt.content)); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -15,21 +15,43 @@
 package raw
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
 )
 
+var errInvalidLookBackDays = errors.New("invalid look back days")
+
 // Maintained checks for maintenance.
 func Maintained(c *checker.CheckRequest) (checker.MaintainedData, error) {
 	var result checker.MaintainedData
 
+	lookBackDays, err := maintainedLookBackDays(c.LookBackDays)
+	if err != nil {
+		return result, err
+	}
+	result.LookBackDays = lookBackDays
+
 	// Archived status.
 	archived, err := c.RepoClient.IsArchived()
 	if err != nil {
 		return result, fmt.Errorf("%w", err)
 	}
 	result.ArchivedStatus.Status = archived
+	if archived {
+		archivedAt, err := c.RepoClient.GetArchivedAt()
+		switch {
+		case errors.Is(err, clients.ErrUnsupportedFeature):
+		case err != nil:
+			return result, fmt.Errorf("GetArchivedAt: %w", err)
+		default:
+			result.ArchivedStatus.ArchivedAt = archivedAt
+		}
+	}
 
 	// Recent commits.
 	commits, err := c.RepoClient.ListCommits()
@@ -37,6 +59,17 @@ func Maintained(c *checker.CheckRequest) (checker.MaintainedData, error) {
 		return result, fmt.Errorf("%w", err)
 	}
 	result.DefaultBranchCommits = commits
+	result.MergedRequests = mergedRequests(commits)
+
+	// Recent releases.
+	releases, err := c.RepoClient.ListReleases()
+	switch {
+	case errors.Is(err, clients.ErrUnsupportedFeature):
+	case err != nil:
+		return result, fmt.Errorf("%w", err)
+	default:
+		result.Releases = releases
+	}
 
 	// Recent issues.
 	issues, err := c.RepoClient.ListIssues()
@@ -44,6 +77,7 @@ func Maintained(c *checker.CheckRequest) (checker.MaintainedData, error) {
 		return result, fmt.Errorf("%w", err)
 	}
 	result.Issues = issues
+	result.IssueResponseTime = issueResponseTime(issues, result.LookBackThreshold(), time.Now())
 
 	createdAt, err := c.RepoClient.GetCreatedAt()
 	if err != nil {
@@ -51,5 +85,83 @@ func Maintained(c *checker.CheckRequest) (checker.MaintainedData, error) {
 	}
 	result.CreatedAt = createdAt
 
+	// Deprecation notices.
+	deprecations, err := getDeprecations(c)
+	if err != nil {
+		return result, err
+	}
+	result.Deprecations = deprecations
+
 	return result, nil
 }
+
+// maintainedLookBackDays returns the window requested for the run, or the default one.
+func maintainedLookBackDays(days int) (int, error) {
+	switch {
+	case days == 0:
+		return checker.DefaultLookBackDays, nil
+	case days < checker.MinLookBackDays:
+		return 0, fmt.Errorf("%w: %d, want at least %d", errInvalidLookBackDays, days, checker.MinLookBackDays)
+	default:
+		return days, nil
+	}
+}
+
+// mergedRequests returns the merged pull or merge requests associated with the commits.
+func mergedRequests(commits []clients.Commit) []clients.PullRequest {
+	var requests []clients.PullRequest
+	seen := make(map[int]bool)
+	for i := range commits {
+		pr := commits[i].AssociatedMergeRequest
+		if pr.Number == 0 || pr.MergedAt.IsZero() || seen[pr.Number] {
+			continue
+		}
+		seen[pr.Number] = true
+		requests = append(requests, pr)
+	}
+	return requests
+}
+
+// issueResponseTime measures how long maintainers take to first comment on the issues
+// opened by other users since the threshold.
+func issueResponseTime(issues []clients.Issue, threshold, now time.Time) checker.IssueResponseTime {
+	var result checker.IssueResponseTime
+	var delays []time.Duration
+	for i := range issues {
+		issue := &issues[i]
+		if issue.CreatedAt == nil || issue.CreatedAt.Before(threshold) || isMaintainer(issue.AuthorAssociation) {
+			continue
+		}
+		result.Issues++
+		delay := now.Sub(*issue.CreatedAt)
+		responded := false
+		for j := range issue.Comments {
+			comment := &issue.Comments[j]
+			if comment.CreatedAt == nil || !isMaintainer(comment.AuthorAssociation) {
+				continue
+			}
+			if d := comment.CreatedAt.Sub(*issue.CreatedAt); d < delay {
+				delay = d
+				responded = true
+			}
+		}
+		if responded {
+			result.Responded++
+		}
+		delays = append(delays, delay)
+	}
+	if len(delays) == 0 {
+		return result
+	}
+	sort.Slice(delays, func(i, j int) bool { return delays[i] < delays[j] })
+	if n := len(delays); n%2 == 1 {
+		result.Median = delays[n/2]
+	} else {
+		result.Median = (delays[n/2-1] + delays[n/2]) / 2
+	}
+	return result
+}
+
+func isMaintainer(association *clients.RepoAssociation) bool {
+	return association != nil && association.Gte(clients.RepoAssociationCollaborator)
+}
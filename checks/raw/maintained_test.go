@@ -53,8 +53,11 @@ func TestMaintained(t *testing.T) {
 
 		mockRepoClient.EXPECT().IsArchived().Return(archived, nil)
 		mockRepoClient.EXPECT().ListCommits().Return(commits, nil)
+		mockRepoClient.EXPECT().ListReleases().Return(nil, nil)
 		mockRepoClient.EXPECT().ListIssues().Return(issues, nil)
 		mockRepoClient.EXPECT().GetCreatedAt().Return(createdAt, nil)
+		mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(nil, nil)
+		mockRepoClient.EXPECT().ListTopics().Return([]string{"go"}, nil)
 
 		data, err := Maintained(req)
 		if err != nil {
@@ -76,6 +79,20 @@ func TestMaintained(t *testing.T) {
 		if len(data.Issues) != len(issues) {
 			t.Errorf("unexpected number of issues: got %v, want %v", len(data.Issues), len(issues))
 		}
+
+		if data.LookBackDays != checker.DefaultLookBackDays {
+			t.Errorf("unexpected look back days: got %v, want %v", data.LookBackDays, checker.DefaultLookBackDays)
+		}
+	})
+
+	t.Run("returns error if the look back days are invalid", func(t *testing.T) {
+		shortReq := *req
+		shortReq.LookBackDays = checker.MinLookBackDays - 1
+
+		_, err := Maintained(&shortReq)
+		if err == nil {
+			t.Fatal("expected an error but got none")
+		}
 	})
 
 	t.Run("returns error if IsArchived fails", func(t *testing.T) {
@@ -100,6 +117,7 @@ func TestMaintained(t *testing.T) {
 	t.Run("returns error if ListIssues fails", func(t *testing.T) {
 		mockRepoClient.EXPECT().IsArchived().Return(false, nil)
 		mockRepoClient.EXPECT().ListCommits().Return([]clients.Commit{}, nil)
+		mockRepoClient.EXPECT().ListReleases().Return(nil, clients.ErrUnsupportedFeature)
 		mockRepoClient.EXPECT().ListIssues().Return(nil, fmt.Errorf("some error"))
 
 		_, err := Maintained(req)
@@ -111,6 +129,7 @@ func TestMaintained(t *testing.T) {
 	t.Run("returns error if GetCreatedAt fails", func(t *testing.T) {
 		mockRepoClient.EXPECT().IsArchived().Return(false, nil)
 		mockRepoClient.EXPECT().ListCommits().Return([]clients.Commit{}, nil)
+		mockRepoClient.EXPECT().ListReleases().Return(nil, clients.ErrUnsupportedFeature)
 		mockRepoClient.EXPECT().ListIssues().Return([]clients.Issue{}, nil)
 		mockRepoClient.EXPECT().GetCreatedAt().Return(time.Time{}, fmt.Errorf("some error"))
 
@@ -120,3 +139,57 @@ func TestMaintained(t *testing.T) {
 		}
 	})
 }
+
+func Test_issueResponseTime(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	threshold := now.AddDate(0, 0, -90)
+	at := func(days int) *time.Time {
+		date := now.AddDate(0, 0, -days)
+		return &date
+	}
+	member := clients.RepoAssociationMember
+	none := clients.RepoAssociationNone
+	issues := []clients.Issue{
+		{
+			// Answered by a maintainer after 2 days.
+			CreatedAt:         at(30),
+			AuthorAssociation: &none,
+			Comments: []clients.IssueComment{
+				{CreatedAt: at(29), AuthorAssociation: &none},
+				{CreatedAt: at(28), AuthorAssociation: &member},
+			},
+		},
+		{
+			// Answered by a maintainer after 1 day.
+			CreatedAt:         at(20),
+			AuthorAssociation: &none,
+			Comments: []clients.IssueComment{
+				{CreatedAt: at(19), AuthorAssociation: &member},
+			},
+		},
+		{
+			// Not answered for 10 days.
+			CreatedAt:         at(10),
+			AuthorAssociation: &none,
+		},
+		{
+			// Opened by a maintainer.
+			CreatedAt:         at(5),
+			AuthorAssociation: &member,
+		},
+		{
+			// Opened before the threshold.
+			CreatedAt:         at(100),
+			AuthorAssociation: &none,
+		},
+	}
+	want := checker.IssueResponseTime{
+		Median:    48 * time.Hour,
+		Issues:    3,
+		Responded: 2,
+	}
+	if got := issueResponseTime(issues, threshold, now); got != want {
+		t.Errorf("issueResponseTime() = %+v, want %+v", got, want)
+	}
+}
@@ -15,6 +15,7 @@
 package raw
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/checks/fileparser"
 	"github.com/ossf/scorecard/v4/checks/raw/github"
+	"github.com/ossf/scorecard/v4/clients"
 	sce "github.com/ossf/scorecard/v4/errors"
 	"github.com/ossf/scorecard/v4/finding"
 )
@@ -58,8 +60,39 @@ func TokenPermissions(c *checker.CheckRequest) (checker.TokenPermissionsData, er
 		Pattern:       ".github/workflows/*",
 		CaseSensitive: false,
 	}, validateGitHubActionTokenPermissions, &data)
+	if err != nil {
+		return data.results, err
+	}
+
+	// GitLab CI/CD jobs get a token whose scope is a project setting.
+	scope, err := c.RepoClient.GetCIJobTokenScope()
+	switch {
+	case errors.Is(err, clients.ErrUnsupportedFeature):
+	case err != nil:
+		return data.results, fmt.Errorf("GetCIJobTokenScope: %w", err)
+	default:
+		data.results.JobTokenScope = scope
+	}
+
+	// GitHub workflows not declaring permissions get the default of the repository.
+	repoPerms, err := c.RepoClient.GetWorkflowPermissions()
+	switch {
+	case errors.Is(err, clients.ErrUnsupportedFeature):
+	case err != nil:
+		return data.results, fmt.Errorf("GetWorkflowPermissions: %w", err)
+	default:
+		data.results.RepoWorkflowPermissions = repoPerms
+	}
+	orgPerms, err := c.RepoClient.GetOrgWorkflowPermissions()
+	switch {
+	case errors.Is(err, clients.ErrUnsupportedFeature):
+	case err != nil:
+		return data.results, fmt.Errorf("GetOrgWorkflowPermissions: %w", err)
+	default:
+		data.results.OrgWorkflowPermissions = orgPerms
+	}
 
-	return data.results, err
+	return data.results, nil
 }
 
 // Check file content.
@@ -309,20 +342,37 @@ func validatejobLevelPermissions(workflow *actionlint.Workflow, path string,
 					LocationType: &permLoc,
 					Type:         checker.PermissionLevelUndeclared,
 					Msg:          github.StringPointer(fmt.Sprintf("no %s permission defined", permLoc)),
-					// TODO: Job
+					Job:          workflowJob(job),
 				})
 
 			continue
 		}
+		start := len(pdata.results.TokenPermissions)
 		err := validatePermissions(job.Permissions, checker.PermissionLocationJob,
 			path, pdata, ignoredPermissions)
 		if err != nil {
 			return err
 		}
+		// Record the job of its permissions, e.g. to tell which jobs can request
+		// an OIDC identity token with `id-token: write`.
+		for i := start; i < len(pdata.results.TokenPermissions); i++ {
+			pdata.results.TokenPermissions[i].Job = workflowJob(job)
+		}
 	}
 	return nil
 }
 
+func workflowJob(job *actionlint.Job) *checker.WorkflowJob {
+	ret := &checker.WorkflowJob{}
+	if job.ID != nil {
+		ret.ID = &job.ID.Value
+	}
+	if job.Name != nil {
+		ret.Name = &job.Name.Value
+	}
+	return ret
+}
+
 func isPermissionOfInterest(name permission, ignoredPermissions map[permission]bool) bool {
 	for _, p := range permissionsOfInterest {
 		_, present := ignoredPermissions[p]
@@ -0,0 +1,53 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v4/checker"
+)
+
+func TestTokenPermissionsJob(t *testing.T) {
+	t.Parallel()
+	content := []byte(`on: push
+permissions:
+  contents: read
+jobs:
+  publish:
+    runs-on: ubuntu-latest
+    permissions:
+      id-token: write
+    steps:
+      - run: echo publish
+`)
+	var data permissionCbData
+	if _, err := validateGitHubActionTokenPermissions(".github/workflows/release.yml", content, &data); err != nil {
+		t.Fatalf("validateGitHubActionTokenPermissions: %v", err)
+	}
+	for _, p := range data.results.TokenPermissions {
+		if p.Name == nil || *p.Name != "id-token" {
+			continue
+		}
+		if *p.LocationType != checker.PermissionLocationJob {
+			t.Errorf("LocationType = %s, want %s", *p.LocationType, checker.PermissionLocationJob)
+		}
+		if p.Job == nil || p.Job.ID == nil || *p.Job.ID != "publish" {
+			t.Errorf("Job = %v, want publish", p.Job)
+		}
+		return
+	}
+	t.Errorf("id-token permission not recorded")
+}
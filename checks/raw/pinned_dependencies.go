@@ -41,6 +41,11 @@ func PinningDependencies(c *checker.CheckRequest) (checker.PinningDependenciesDa
 		return checker.PinningDependenciesData{}, err
 	}
 
+	// Git submodules.
+	if err := collectGitSubmodulePinning(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
 	// // Docker files.
 	if err := collectDockerfilePinning(c, &results); err != nil {
 		return checker.PinningDependenciesData{}, err
@@ -56,6 +61,11 @@ func PinningDependencies(c *checker.CheckRequest) (checker.PinningDependenciesDa
 		return checker.PinningDependenciesData{}, err
 	}
 
+	// Makefile, justfile and Taskfile downloads.
+	if err := collectBuildFileInsecureDownloads(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
 	// Action script downloads.
 	if err := collectGitHubWorkflowScriptInsecureDownloads(c, &results); err != nil {
 		return checker.PinningDependenciesData{}, err
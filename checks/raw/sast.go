@@ -24,7 +24,9 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/BurntSushi/toml"
 	"github.com/rhysd/actionlint"
+	"gopkg.in/yaml.v3"
 
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/checks/fileparser"
@@ -40,11 +42,40 @@ var sastTools = map[string]bool{
 	"github-advanced-security": true,
 	"github-code-scanning":     true,
 	"lgtm-com":                 true,
+	"semgrep-app":              true,
 	"sonarcloud":               true,
 }
 
 var allowedConclusions = map[string]bool{"success": true, "neutral": true}
 
+// sastStatusContext matches the commit statuses reported by SAST tools,
+// including the jobs of the GitLab SAST template, such as `semgrep-sast`.
+var sastStatusContext = regexp.MustCompile(
+	`(?i)(^|[^a-z])(semgrep|gosec|bandit|golangci-lint|codeql|sonarcloud)([^a-z]|$)|(^|-)sast$`)
+
+// sastCommand is the invocation of a SAST tool in a script.
+type sastCommand struct {
+	pattern *regexp.Regexp
+	tool    checker.SASTWorkflowType
+}
+
+var (
+	sastCommands = []sastCommand{
+		{regexp.MustCompile(`(^|[\s;&|(/])semgrep\s+(ci|scan|--config)\b`), checker.SemgrepWorkflow},
+		{regexp.MustCompile(`(^|[\s;&|(/])gosec(\s|$)`), checker.GosecWorkflow},
+		{regexp.MustCompile(`(^|[\s;&|(/])bandit(\s|$)`), checker.BanditWorkflow},
+		{regexp.MustCompile(`(^|[\s;&|(/])golangci-lint\s+run\b`), checker.GolangciLintWorkflow},
+	}
+	// installCommand matches the package manager commands installing a tool rather than running it.
+	installCommand = regexp.MustCompile(
+		`(^|[\s;&|(])(go|pip3?|pipx|uv\s+pip|npm|pnpm|yarn(\s+global)?|brew|apt(-get)?|apk|gem|cargo|poetry)` +
+			`\s+(install|add|get)\b`)
+	// gitlabSASTTemplate matches the SAST templates shipped with GitLab.
+	gitlabSASTTemplate = regexp.MustCompile(`^(Jobs|Security)/SAST(-IaC)?(\.latest)?\.gitlab-ci\.yml$`)
+	// gitlabSASTComponent matches the SAST component of the CI/CD catalog.
+	gitlabSASTComponent = regexp.MustCompile(`/components/sast/sast@`)
+)
+
 // SAST checks for presence of static analysis tools.
 func SAST(c *checker.CheckRequest) (checker.SASTData, error) {
 	var data checker.SASTData
@@ -85,6 +116,49 @@ func SAST(c *checker.CheckRequest) (checker.SASTData, error) {
 	}
 	data.Workflows = append(data.Workflows, qodanaWorkflows...)
 
+	semgrepWorkflows, err := getSastUsesWorkflows(c, "^(returntocorp|semgrep)/semgrep-action$", checker.SemgrepWorkflow)
+	if err != nil {
+		return data, err
+	}
+	data.Workflows = append(data.Workflows, semgrepWorkflows...)
+
+	gosecWorkflows, err := getSastUsesWorkflows(c, "^securego/gosec$", checker.GosecWorkflow)
+	if err != nil {
+		return data, err
+	}
+	data.Workflows = append(data.Workflows, gosecWorkflows...)
+
+	banditWorkflows, err := getSastUsesWorkflows(c, "^PyCQA/bandit-action$", checker.BanditWorkflow)
+	if err != nil {
+		return data, err
+	}
+	data.Workflows = append(data.Workflows, banditWorkflows...)
+
+	golangciLintWorkflows, err := getSastUsesWorkflows(c, "^golangci/golangci-lint-action$",
+		checker.GolangciLintWorkflow)
+	if err != nil {
+		return data, err
+	}
+	data.Workflows = append(data.Workflows, golangciLintWorkflows...)
+
+	commandWorkflows, err := getSastCommandWorkflows(c)
+	if err != nil {
+		return data, err
+	}
+	data.Workflows = append(data.Workflows, commandWorkflows...)
+
+	gitlabWorkflows, err := getGitLabSASTWorkflows(c)
+	if err != nil {
+		return data, err
+	}
+	data.Workflows = append(data.Workflows, gitlabWorkflows...)
+
+	// golangci-lint is only a SAST tool if its security linter is enabled.
+	data.Workflows, err = filterGolangciLintWorkflows(c, data.Workflows)
+	if err != nil {
+		return data, err
+	}
+
 	return data, nil
 }
 
@@ -129,6 +203,12 @@ func sastToolInCheckRuns(c *checker.CheckRequest) ([]checker.SASTCommit, error)
 				break
 			}
 		}
+		if !checked {
+			checked, err = sastToolInStatuses(c, pr.HeadSHA)
+			if err != nil {
+				return sastCommits, err
+			}
+		}
 		sastCommit := checker.SASTCommit{
 			CommittedDate:          commits[i].CommittedDate,
 			Message:                commits[i].Message,
@@ -142,6 +222,27 @@ func sastToolInCheckRuns(c *checker.CheckRequest) ([]checker.SASTCommit, error)
 	return sastCommits, nil
 }
 
+// sastToolInStatuses returns true if a SAST tool reported a successful commit status for ref.
+func sastToolInStatuses(c *checker.CheckRequest, ref string) (bool, error) {
+	statuses, err := c.RepoClient.ListStatuses(ref)
+	if err != nil {
+		return false,
+			sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("Client.Repositories.ListStatuses: %v", err))
+	}
+	for _, status := range statuses {
+		if status.State != "success" || !sastStatusContext.MatchString(status.Context) {
+			continue
+		}
+		c.Dlogger.Debug(&checker.LogMessage{
+			Path: status.URL,
+			Type: finding.FileTypeURL,
+			Text: fmt.Sprintf("tool detected: %v", status.Context),
+		})
+		return true, nil
+	}
+	return false, nil
+}
+
 // getSastUsesWorkflows matches if the "uses" field of a GitHub action matches
 // a given regex by way of usesRegex. Each workflow that matches the usesRegex
 // is appended to the slice that is returned.
@@ -223,6 +324,241 @@ var searchGitHubActionWorkflowUseRegex fileparser.DoWhileTrueOnFileContent = fun
 	return true, nil
 }
 
+// sastToolInCommand returns the SAST tool run by a script line, if any.
+func sastToolInCommand(line string) (checker.SASTWorkflowType, bool) {
+	if installCommand.MatchString(line) {
+		return "", false
+	}
+	for _, cmd := range sastCommands {
+		if cmd.pattern.MatchString(line) {
+			return cmd.tool, true
+		}
+	}
+	return "", false
+}
+
+// getSastCommandWorkflows returns the GitHub workflows running a SAST tool in a `run:` step.
+func getSastCommandWorkflows(c *checker.CheckRequest) ([]checker.SASTWorkflow, error) {
+	var sastWorkflows []checker.SASTWorkflow
+	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       ".github/workflows/*",
+		CaseSensitive: false,
+	}, searchGitHubActionWorkflowCommands, &sastWorkflows)
+	return sastWorkflows, err
+}
+
+var searchGitHubActionWorkflowCommands fileparser.DoWhileTrueOnFileContent = func(path string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if !fileparser.IsWorkflowFile(path) {
+		return true, nil
+	}
+
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"searchGitHubActionWorkflowCommands requires exactly 1 argument: %w", errInvalid)
+	}
+
+	// Verify the type of the data.
+	pdata, ok := args[0].(*[]checker.SASTWorkflow)
+	if !ok {
+		return false, fmt.Errorf(
+			"searchGitHubActionWorkflowCommands expects arg[0] of type *[]checker.SASTWorkflow: %w", errInvalid)
+	}
+
+	workflow, errs := actionlint.Parse(content)
+	if len(errs) > 0 && workflow == nil {
+		return false, fileparser.FormatActionlintError(errs)
+	}
+
+	for _, job := range workflow.Jobs {
+		for _, step := range job.Steps {
+			e, ok := step.Exec.(*actionlint.ExecRun)
+			if !ok || e == nil || e.Run == nil {
+				continue
+			}
+			for i, line := range strings.Split(e.Run.Value, "\n") {
+				tool, ok := sastToolInCommand(line)
+				if !ok {
+					continue
+				}
+				*pdata = append(*pdata, checker.SASTWorkflow{
+					File: checker.File{
+						Path:    path,
+						Offset:  runLineOffset(e.Run, i),
+						Type:    finding.FileTypeSource,
+						Snippet: strings.TrimSpace(line),
+					},
+					Type: tool,
+				})
+			}
+		}
+	}
+	return true, nil
+}
+
+// runLineOffset returns the line of the i-th line of a `run:` script.
+// Block scalars start on the line after the `run:` key.
+func runLineOffset(run *actionlint.String, i int) uint {
+	if run.Pos == nil {
+		return checker.OffsetDefault
+	}
+	line := uint(run.Pos.Line + i)
+	if strings.Contains(run.Value, "\n") {
+		line++
+	}
+	return line
+}
+
+// getGitLabSASTWorkflows returns the GitLab SAST templates and components included
+// by `.gitlab-ci.yml`, and the jobs running a SAST tool.
+func getGitLabSASTWorkflows(c *checker.CheckRequest) ([]checker.SASTWorkflow, error) {
+	ci, err := fileparser.ParseGitLabCIFromRepo(c.RepoClient)
+	// SAST tools are not run by configurations which do not parse.
+	if err != nil || ci == nil {
+		return nil, nil //nolint:nilerr // the other workflows are still valid
+	}
+
+	var sastWorkflows []checker.SASTWorkflow
+	for _, inc := range ci.Includes {
+		if (inc.Type == fileparser.GitLabCIIncludeTemplate && gitlabSASTTemplate.MatchString(inc.Value)) ||
+			(inc.Type == fileparser.GitLabCIIncludeComponent && gitlabSASTComponent.MatchString(inc.Value)) {
+			sastWorkflows = append(sastWorkflows, checker.SASTWorkflow{
+				File: checker.File{
+					Path:    inc.File,
+					Offset:  inc.Line,
+					Type:    finding.FileTypeSource,
+					Snippet: inc.Value,
+				},
+				Type: checker.GitLabSASTWorkflow,
+			})
+		}
+	}
+	for _, job := range ci.Jobs {
+		for _, cmd := range job.Scripts() {
+			tool, ok := sastToolInCommand(cmd.Value)
+			if !ok {
+				continue
+			}
+			sastWorkflows = append(sastWorkflows, checker.SASTWorkflow{
+				File: checker.File{
+					Path:    cmd.File,
+					Offset:  cmd.Line,
+					Type:    finding.FileTypeSource,
+					Snippet: cmd.Value,
+				},
+				Type: tool,
+			})
+		}
+	}
+	return sastWorkflows, nil
+}
+
+// golangciLintConfig is the part of the golangci-lint configuration selecting linters.
+type golangciLintConfig struct {
+	Linters struct {
+		// Default is the v2 replacement of EnableAll.
+		Default   string   `yaml:"default" toml:"default"`
+		Enable    []string `yaml:"enable" toml:"enable"`
+		Disable   []string `yaml:"disable" toml:"disable"`
+		Presets   []string `yaml:"presets" toml:"presets"`
+		EnableAll bool     `yaml:"enable-all" toml:"enable-all"`
+	} `yaml:"linters" toml:"linters"`
+}
+
+// enablesGosec returns true if the linters selected by the configuration include gosec.
+func (cfg *golangciLintConfig) enablesGosec() bool {
+	for _, l := range cfg.Linters.Disable {
+		if l == "gosec" {
+			return false
+		}
+	}
+	if cfg.Linters.EnableAll || cfg.Linters.Default == "all" {
+		return true
+	}
+	for _, l := range cfg.Linters.Enable {
+		if l == "gosec" {
+			return true
+		}
+	}
+	for _, p := range cfg.Linters.Presets {
+		if p == "bugs" {
+			return true
+		}
+	}
+	return false
+}
+
+// filterGolangciLintWorkflows drops the golangci-lint workflows if the
+// configuration of the repository does not enable gosec.
+func filterGolangciLintWorkflows(c *checker.CheckRequest,
+	workflows []checker.SASTWorkflow,
+) ([]checker.SASTWorkflow, error) {
+	hasGolangciLint := false
+	for i := range workflows {
+		hasGolangciLint = hasGolangciLint || workflows[i].Type == checker.GolangciLintWorkflow
+	}
+	if !hasGolangciLint {
+		return workflows, nil
+	}
+
+	enabled := false
+	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       ".golangci.*",
+		CaseSensitive: true,
+	}, checkGolangciLintConfig, &enabled)
+	if err != nil {
+		return workflows, err
+	}
+	if enabled {
+		return workflows, nil
+	}
+
+	filtered := workflows[:0]
+	for i := range workflows {
+		if workflows[i].Type != checker.GolangciLintWorkflow {
+			filtered = append(filtered, workflows[i])
+		}
+	}
+	return filtered, nil
+}
+
+var checkGolangciLintConfig fileparser.DoWhileTrueOnFileContent = func(path string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"checkGolangciLintConfig requires exactly 1 argument: %w", errInvalid)
+	}
+
+	// Verify the type of the data.
+	enabled, ok := args[0].(*bool)
+	if !ok {
+		return false, fmt.Errorf(
+			"checkGolangciLintConfig expects arg[0] of type *bool: %w", errInvalid)
+	}
+
+	var cfg golangciLintConfig
+	var err error
+	switch path {
+	case ".golangci.yml", ".golangci.yaml", ".golangci.json":
+		// JSON is a subset of YAML.
+		err = yaml.Unmarshal(content, &cfg)
+	case ".golangci.toml":
+		_, err = toml.Decode(string(content), &cfg)
+	default:
+		return true, nil
+	}
+	if err != nil {
+		// An invalid configuration makes golangci-lint fail, so it runs no linter.
+		return true, nil
+	}
+	*enabled = cfg.enablesGosec()
+	return false, nil
+}
+
 type sonarConfig struct {
 	url  string
 	file checker.File
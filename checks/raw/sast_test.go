@@ -32,8 +32,10 @@ func TestSAST(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name     string
-		files    []string
+		name  string
+		files []string
+		// testdata maps the files of the repository to other files of testdata.
+		testdata map[string]string
 		commits  []clients.Commit
 		expected checker.SASTData
 	}{
@@ -195,6 +197,184 @@ func TestSAST(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "Has Semgrep",
+			files: []string{".github/workflows/github-semgrep-workflow.yaml"},
+			expected: checker.SASTData{
+				Workflows: []checker.SASTWorkflow{
+					{
+						Type: checker.SemgrepWorkflow,
+						File: checker.File{
+							Path:   ".github/workflows/github-semgrep-workflow.yaml",
+							Offset: checker.OffsetDefault,
+							Type:   finding.FileTypeSource,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Runs Bandit, gosec and golangci-lint with gosec enabled",
+			files: []string{
+				".github/workflows/github-sast-commands-workflow.yaml",
+				".golangci.yml",
+			},
+			expected: checker.SASTData{
+				Workflows: []checker.SASTWorkflow{
+					{
+						Type: checker.GolangciLintWorkflow,
+						File: checker.File{
+							Path:   ".github/workflows/github-sast-commands-workflow.yaml",
+							Offset: checker.OffsetDefault,
+							Type:   finding.FileTypeSource,
+						},
+					},
+					{
+						Type: checker.BanditWorkflow,
+						File: checker.File{
+							Path:    ".github/workflows/github-sast-commands-workflow.yaml",
+							Offset:  11,
+							Type:    finding.FileTypeSource,
+							Snippet: "bandit -r src",
+						},
+					},
+					{
+						Type: checker.GosecWorkflow,
+						File: checker.File{
+							Path:    ".github/workflows/github-sast-commands-workflow.yaml",
+							Offset:  14,
+							Type:    finding.FileTypeSource,
+							Snippet: "gosec ./...",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "golangci-lint with gosec enabled in TOML",
+			files: []string{
+				".github/workflows/github-sast-commands-workflow.yaml",
+				".golangci.toml",
+			},
+			testdata: map[string]string{".golangci.toml": "golangci-gosec.toml"},
+			expected: checker.SASTData{
+				Workflows: []checker.SASTWorkflow{
+					{
+						Type: checker.GolangciLintWorkflow,
+						File: checker.File{
+							Path:   ".github/workflows/github-sast-commands-workflow.yaml",
+							Offset: checker.OffsetDefault,
+							Type:   finding.FileTypeSource,
+						},
+					},
+					{
+						Type: checker.BanditWorkflow,
+						File: checker.File{
+							Path:    ".github/workflows/github-sast-commands-workflow.yaml",
+							Offset:  11,
+							Type:    finding.FileTypeSource,
+							Snippet: "bandit -r src",
+						},
+					},
+					{
+						Type: checker.GosecWorkflow,
+						File: checker.File{
+							Path:    ".github/workflows/github-sast-commands-workflow.yaml",
+							Offset:  14,
+							Type:    finding.FileTypeSource,
+							Snippet: "gosec ./...",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "golangci-lint without gosec enabled",
+			files: []string{".github/workflows/github-sast-commands-workflow.yaml"},
+			expected: checker.SASTData{
+				Workflows: []checker.SASTWorkflow{
+					{
+						Type: checker.BanditWorkflow,
+						File: checker.File{
+							Path:    ".github/workflows/github-sast-commands-workflow.yaml",
+							Offset:  11,
+							Type:    finding.FileTypeSource,
+							Snippet: "bandit -r src",
+						},
+					},
+					{
+						Type: checker.GosecWorkflow,
+						File: checker.File{
+							Path:    ".github/workflows/github-sast-commands-workflow.yaml",
+							Offset:  14,
+							Type:    finding.FileTypeSource,
+							Snippet: "gosec ./...",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "golangci-lint with gosec disabled in TOML",
+			files: []string{
+				".github/workflows/github-sast-commands-workflow.yaml",
+				".golangci.toml",
+			},
+			testdata: map[string]string{".golangci.toml": "golangci-no-gosec.toml"},
+			expected: checker.SASTData{
+				Workflows: []checker.SASTWorkflow{
+					{
+						Type: checker.BanditWorkflow,
+						File: checker.File{
+							Path:    ".github/workflows/github-sast-commands-workflow.yaml",
+							Offset:  11,
+							Type:    finding.FileTypeSource,
+							Snippet: "bandit -r src",
+						},
+					},
+					{
+						Type: checker.GosecWorkflow,
+						File: checker.File{
+							Path:    ".github/workflows/github-sast-commands-workflow.yaml",
+							Offset:  14,
+							Type:    finding.FileTypeSource,
+							Snippet: "gosec ./...",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:     "unparsable GitLab CI configuration",
+			files:    []string{".gitlab-ci.yml"},
+			testdata: map[string]string{".gitlab-ci.yml": "gitlab-ci-invalid.yml"},
+		},
+		{
+			name:  "GitLab SAST template and Semgrep job",
+			files: []string{".gitlab-ci.yml"},
+			expected: checker.SASTData{
+				Workflows: []checker.SASTWorkflow{
+					{
+						Type: checker.GitLabSASTWorkflow,
+						File: checker.File{
+							Path:    ".gitlab-ci.yml",
+							Offset:  2,
+							Type:    finding.FileTypeSource,
+							Snippet: "Jobs/SAST.gitlab-ci.yml",
+						},
+					},
+					{
+						Type: checker.SemgrepWorkflow,
+						File: checker.File{
+							Path:    ".gitlab-ci.yml",
+							Offset:  11,
+							Type:    finding.FileTypeSource,
+							Snippet: "semgrep ci",
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt // Re-initializing variable so it is not changed while executing the closure below
@@ -208,6 +388,9 @@ func TestSAST(t *testing.T) {
 				return tt.commits, nil
 			})
 			mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
+				if f, ok := tt.testdata[file]; ok {
+					file = f
+				}
 				return os.Open("./testdata/" + file)
 			}).AnyTimes()
 			req := checker.CheckRequest{
@@ -223,3 +406,29 @@ func TestSAST(t *testing.T) {
 		})
 	}
 }
+
+func TestSASTToolInCommand(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		line string
+		want checker.SASTWorkflowType
+	}{
+		{line: "pip install bandit"},
+		{line: "python -m pip install --upgrade bandit"},
+		{line: "go install github.com/securego/gosec/v2/cmd/gosec"},
+		{line: "yarn global add gosec"},
+		{line: "git add . && gosec ./...", want: checker.GosecWorkflow},
+		{line: "make install-deps; bandit -r src", want: checker.BanditWorkflow},
+		{line: "semgrep ci --config p/address", want: checker.SemgrepWorkflow},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.line, func(t *testing.T) {
+			t.Parallel()
+			got, _ := sastToolInCommand(tt.line)
+			if got != tt.want {
+				t.Errorf("sastToolInCommand(%q) = %q, want %q", tt.line, got, tt.want)
+			}
+		})
+	}
+}
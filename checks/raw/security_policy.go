@@ -18,6 +18,7 @@ import (
 	"bufio"
 	"errors"
 	"fmt"
+	"os"
 	"path"
 	"regexp"
 	"strings"
@@ -44,18 +45,27 @@ func SecurityPolicy(c *checker.CheckRequest) (checker.SecurityPolicyData, error)
 	if err != nil {
 		return checker.SecurityPolicyData{}, err
 	}
+	// security.txt files are only looked for in the repo, along with any other policy file.
+	txtFiles, err := getSecurityTxtFiles(c)
+	if err != nil {
+		return checker.SecurityPolicyData{}, err
+	}
+
 	// If we found files in the repo, return immediately.
 	if len(data.files) > 0 {
 		for idx := range data.files {
 			err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
 				Pattern:       data.files[idx].File.Path,
 				CaseSensitive: false,
-			}, checkSecurityPolicyFileContent, &data.files[idx].File, &data.files[idx].Information)
+			}, checkSecurityPolicyFileContent, &data.files[idx])
 			if err != nil {
 				return checker.SecurityPolicyData{}, err
 			}
 		}
-		return checker.SecurityPolicyData{PolicyFiles: data.files}, nil
+		return addDisclosureChannels(c, checker.SecurityPolicyData{
+			PolicyFiles:      data.files,
+			SecurityTxtFiles: txtFiles,
+		})
 	}
 
 	// Check if present in parent org.
@@ -89,13 +99,92 @@ func SecurityPolicy(c *checker.CheckRequest) (checker.SecurityPolicyData, error)
 			err := fileparser.OnMatchingFileContentDo(client, fileparser.PathMatcher{
 				Pattern:       filePattern,
 				CaseSensitive: false,
-			}, checkSecurityPolicyFileContent, &data.files[idx].File, &data.files[idx].Information)
+			}, checkSecurityPolicyFileContent, &data.files[idx])
 			if err != nil {
 				return checker.SecurityPolicyData{}, err
 			}
 		}
 	}
-	return checker.SecurityPolicyData{PolicyFiles: data.files}, nil
+	return addDisclosureChannels(c, checker.SecurityPolicyData{
+		PolicyFiles:      data.files,
+		SecurityTxtFiles: txtFiles,
+	})
+}
+
+// addDisclosureChannels records the forge settings showing that the project
+// handles vulnerability reports, regardless of its policy files. The probes
+// reading them only run in experimental mode, so nothing is fetched otherwise.
+func addDisclosureChannels(c *checker.CheckRequest,
+	data checker.SecurityPolicyData,
+) (checker.SecurityPolicyData, error) {
+	if _, experimental := os.LookupEnv("SCORECARD_EXPERIMENTAL"); !experimental {
+		return data, nil
+	}
+	enabled, err := c.RepoClient.IsPrivateVulnerabilityReportingEnabled()
+	switch {
+	case errors.Is(err, clients.ErrUnsupportedFeature):
+	case err != nil:
+		return checker.SecurityPolicyData{}, fmt.Errorf("IsPrivateVulnerabilityReportingEnabled: %w", err)
+	default:
+		data.PrivateVulnerabilityReportingEnabled = &enabled
+	}
+
+	advisories, err := c.RepoClient.ListSecurityAdvisories()
+	switch {
+	case errors.Is(err, clients.ErrUnsupportedFeature):
+	case err != nil:
+		return checker.SecurityPolicyData{}, fmt.Errorf("ListSecurityAdvisories: %w", err)
+	default:
+		data.SecurityAdvisories = append([]clients.SecurityAdvisory{}, advisories...)
+	}
+	return data, nil
+}
+
+// getSecurityTxtFiles returns the security.txt files of the repository.
+// See https://www.rfc-editor.org/rfc/rfc9116#section-3.
+func getSecurityTxtFiles(c *checker.CheckRequest) ([]checker.SecurityPolicyFile, error) {
+	var files []checker.SecurityPolicyFile
+	err := fileparser.OnAllFilesDo(c.RepoClient, isSecurityTxtFile, &files)
+	if err != nil {
+		return nil, err
+	}
+	for idx := range files {
+		err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+			Pattern:       files[idx].File.Path,
+			CaseSensitive: false,
+		}, checkSecurityPolicyFileContent, &files[idx])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+var isSecurityTxtFile fileparser.DoWhileTrueOnFilename = func(name string, args ...interface{}) (bool, error) {
+	if len(args) != 1 {
+		return false, fmt.Errorf("isSecurityTxtFile requires exactly one argument: %w", errInvalidArgLength)
+	}
+	pfiles, ok := args[0].(*[]checker.SecurityPolicyFile)
+	if !ok {
+		return false, fmt.Errorf("invalid arg type: %w", errInvalidArgType)
+	}
+	if isSecurityTxtFilename(name) {
+		*pfiles = append(*pfiles, checker.SecurityPolicyFile{
+			File: checker.File{
+				Path:     name,
+				Type:     finding.FileTypeText,
+				Offset:   checker.OffsetDefault,
+				FileSize: checker.OffsetDefault,
+			},
+			Information: make([]checker.SecurityPolicyInformation, 0),
+		})
+	}
+	return true, nil
+}
+
+func isSecurityTxtFilename(name string) bool {
+	return strings.EqualFold(name, ".well-known/security.txt") ||
+		strings.EqualFold(name, "security.txt")
 }
 
 // Check repository for repository-specific policy.
@@ -155,19 +244,14 @@ func isSecurityPolicyFilename(name string) bool {
 var checkSecurityPolicyFileContent fileparser.DoWhileTrueOnFileContent = func(path string, content []byte,
 	args ...interface{},
 ) (bool, error) {
-	if len(args) != 2 {
-		return false, fmt.Errorf(
-			"checkSecurityPolicyFileContent requires exactly two arguments: %w", errInvalidArgLength)
-	}
-	pfiles, ok := args[0].(*checker.File)
-	if !ok {
+	if len(args) != 1 {
 		return false, fmt.Errorf(
-			"checkSecurityPolicyFileContent requires argument of type *checker.File: %w", errInvalidArgType)
+			"checkSecurityPolicyFileContent requires exactly one argument: %w", errInvalidArgLength)
 	}
-	pinfo, ok := args[1].(*[]checker.SecurityPolicyInformation)
+	ppolicy, ok := args[0].(*checker.SecurityPolicyFile)
 	if !ok {
 		return false, fmt.Errorf(
-			"%s requires argument of type *[]checker.SecurityPolicyInformation: %w",
+			"%s requires argument of type *checker.SecurityPolicyFile: %w",
 			"checkSecurityPolicyFileContent", errInvalidArgType)
 	}
 
@@ -177,12 +261,18 @@ var checkSecurityPolicyFileContent fileparser.DoWhileTrueOnFileContent = func(pa
 		return true, nil
 	}
 
-	if pfiles != nil && (*pinfo) != nil {
-		pfiles.Offset = checker.OffsetDefault
-		pfiles.FileSize = uint(len(content))
+	if ppolicy != nil && ppolicy.Information != nil {
+		ppolicy.File.Offset = checker.OffsetDefault
+		ppolicy.File.FileSize = uint(len(content))
 		policyHits := collectPolicyHits(content)
 		if len(policyHits) > 0 {
-			(*pinfo) = append((*pinfo), policyHits...)
+			ppolicy.Information = append(ppolicy.Information, policyHits...)
+		}
+		if isSecurityTxtFilename(ppolicy.File.Path) {
+			ppolicy.SecurityTxt = parseSecurityTxt(content)
+		} else {
+			ppolicy.DisclosureTimeline = collectDisclosureTimeline(content)
+			ppolicy.SupportedVersions = parseSupportedVersions(content)
 		}
 	} else {
 		e := sce.WithMessage(sce.ErrScorecardInternal, "bad file or information reference")
@@ -252,3 +342,107 @@ func collectPolicyHits(policyContent []byte) []checker.SecurityPolicyInformation
 
 	return hits
 }
+
+var (
+	// reTimelineDelay matches a delay, such as "within 48 hours" or "ninety days".
+	reTimelineDelay = regexp.MustCompile(`(?i)\b([0-9]{1,3}|one|two|three|four|five|seven|ten|fourteen|thirty|sixty|ninety)` +
+		`[\s-]+(business[\s-]+|working[\s-]+|calendar[\s-]+)?(hours?|days?|weeks?|months?)\b`)
+	// reTimelineContext matches the words committing to act on a report.
+	reTimelineContext = regexp.MustCompile(`(?i)(respon|acknowledg|triag|fix|patch|disclos|embargo|within)`)
+)
+
+// collectDisclosureTimeline returns the delays the policy commits to, such as
+// acknowledging a report within 48 hours or disclosing it after 90 days.
+func collectDisclosureTimeline(content []byte) []checker.SecurityPolicyValueType {
+	var timeline []checker.SecurityPolicyValueType
+	for i, line := range strings.Split(string(content), "\n") {
+		indexes := reTimelineDelay.FindStringIndex(line)
+		if indexes == nil || !reTimelineContext.MatchString(line) {
+			continue
+		}
+		timeline = append(timeline, checker.SecurityPolicyValueType{
+			Match:      line[indexes[0]:indexes[1]],
+			LineNumber: uint(i + 1),
+			Offset:     uint(indexes[0]),
+		})
+	}
+	return timeline
+}
+
+// parseSupportedVersions parses the first markdown table of the policy
+// with a version column and a supported column.
+func parseSupportedVersions(content []byte) []checker.SupportedVersion {
+	lines := strings.Split(string(content), "\n")
+	for i := 0; i+1 < len(lines); i++ {
+		versionCol, supportedCol := -1, -1
+		for j, cell := range markdownTableCells(lines[i]) {
+			cell = strings.ToLower(cell)
+			switch {
+			case versionCol < 0 && strings.Contains(cell, "version"):
+				versionCol = j
+			case supportedCol < 0 && strings.Contains(cell, "support"):
+				supportedCol = j
+			}
+		}
+		if versionCol < 0 || supportedCol < 0 || !isMarkdownTableSeparator(lines[i+1]) {
+			continue
+		}
+
+		var versions []checker.SupportedVersion
+		for _, line := range lines[i+2:] {
+			cells := markdownTableCells(line)
+			if cells == nil {
+				break
+			}
+			if len(cells) <= versionCol || len(cells) <= supportedCol {
+				continue
+			}
+			versions = append(versions, checker.SupportedVersion{
+				Version:   strings.Trim(cells[versionCol], "`*"),
+				Supported: isSupportedMark(cells[supportedCol]),
+			})
+		}
+		return versions
+	}
+	return nil
+}
+
+func markdownTableCells(line string) []string {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "|") {
+		return nil
+	}
+	line = strings.TrimSuffix(strings.TrimPrefix(line, "|"), "|")
+	cells := strings.Split(line, "|")
+	for i := range cells {
+		cells[i] = strings.TrimSpace(cells[i])
+	}
+	return cells
+}
+
+var reTableSeparator = regexp.MustCompile(`^:?-+:?$`)
+
+func isMarkdownTableSeparator(line string) bool {
+	cells := markdownTableCells(line)
+	for _, cell := range cells {
+		if !reTableSeparator.MatchString(cell) {
+			return false
+		}
+	}
+	return len(cells) > 0
+}
+
+func isSupportedMark(cell string) bool {
+	cell = strings.ToLower(cell)
+	for _, mark := range []string{"❌", ":x:", "✗", "unsupported", "not supported", "end of life", "eol"} {
+		if strings.Contains(cell, mark) {
+			return false
+		}
+	}
+	for _, mark := range []string{"✅", "✔", "✓", ":white_check_mark:", ":heavy_check_mark:", "yes", "supported"} {
+		if strings.Contains(cell, mark) {
+			return true
+		}
+	}
+	return false
+}
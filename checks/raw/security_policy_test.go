@@ -19,10 +19,13 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
 
 	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
 	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
 	scut "github.com/ossf/scorecard/v4/utests"
 )
@@ -139,6 +142,8 @@ func TestSecurityPolicy(t *testing.T) {
 			mockRepo := mockrepo.NewMockRepo(ctrl)
 
 			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(tt.files, nil).AnyTimes()
+			mockRepoClient.EXPECT().IsPrivateVulnerabilityReportingEnabled().Return(false, clients.ErrUnsupportedFeature).AnyTimes()
+			mockRepoClient.EXPECT().ListSecurityAdvisories().Return(nil, clients.ErrUnsupportedFeature).AnyTimes()
 			// the revised Security Policy will immediate go for the
 			// file contents once found. This test will return that
 			// mock file, but this specific unit test is not testing
@@ -175,3 +180,182 @@ func TestSecurityPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestSecurityPolicySecurityTxt(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{".well-known/security.txt"}, nil).AnyTimes()
+	mockRepoClient.EXPECT().IsPrivateVulnerabilityReportingEnabled().Return(false, clients.ErrUnsupportedFeature).AnyTimes()
+	mockRepoClient.EXPECT().ListSecurityAdvisories().Return(nil, clients.ErrUnsupportedFeature).AnyTimes()
+	mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(fn string) (io.ReadCloser, error) {
+		return os.Open("./testdata/" + fn)
+	}).AnyTimes()
+	mockRepoClient.EXPECT().GetOrgRepoClient(gomock.Any()).Return(nil, clients.ErrUnsupportedFeature).AnyTimes()
+
+	c := checker.CheckRequest{
+		RepoClient: mockRepoClient,
+		Dlogger:    &scut.TestDetailLogger{},
+	}
+	res, err := SecurityPolicy(&c)
+	if err != nil {
+		t.Fatalf("SecurityPolicy: %v", err)
+	}
+	// security.txt is not a security policy by itself.
+	if len(res.PolicyFiles) != 0 {
+		t.Errorf("got %d policy files, want 0", len(res.PolicyFiles))
+	}
+	if len(res.SecurityTxtFiles) != 1 {
+		t.Fatalf("got %d security.txt files, want 1", len(res.SecurityTxtFiles))
+	}
+	expires := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	want := &checker.SecurityTxt{
+		Expires:            &expires,
+		Contacts:           []string{"mailto:security@example.com", "https://example.com/security/report"},
+		Encryption:         []string{"https://example.com/pgp-key.txt"},
+		Policies:           []string{"https://example.com/security-policy.html"},
+		PreferredLanguages: []string{"en", "fr"},
+		Canonical:          []string{"https://example.com/.well-known/security.txt"},
+		Signed:             true,
+	}
+	if diff := cmp.Diff(want, res.SecurityTxtFiles[0].SecurityTxt); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+//nolint:paralleltest // sets SCORECARD_EXPERIMENTAL
+func TestSecurityPolicyDisclosureChannels(t *testing.T) {
+	t.Setenv("SCORECARD_EXPERIMENTAL", "1")
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(nil, nil).AnyTimes()
+	mockRepoClient.EXPECT().GetOrgRepoClient(gomock.Any()).Return(nil, clients.ErrUnsupportedFeature).AnyTimes()
+	mockRepoClient.EXPECT().IsPrivateVulnerabilityReportingEnabled().Return(true, nil)
+	mockRepoClient.EXPECT().ListSecurityAdvisories().Return(nil, nil)
+
+	c := checker.CheckRequest{
+		RepoClient: mockRepoClient,
+		Dlogger:    &scut.TestDetailLogger{},
+	}
+	res, err := SecurityPolicy(&c)
+	if err != nil {
+		t.Fatalf("SecurityPolicy: %v", err)
+	}
+	if res.PrivateVulnerabilityReportingEnabled == nil || !*res.PrivateVulnerabilityReportingEnabled {
+		t.Errorf("PrivateVulnerabilityReportingEnabled = %v, want true", res.PrivateVulnerabilityReportingEnabled)
+	}
+	// No advisories is different from advisories not being listed.
+	if res.SecurityAdvisories == nil || len(res.SecurityAdvisories) != 0 {
+		t.Errorf("SecurityAdvisories = %v, want empty", res.SecurityAdvisories)
+	}
+}
+
+func TestSecurityPolicyDisclosureChannelsNotExperimental(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(nil, nil).AnyTimes()
+	mockRepoClient.EXPECT().GetOrgRepoClient(gomock.Any()).Return(nil, clients.ErrUnsupportedFeature).AnyTimes()
+	// IsPrivateVulnerabilityReportingEnabled and ListSecurityAdvisories must not be called.
+
+	c := checker.CheckRequest{
+		RepoClient: mockRepoClient,
+		Dlogger:    &scut.TestDetailLogger{},
+	}
+	res, err := SecurityPolicy(&c)
+	if err != nil {
+		t.Fatalf("SecurityPolicy: %v", err)
+	}
+	if res.PrivateVulnerabilityReportingEnabled != nil || res.SecurityAdvisories != nil {
+		t.Errorf("disclosure channels collected outside experimental mode: %v, %v",
+			res.PrivateVulnerabilityReportingEnabled, res.SecurityAdvisories)
+	}
+}
+
+func Test_parseSecurityTxt(t *testing.T) {
+	t.Parallel()
+	expires := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		want    *checker.SecurityTxt
+		name    string
+		content string
+	}{
+		{
+			name:    "unsigned with case-insensitive fields",
+			content: "CONTACT: mailto:security@example.com\r\nexpires: 2030-01-01T00:00:00Z\r\n",
+			want: &checker.SecurityTxt{
+				Contacts: []string{"mailto:security@example.com"},
+				Expires:  &expires,
+			},
+		},
+		{
+			name:    "invalid expiry date",
+			content: "Contact: mailto:security@example.com\nExpires: next year\n",
+			want: &checker.SecurityTxt{
+				Contacts: []string{"mailto:security@example.com"},
+			},
+		},
+		{
+			name: "expiry date set twice",
+			content: "Contact: mailto:security@example.com\n" +
+				"Expires: 2030-01-01T00:00:00Z\nExpires: 2031-01-01T00:00:00Z\n",
+			want: &checker.SecurityTxt{
+				Contacts: []string{"mailto:security@example.com"},
+			},
+		},
+		{
+			name: "expiry date set three times",
+			content: "Contact: mailto:security@example.com\n" +
+				"Expires: 2030-01-01T00:00:00Z\nExpires: 2031-01-01T00:00:00Z\nExpires: 2032-01-01T00:00:00Z\n",
+			want: &checker.SecurityTxt{
+				Contacts: []string{"mailto:security@example.com"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := parseSecurityTxt([]byte(tt.content))
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_parseSupportedVersions(t *testing.T) {
+	t.Parallel()
+	content := `# Security Policy
+
+## Supported Versions
+
+| Version | Supported          |
+| ------- | ------------------ |
+| 5.1.x   | :white_check_mark: |
+| 5.0.x   | :x:                |
+| ` + "`4.0.x`" + ` | ✅ |
+| < 4.0   | ❌                 |
+
+## Reporting a Vulnerability
+
+We acknowledge reports within 48 hours and publish an advisory after 90 days.
+Contact us at security@example.com.
+`
+	wantVersions := []checker.SupportedVersion{
+		{Version: "5.1.x", Supported: true},
+		{Version: "5.0.x", Supported: false},
+		{Version: "4.0.x", Supported: true},
+		{Version: "< 4.0", Supported: false},
+	}
+	if diff := cmp.Diff(wantVersions, parseSupportedVersions([]byte(content))); diff != "" {
+		t.Errorf("parseSupportedVersions mismatch (-want +got):\n%s", diff)
+	}
+
+	wantTimeline := []checker.SecurityPolicyValueType{
+		{Match: "48 hours", LineNumber: 14, Offset: 30},
+	}
+	if diff := cmp.Diff(wantTimeline, collectDisclosureTimeline([]byte(content))); diff != "" {
+		t.Errorf("collectDisclosureTimeline mismatch (-want +got):\n%s", diff)
+	}
+}
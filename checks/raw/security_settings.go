@@ -0,0 +1,42 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+// SecuritySettings retrieves the raw data for the Security-Settings check.
+func SecuritySettings(c *checker.CheckRequest) (checker.SecuritySettingsData, error) {
+	data := checker.SecuritySettingsData{
+		Settings: map[clients.SecuritySetting]bool{},
+	}
+	for _, setting := range clients.SecuritySettings {
+		enabled, err := c.RepoClient.IsSecuritySettingEnabled(setting)
+		switch {
+		case errors.Is(err, clients.ErrUnsupportedFeature):
+			// The token lacks the scope to read the setting, or the forge has no such setting.
+		case err != nil:
+			return checker.SecuritySettingsData{}, fmt.Errorf("IsSecuritySettingEnabled: %w", err)
+		default:
+			data.Settings[setting] = enabled
+		}
+	}
+	return data, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
+)
+
+var errSecuritySettings = errors.New("security settings")
+
+func TestSecuritySettings(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		settings map[clients.SecuritySetting]bool
+		err      error
+		want     map[clients.SecuritySetting]bool
+		name     string
+		wantErr  bool
+	}{
+		{
+			name: "unsupported settings are unknown",
+			settings: map[clients.SecuritySetting]bool{
+				clients.SecretScanning: true,
+				clients.CodeScanning:   false,
+			},
+			want: map[clients.SecuritySetting]bool{
+				clients.SecretScanning: true,
+				clients.CodeScanning:   false,
+			},
+		},
+		{
+			name:    "client error",
+			err:     errSecuritySettings,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+			mockRepoClient.EXPECT().IsSecuritySettingEnabled(gomock.Any()).DoAndReturn(
+				func(setting clients.SecuritySetting) (bool, error) {
+					if tt.err != nil {
+						return false, tt.err
+					}
+					enabled, ok := tt.settings[setting]
+					if !ok {
+						return false, fmt.Errorf("%w: %s", clients.ErrUnsupportedFeature, setting)
+					}
+					return enabled, nil
+				}).AnyTimes()
+			got, err := SecuritySettings(&checker.CheckRequest{RepoClient: mockRepoClient})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SecuritySettings() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.want, got.Settings); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
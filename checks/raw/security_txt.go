@@ -0,0 +1,113 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ossf/scorecard/v4/checker"
+)
+
+const (
+	pgpSignedMessageHeader = "-----BEGIN PGP SIGNED MESSAGE-----"
+	pgpSignatureHeader     = "-----BEGIN PGP SIGNATURE-----"
+)
+
+// parseSecurityTxt parses the fields of a security.txt file.
+// See https://www.rfc-editor.org/rfc/rfc9116#section-2.
+func parseSecurityTxt(content []byte) *checker.SecurityTxt {
+	var txt checker.SecurityTxt
+	var expiresFields int
+	lines := strings.Split(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+	lines, txt.Signed = stripCleartextSignature(lines)
+
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		// Field names are case-insensitive.
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "contact":
+			txt.Contacts = append(txt.Contacts, value)
+		case "encryption":
+			txt.Encryption = append(txt.Encryption, value)
+		case "policy":
+			txt.Policies = append(txt.Policies, value)
+		case "canonical":
+			txt.Canonical = append(txt.Canonical, value)
+		case "preferred-languages":
+			for _, lang := range strings.Split(value, ",") {
+				if lang = strings.TrimSpace(lang); lang != "" {
+					txt.PreferredLanguages = append(txt.PreferredLanguages, lang)
+				}
+			}
+		case "expires":
+			// The field must not appear more than once.
+			expiresFields++
+			if expiresFields > 1 {
+				txt.Expires = nil
+				continue
+			}
+			if t, err := time.Parse(time.RFC3339, value); err == nil {
+				txt.Expires = &t
+			}
+		}
+	}
+	return &txt
+}
+
+// stripCleartextSignature returns the signed lines of an OpenPGP cleartext
+// signed message, and whether the lines were signed.
+// See https://www.rfc-editor.org/rfc/rfc4880#section-7.
+func stripCleartextSignature(lines []string) ([]string, bool) {
+	start := -1
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if strings.TrimSpace(line) == pgpSignedMessageHeader {
+			start = i
+		}
+		break
+	}
+	if start < 0 {
+		return lines, false
+	}
+
+	// Armor headers, such as "Hash:", end with an empty line.
+	i := start + 1
+	for i < len(lines) && strings.TrimSpace(lines[i]) != "" {
+		i++
+	}
+	if i < len(lines) {
+		i++
+	}
+	var signed []string
+	for _, line := range lines[i:] {
+		if strings.TrimSpace(line) == pgpSignatureHeader {
+			break
+		}
+		// Undo dash-escaping.
+		signed = append(signed, strings.TrimPrefix(line, "- "))
+	}
+	return signed, true
+}
@@ -0,0 +1,184 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	errInvalidSPDXExpression = errors.New("invalid SPDX license expression")
+	// reSPDXID matches license and exception identifiers, including LicenseRef- and DocumentRef- references.
+	reSPDXID = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9.\-:]*\+?$`)
+)
+
+// spdxExpression is a parsed SPDX license expression.
+// See https://spdx.github.io/spdx-spec/v2.3/SPDX-license-expressions/.
+type spdxExpression struct {
+	// licenses are the license identifiers of the expression,
+	// without the "+" operator.
+	licenses   []string
+	exceptions []string
+	// approved is true if the expression can be satisfied with
+	// FSF or OSI approved licenses only.
+	approved bool
+}
+
+type spdxParser struct {
+	expr   *spdxExpression
+	tokens []string
+	pos    int
+}
+
+// parseSPDXExpression parses expressions such as "MIT OR Apache-2.0" or
+// "GPL-2.0-or-later WITH Classpath-exception-2.0".
+func parseSPDXExpression(expression string) (*spdxExpression, error) {
+	setCiMap()
+	p := spdxParser{
+		tokens: tokenizeSPDXExpression(expression),
+		expr:   &spdxExpression{},
+	}
+	if len(p.tokens) == 0 {
+		return nil, fmt.Errorf("%w: empty expression", errInvalidSPDXExpression)
+	}
+	approved, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("%w: unexpected %q", errInvalidSPDXExpression, p.tokens[p.pos])
+	}
+	p.expr.approved = approved
+	return p.expr, nil
+}
+
+func tokenizeSPDXExpression(expression string) []string {
+	expression = strings.NewReplacer("(", " ( ", ")", " ) ").Replace(expression)
+	return strings.Fields(expression)
+}
+
+// operator returns the operator at the current position, if any.
+// Operators are either all upper case or all lower case.
+func (p *spdxParser) operator(op string) bool {
+	if p.pos >= len(p.tokens) {
+		return false
+	}
+	if tok := p.tokens[p.pos]; tok == op || tok == strings.ToLower(op) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *spdxParser) parseOr() (bool, error) {
+	approved, err := p.parseAnd()
+	if err != nil {
+		return false, err
+	}
+	for p.operator("OR") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return false, err
+		}
+		approved = approved || right
+	}
+	return approved, nil
+}
+
+func (p *spdxParser) parseAnd() (bool, error) {
+	approved, err := p.parseWith()
+	if err != nil {
+		return false, err
+	}
+	for p.operator("AND") {
+		right, err := p.parseWith()
+		if err != nil {
+			return false, err
+		}
+		approved = approved && right
+	}
+	return approved, nil
+}
+
+func (p *spdxParser) parseWith() (bool, error) {
+	approved, err := p.parseSimple()
+	if err != nil {
+		return false, err
+	}
+	if p.operator("WITH") {
+		if p.pos >= len(p.tokens) || !isSPDXIdentifier(p.tokens[p.pos]) {
+			return false, fmt.Errorf("%w: missing exception after WITH", errInvalidSPDXExpression)
+		}
+		p.expr.exceptions = append(p.expr.exceptions, p.tokens[p.pos])
+		p.pos++
+	}
+	return approved, nil
+}
+
+func (p *spdxParser) parseSimple() (bool, error) {
+	if p.pos >= len(p.tokens) {
+		return false, fmt.Errorf("%w: unexpected end of expression", errInvalidSPDXExpression)
+	}
+	tok := p.tokens[p.pos]
+	p.pos++
+	if tok == "(" {
+		approved, err := p.parseOr()
+		if err != nil {
+			return false, err
+		}
+		if p.pos >= len(p.tokens) || p.tokens[p.pos] != ")" {
+			return false, fmt.Errorf("%w: missing closing parenthesis", errInvalidSPDXExpression)
+		}
+		p.pos++
+		return approved, nil
+	}
+	if !isSPDXIdentifier(tok) {
+		return false, fmt.Errorf("%w: unexpected %q", errInvalidSPDXExpression, tok)
+	}
+	id := strings.TrimSuffix(tok, "+")
+	p.expr.licenses = append(p.expr.licenses, id)
+	return isApprovedSPDXID(id), nil
+}
+
+func isSPDXIdentifier(tok string) bool {
+	switch strings.ToUpper(tok) {
+	case "AND", "OR", "WITH":
+		return false
+	}
+	return reSPDXID.MatchString(tok)
+}
+
+// isApprovedSPDXID returns whether the license identifier is FSF or OSI approved.
+// setCiMap must have been called.
+func isApprovedSPDXID(id string) bool {
+	key := strings.ToUpper(id)
+	// See setCiMap for the special case of the Unlicense.
+	if key == "UNLICENSE" {
+		key = "UN"
+	}
+	if len(fsfOsiApprovedLicenseCiMap[key].Name) > 0 {
+		return true
+	}
+	// The map has the deprecated identifiers of the GNU licenses, e.g. GPL-2.0 for GPL-2.0-only.
+	for _, suffix := range []string{"-ONLY", "-OR-LATER"} {
+		if base, found := strings.CutSuffix(key, suffix); found {
+			return len(fsfOsiApprovedLicenseCiMap[base].Name) > 0
+		}
+	}
+	return false
+}
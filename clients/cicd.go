@@ -0,0 +1,43 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clients
+
+// CIVariable represents a CI/CD variable defined in the settings of a project.
+type CIVariable struct {
+	Key              string
+	EnvironmentScope string
+	// Protected variables are only passed to pipelines running on protected branches or tags.
+	Protected bool
+	Masked    bool
+}
+
+// CIJobTokenScope represents the access settings of the CI/CD job token.
+type CIJobTokenScope struct {
+	// InboundRestricted is true if only allowlisted projects can use their
+	// job token to access this project.
+	InboundRestricted bool
+	// OutboundRestricted is true if the job token of this project can only
+	// access allowlisted projects.
+	OutboundRestricted bool
+}
+
+// WorkflowPermissions represents the GitHub Actions settings of the GITHUB_TOKEN
+// of a repository or an organization.
+type WorkflowPermissions struct {
+	// DefaultPermissions is the default permission of the token, "read" or "write".
+	DefaultPermissions string
+	// CanApprovePullRequestReviews is true if workflows can approve pull requests.
+	CanApprovePullRequestReviews bool
+}
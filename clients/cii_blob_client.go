@@ -31,9 +31,31 @@ type blobClientCIIBestPractices struct {
 
 // GetBadgeLevel implements CIIBestPracticesClient.GetBadgeLevel.
 func (client *blobClientCIIBestPractices) GetBadgeLevel(ctx context.Context, uri string) (BadgeLevel, error) {
+	badge, _, err := client.GetBadge(ctx, uri)
+	return badge, err
+}
+
+// GetBadge implements CIIBestPracticesBadgeClient.GetBadge.
+func (client *blobClientCIIBestPractices) GetBadge(ctx context.Context, uri string) (BadgeLevel, BadgeCriteria, error) {
+	parsedResponse, err := client.getBadgeResponse(ctx, uri)
+	if err != nil {
+		return Unknown, nil, err
+	}
+	if len(parsedResponse) < 1 {
+		return NotFound, nil, nil
+	}
+	badge, err := parsedResponse[0].getBadgeLevel()
+	if err != nil {
+		return badge, nil, err
+	}
+	return badge, parsedResponse[0].Criteria, nil
+}
+
+// getBadgeResponse returns no response if the project is not in the bucket.
+func (client *blobClientCIIBestPractices) getBadgeResponse(ctx context.Context, uri string) ([]BadgeResponse, error) {
 	bucket, err := blob.OpenBucket(ctx, client.bucketURL)
 	if err != nil {
-		return Unknown, fmt.Errorf("error during blob.OpenBucket: %w", err)
+		return nil, fmt.Errorf("error during blob.OpenBucket: %w", err)
 	}
 	defer bucket.Close()
 
@@ -41,23 +63,20 @@ func (client *blobClientCIIBestPractices) GetBadgeLevel(ctx context.Context, uri
 
 	exists, err := bucket.Exists(ctx, objectName)
 	if err != nil {
-		return Unknown, fmt.Errorf("error during bucket.Exists: %w", err)
+		return nil, fmt.Errorf("error during bucket.Exists: %w", err)
 	}
 	if !exists {
-		return NotFound, nil
+		return nil, nil
 	}
 
 	jsonData, err := bucket.ReadAll(ctx, objectName)
 	if err != nil {
-		return Unknown, fmt.Errorf("error during bucket.ReadAll: %w", err)
+		return nil, fmt.Errorf("error during bucket.ReadAll: %w", err)
 	}
 
 	parsedResponse, err := ParseBadgeResponseFromJSON(jsonData)
 	if err != nil {
-		return Unknown, fmt.Errorf("error parsing data: %w", err)
-	}
-	if len(parsedResponse) < 1 {
-		return NotFound, nil
+		return nil, fmt.Errorf("error parsing data: %w", err)
 	}
-	return parsedResponse[0].getBadgeLevel()
+	return parsedResponse, nil
 }
@@ -57,11 +57,38 @@ func (badge BadgeLevel) String() string {
 	}
 }
 
+// BadgeCriterionStatus is the self-attested status of a CII Best Practices criterion.
+type BadgeCriterionStatus string
+
+const (
+	// CriterionMet is the status of a criterion the project meets.
+	CriterionMet BadgeCriterionStatus = "Met"
+	// CriterionUnmet is the status of a criterion the project does not meet.
+	CriterionUnmet BadgeCriterionStatus = "Unmet"
+	// CriterionNotApplicable is the status of a criterion not applicable to the project.
+	CriterionNotApplicable BadgeCriterionStatus = "N/A"
+	// CriterionUnknown is the status of a criterion the project did not answer.
+	CriterionUnknown BadgeCriterionStatus = "?"
+)
+
+// BadgeCriteria maps the names of CII Best Practices criteria,
+// e.g. "static_analysis", to their status.
+// https://www.bestpractices.dev/en/criteria
+type BadgeCriteria map[string]BadgeCriterionStatus
+
 // CIIBestPracticesClient interface returns the BadgeLevel for a repo URL.
 type CIIBestPracticesClient interface {
 	GetBadgeLevel(ctx context.Context, uri string) (BadgeLevel, error)
 }
 
+// CIIBestPracticesBadgeClient is implemented by the CIIBestPracticesClients
+// which also return the criteria of the project.
+type CIIBestPracticesBadgeClient interface {
+	// GetBadge returns the BadgeLevel and the criteria of a repo URL from a single lookup.
+	// The criteria are nil if the project has no badge entry.
+	GetBadge(ctx context.Context, uri string) (BadgeLevel, BadgeCriteria, error)
+}
+
 // DefaultCIIBestPracticesClient returns http-based implementation of the interface.
 func DefaultCIIBestPracticesClient() CIIBestPracticesClient {
 	return &httpClientCIIBestPractices{}
@@ -48,11 +48,32 @@ func (transport *expBackoffTransport) RoundTrip(req *http.Request) (*http.Respon
 
 // GetBadgeLevel implements CIIBestPracticesClient.GetBadgeLevel.
 func (client *httpClientCIIBestPractices) GetBadgeLevel(ctx context.Context, uri string) (BadgeLevel, error) {
+	badge, _, err := client.GetBadge(ctx, uri)
+	return badge, err
+}
+
+// GetBadge implements CIIBestPracticesBadgeClient.GetBadge.
+func (client *httpClientCIIBestPractices) GetBadge(ctx context.Context, uri string) (BadgeLevel, BadgeCriteria, error) {
+	parsedResponse, err := client.getBadgeResponse(ctx, uri)
+	if err != nil {
+		return Unknown, nil, err
+	}
+	if len(parsedResponse) < 1 {
+		return NotFound, nil, nil
+	}
+	badge, err := parsedResponse[0].getBadgeLevel()
+	if err != nil {
+		return badge, nil, err
+	}
+	return badge, parsedResponse[0].Criteria, nil
+}
+
+func (client *httpClientCIIBestPractices) getBadgeResponse(ctx context.Context, uri string) ([]BadgeResponse, error) {
 	repoURI := fmt.Sprintf("https://%s", uri)
 	url := fmt.Sprintf("https://www.bestpractices.dev/projects.json?url=%s", repoURI)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return Unknown, fmt.Errorf("error during http.NewRequestWithContext: %w", err)
+		return nil, fmt.Errorf("error during http.NewRequestWithContext: %w", err)
 	}
 
 	httpClient := http.Client{
@@ -62,21 +83,18 @@ func (client *httpClientCIIBestPractices) GetBadgeLevel(ctx context.Context, uri
 	}
 	resp, err := httpClient.Do(req)
 	if err != nil {
-		return Unknown, fmt.Errorf("error during http.Do: %w", err)
+		return nil, fmt.Errorf("error during http.Do: %w", err)
 	}
 	defer resp.Body.Close()
 
 	jsonData, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return Unknown, fmt.Errorf("error during io.ReadAll: %w", err)
+		return nil, fmt.Errorf("error during io.ReadAll: %w", err)
 	}
 
 	parsedResponse, err := ParseBadgeResponseFromJSON(jsonData)
 	if err != nil {
-		return Unknown, fmt.Errorf("error during json parsing: %w", err)
-	}
-	if len(parsedResponse) < 1 {
-		return NotFound, nil
+		return nil, fmt.Errorf("error during json parsing: %w", err)
 	}
-	return parsedResponse[0].getBadgeLevel()
+	return parsedResponse, nil
 }
@@ -30,9 +30,53 @@ const (
 
 var errUnsupportedBadge = errors.New("unsupported badge")
 
+// criterionStatusSuffix is the suffix of the criteria statuses in the responses,
+// e.g. "static_analysis_status".
+const criterionStatusSuffix = "_status"
+
 // BadgeResponse struct is used to read/write CII Best Practices badge data.
 type BadgeResponse struct {
-	BadgeLevel string `json:"badge_level"`
+	// Criteria are read from and written to the `<criterion>_status` fields.
+	Criteria   BadgeCriteria `json:"-"`
+	BadgeLevel string        `json:"badge_level"`
+}
+
+// UnmarshalJSON reads the badge level and the criteria statuses.
+func (resp *BadgeResponse) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return fmt.Errorf("error during json.Unmarshal: %w", err)
+	}
+	*resp = BadgeResponse{}
+	for name, value := range fields {
+		var s string
+		// Skip the fields that are not strings, e.g. "id".
+		if err := json.Unmarshal(value, &s); err != nil {
+			continue
+		}
+		switch {
+		case name == "badge_level":
+			resp.BadgeLevel = s
+		case strings.HasSuffix(name, criterionStatusSuffix):
+			if resp.Criteria == nil {
+				resp.Criteria = BadgeCriteria{}
+			}
+			resp.Criteria[strings.TrimSuffix(name, criterionStatusSuffix)] = BadgeCriterionStatus(s)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON writes the badge level and the criteria statuses.
+func (resp BadgeResponse) MarshalJSON() ([]byte, error) {
+	fields := map[string]string{
+		"badge_level": resp.BadgeLevel,
+	}
+	for name, status := range resp.Criteria {
+		fields[name+criterionStatusSuffix] = string(status)
+	}
+	//nolint:wrapcheck
+	return json.Marshal(fields)
 }
 
 // getBadgeLevel parses a string badge value into BadgeLevel enum.
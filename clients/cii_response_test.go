@@ -40,6 +40,22 @@ func TestParseBadgeResponseFromJSON(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Test ParseBadgeResponseFromJSON with criteria",
+			args: args{
+				data: []byte(`[{"id":1,"badge_level":"passing","static_analysis_status":"Met",` +
+					`"test_status":"Unmet","static_analysis_justification":"We use CodeQL."}]`),
+			},
+			want: []BadgeResponse{
+				{
+					BadgeLevel: "passing",
+					Criteria: BadgeCriteria{
+						"static_analysis": CriterionMet,
+						"test":            CriterionUnmet,
+					},
+				},
+			},
+		},
 		{
 			name: "Fail Test ParseBadgeResponseFromJSON",
 			args: args{
@@ -0,0 +1,36 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clients
+
+// Environment is a deployment environment of a repository,
+// which jobs must pass the protection rules of before they run.
+type Environment struct {
+	Name string
+	// RequiredReviewers is the number of users and teams
+	// who can approve the jobs running in the environment.
+	RequiredReviewers int
+	// WaitTimer is the delay in minutes before the jobs run.
+	WaitTimer int
+	// ProtectedBranchesOnly is true if only protected branches can deploy.
+	ProtectedBranchesOnly bool
+	// CustomBranchPolicies is true if only branches matching
+	// name patterns can deploy.
+	CustomBranchPolicies bool
+}
+
+// RestrictsBranches returns true if not all branches can deploy to the environment.
+func (e *Environment) RestrictsBranches() bool {
+	return e.ProtectedBranchesOnly || e.CustomBranchPolicies
+}
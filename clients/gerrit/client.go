@@ -0,0 +1,247 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gerrit implements a client for the REST API of Gerrit code review servers.
+package gerrit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+// xssiPrefix prefixes the JSON responses of the Gerrit REST API.
+const xssiPrefix = ")]}'"
+
+// codeReviewLabel is the label maintainers vote on to approve a change.
+const codeReviewLabel = "Code-Review"
+
+// defaultTimeout bounds the requests to Gerrit servers, which are arbitrary hosts.
+const defaultTimeout = 30 * time.Second
+
+// maxResponseSize bounds the size of a change, votes included.
+const maxResponseSize = 1 << 20
+
+// maxHosts caps the number of distinct Gerrit servers a client queries, since
+// the hosts of Reviewed-on trailers are chosen by the commit authors.
+const maxHosts = 3
+
+var (
+	errMalformedURL     = errors.New("malformed Reviewed-on url")
+	errUnexpectedStatus = errors.New("unexpected status")
+	errResponseTooLarge = errors.New("response too large")
+	errTooManyHosts     = errors.New("too many Gerrit hosts")
+)
+
+// Change is a Gerrit change as returned by Client.
+type Change struct {
+	Owner clients.User
+	// Reviews are the votes on the Code-Review label. A +2 vote is an approval.
+	Reviews []clients.Review
+}
+
+// Client fetches the changes referenced by Reviewed-on trailers.
+type Client struct {
+	ctx        context.Context
+	httpClient *http.Client
+	// hosts are the servers queried so far.
+	hosts map[string]bool
+}
+
+// CreateGerritClient returns a Gerrit client which uses httpClient, or a
+// client timing out after defaultTimeout if httpClient is nil. Changes are
+// fetched anonymously, over https, from at most maxHosts servers.
+func CreateGerritClient(ctx context.Context, httpClient *http.Client) *Client {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+	return &Client{
+		ctx:        ctx,
+		httpClient: httpClient,
+		hosts:      map[string]bool{},
+	}
+}
+
+type accountInfo struct {
+	Name      string   `json:"name"`
+	Email     string   `json:"email"`
+	Username  string   `json:"username"`
+	Tags      []string `json:"tags"`
+	AccountID int64    `json:"_account_id"`
+}
+
+type approvalInfo struct {
+	accountInfo
+	Value int `json:"value"`
+}
+
+type changeInfo struct {
+	Labels map[string]struct {
+		All []approvalInfo `json:"all"`
+	} `json:"labels"`
+	Owner accountInfo `json:"owner"`
+}
+
+// GetChange returns the change at reviewedOn, the URL of a Reviewed-on trailer.
+func (c *Client) GetChange(reviewedOn string) (*Change, error) {
+	endpoint, err := changeEndpoint(reviewedOn)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.addHost(endpoint); err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(c.ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http.Client.Do: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %s returned %s", errUnexpectedStatus, endpoint, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("io.ReadAll: %w", err)
+	}
+	if len(body) > maxResponseSize {
+		return nil, fmt.Errorf("%w: %s", errResponseTooLarge, endpoint)
+	}
+	return parseChange(body)
+}
+
+// addHost records the host of endpoint, unless maxHosts were already queried.
+func (c *Client) addHost(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("url.Parse: %w", err)
+	}
+	if c.hosts[u.Host] {
+		return nil
+	}
+	if len(c.hosts) >= maxHosts {
+		return fmt.Errorf("%w: %s", errTooManyHosts, u.Host)
+	}
+	c.hosts[u.Host] = true
+	return nil
+}
+
+func parseChange(body []byte) (*Change, error) {
+	body = bytes.TrimPrefix(body, []byte(xssiPrefix))
+	var info changeInfo
+	if err := json.Unmarshal(body, &info); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	change := &Change{
+		Owner: info.Owner.user(),
+	}
+	for _, vote := range info.Labels[codeReviewLabel].All {
+		var state string
+		switch {
+		case vote.Value >= 2:
+			state = "APPROVED"
+		case vote.Value < 0:
+			state = "CHANGES_REQUESTED"
+		case vote.Value == 1:
+			state = "COMMENTED"
+		default:
+			// Reviewers who did not vote.
+			continue
+		}
+		author := vote.user()
+		change.Reviews = append(change.Reviews, clients.Review{
+			Author: &author,
+			State:  state,
+		})
+	}
+	return change, nil
+}
+
+func (a *accountInfo) user() clients.User {
+	login := a.Username
+	for _, l := range []string{a.Email, a.Name, strconv.FormatInt(a.AccountID, 10)} {
+		if login != "" {
+			break
+		}
+		login = l
+	}
+	user := clients.User{
+		Login: login,
+		ID:    a.AccountID,
+	}
+	for _, tag := range a.Tags {
+		if tag == "SERVICE_USER" {
+			user.IsBot = true
+		}
+	}
+	return user
+}
+
+// changeEndpoint returns the REST endpoint of the change at reviewedOn, which has one of the forms
+// https://host/c/project/+/123, https://host/c/123, https://host/#/c/123/ or https://host/123.
+func changeEndpoint(reviewedOn string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(reviewedOn))
+	if err != nil || u.Host == "" || u.Scheme != "https" {
+		return "", fmt.Errorf("%w: %s", errMalformedURL, reviewedOn)
+	}
+
+	var prefix, project, number string
+	p := u.Path
+	switch {
+	case strings.Contains(p, "/c/"):
+		i := strings.Index(p, "/c/")
+		prefix, p = p[:i], p[i+len("/c/"):]
+		if j := strings.Index(p, "/+/"); j >= 0 {
+			project, p = p[:j], p[j+len("/+/"):]
+		}
+		number, _, _ = strings.Cut(p, "/")
+	case strings.HasPrefix(u.Fragment, "/c/"):
+		prefix = strings.TrimSuffix(p, "/")
+		number, _, _ = strings.Cut(strings.TrimPrefix(u.Fragment, "/c/"), "/")
+	default:
+		p = strings.TrimSuffix(p, "/")
+		i := strings.LastIndex(p, "/")
+		if i < 0 {
+			return "", fmt.Errorf("%w: %s", errMalformedURL, reviewedOn)
+		}
+		prefix, number = p[:i], p[i+1:]
+	}
+	if _, err := strconv.ParseUint(number, 10, 64); err != nil {
+		return "", fmt.Errorf("%w: %s", errMalformedURL, reviewedOn)
+	}
+
+	id := number
+	if project != "" {
+		id = url.PathEscape(project) + "~" + number
+	}
+	return fmt.Sprintf("https://%s%s/changes/%s/detail", u.Host, prefix, id), nil
+}
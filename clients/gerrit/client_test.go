@@ -0,0 +1,154 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gerrit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+func TestChangeEndpoint(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		reviewedOn string
+		want       string
+		wantErr    error
+	}{
+		{
+			name:       "project change",
+			reviewedOn: "https://go-review.googlesource.com/c/go/+/12345",
+			want:       "https://go-review.googlesource.com/changes/go~12345/detail",
+		},
+		{
+			name:       "nested project with patchset",
+			reviewedOn: "https://review.example.org/r/c/platform/build/+/678/2",
+			want:       "https://review.example.org/r/changes/platform%2Fbuild~678/detail",
+		},
+		{
+			name:       "change without project",
+			reviewedOn: "https://review.example.org/c/678/",
+			want:       "https://review.example.org/changes/678/detail",
+		},
+		{
+			name:       "fragment",
+			reviewedOn: "https://review.example.org/#/c/678/",
+			want:       "https://review.example.org/changes/678/detail",
+		},
+		{
+			name:       "legacy",
+			reviewedOn: "https://review.example.org/678",
+			want:       "https://review.example.org/changes/678/detail",
+		},
+		{
+			name:       "not a change",
+			reviewedOn: "https://review.example.org/c/go/+/dashboard",
+			wantErr:    errMalformedURL,
+		},
+		{
+			name:       "http",
+			reviewedOn: "http://review.example.org/c/678/",
+			wantErr:    errMalformedURL,
+		},
+		{
+			name:       "no host",
+			reviewedOn: "review/678",
+			wantErr:    errMalformedURL,
+		},
+		{
+			name:       "no path",
+			reviewedOn: "https://review.example.org",
+			wantErr:    errMalformedURL,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := changeEndpoint(tt.reviewedOn)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("changeEndpoint() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("changeEndpoint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetChange(t *testing.T) {
+	t.Parallel()
+	content, err := os.ReadFile("./testdata/change.json")
+	if err != nil {
+		t.Fatalf("os.ReadFile: %v", err)
+	}
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/changes/scorecard~12345/detail" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(content) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	client := CreateGerritClient(context.Background(), srv.Client())
+	change, err := client.GetChange(srv.URL + "/c/scorecard/+/12345")
+	if err != nil {
+		t.Fatalf("GetChange: %v", err)
+	}
+	want := &Change{
+		Owner: clients.User{Login: "alice", ID: 1000096},
+		Reviews: []clients.Review{
+			{Author: &clients.User{Login: "alice", ID: 1000096}, State: "APPROVED"},
+			{Author: &clients.User{Login: "Carol", ID: 1000098}, State: "CHANGES_REQUESTED"},
+		},
+	}
+	if diff := cmp.Diff(want, change); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := client.GetChange(srv.URL + "/c/scorecard/+/999"); !errors.Is(err, errUnexpectedStatus) {
+		t.Errorf("GetChange() error = %v, want %v", err, errUnexpectedStatus)
+	}
+}
+
+func TestGetChangeLimits(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(bytes.Repeat([]byte(" "), maxResponseSize+1)) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	client := CreateGerritClient(context.Background(), srv.Client())
+	if _, err := client.GetChange(srv.URL + "/c/scorecard/+/12345"); !errors.Is(err, errResponseTooLarge) {
+		t.Errorf("GetChange() error = %v, want %v", err, errResponseTooLarge)
+	}
+
+	for i := 0; i < maxHosts; i++ {
+		client.hosts[fmt.Sprintf("review%d.example.org", i)] = true
+	}
+	if _, err := client.GetChange("https://review.example.org/c/678/"); !errors.Is(err, errTooManyHosts) {
+		t.Errorf("GetChange() error = %v, want %v", err, errTooManyHosts)
+	}
+}
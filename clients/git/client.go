@@ -255,6 +255,14 @@ func (c *Client) IsArchived() (bool, error) {
 	return false, clients.ErrUnsupportedFeature
 }
 
+func (c *Client) GetArchivedAt() (*time.Time, error) {
+	return nil, clients.ErrUnsupportedFeature
+}
+
+func (c *Client) IsPrivate() (bool, error) {
+	return false, clients.ErrUnsupportedFeature
+}
+
 func (c *Client) URI() string {
 	return c.repo.URI()
 }
@@ -378,6 +386,42 @@ func (c *Client) SearchCommits(request clients.SearchCommitsOptions) ([]clients.
 	return nil, clients.ErrUnsupportedFeature
 }
 
+func (c *Client) IsSecuritySettingEnabled(setting clients.SecuritySetting) (bool, error) {
+	return false, clients.ErrUnsupportedFeature
+}
+
+func (c *Client) ListEnvironments() ([]clients.Environment, error) {
+	return nil, clients.ErrUnsupportedFeature
+}
+
+func (c *Client) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	return false, clients.ErrUnsupportedFeature
+}
+
+func (c *Client) ListSecurityAdvisories() ([]clients.SecurityAdvisory, error) {
+	return nil, clients.ErrUnsupportedFeature
+}
+
+func (c *Client) ListTopics() ([]string, error) {
+	return nil, clients.ErrUnsupportedFeature
+}
+
+func (c *Client) GetWorkflowPermissions() (*clients.WorkflowPermissions, error) {
+	return nil, clients.ErrUnsupportedFeature
+}
+
+func (c *Client) GetOrgWorkflowPermissions() (*clients.WorkflowPermissions, error) {
+	return nil, clients.ErrUnsupportedFeature
+}
+
+func (c *Client) ListCIVariables() ([]clients.CIVariable, error) {
+	return nil, clients.ErrUnsupportedFeature
+}
+
+func (c *Client) GetCIJobTokenScope() (*clients.CIJobTokenScope, error) {
+	return nil, clients.ErrUnsupportedFeature
+}
+
 func (c *Client) LocalPath() (string, error) {
 	return c.tempDir, nil
 }
@@ -0,0 +1,148 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+// ListGitlinks implements RepoClient.ListGitlinks for the checked out commit.
+func (c *Client) ListGitlinks() (map[string]string, error) {
+	head, err := c.gitRepo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("git.Head: %w", err)
+	}
+	commit, err := c.gitRepo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("git.CommitObject: %w", err)
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("commit.Tree: %w", err)
+	}
+	gitlinks := map[string]string{}
+	walker := object.NewTreeWalker(tree, true /*recursive*/, nil)
+	defer walker.Close()
+	for {
+		name, entry, err := walker.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("walker.Next: %w", err)
+		}
+		if entry.Mode == filemode.Submodule {
+			gitlinks[name] = entry.Hash.String()
+		}
+	}
+	return gitlinks, nil
+}
+
+// reachabilityTimeout bounds the time, and so the memory, spent on the
+// repository of a single submodule.
+const reachabilityTimeout = 2 * time.Minute
+
+// reachabilityDepth bounds the history fetched from each branch.
+const reachabilityDepth = 1000
+
+var (
+	// ErrUnsupportedURL is returned for the URLs which are not probed: local
+	// paths, file:// and ssh URLs would reach the host running Scorecard or
+	// its credentials.
+	ErrUnsupportedURL = errors.New("only https URLs are supported")
+	errBeyondDepth    = errors.New("commit not found in the fetched history of the branches")
+)
+
+// IsCommitReachable returns whether the commit is reachable from one of the
+// branches of the https repository at uri. Commits at the tip of a branch are
+// found in the references advertised by the remote. Otherwise, the last
+// reachabilityDepth commits of each branch are fetched in memory: the fetch
+// only contains the objects reachable from the branches, so the commit is
+// reachable if it was fetched. It returns an error if it was not fetched but
+// the history of a branch is longer.
+func IsCommitReachable(ctx context.Context, uri, commitSHA string) (bool, error) {
+	if !IsSupportedURL(uri) {
+		return false, fmt.Errorf("%w: %s", ErrUnsupportedURL, uri)
+	}
+	return isCommitReachable(ctx, uri, commitSHA)
+}
+
+// IsSupportedURL returns whether IsCommitReachable probes the repository at uri.
+func IsSupportedURL(uri string) bool {
+	u, err := url.Parse(uri)
+	return err == nil && u.Scheme == "https" && u.Host != ""
+}
+
+func isCommitReachable(ctx context.Context, uri, commitSHA string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, reachabilityTimeout)
+	defer cancel()
+
+	repo, err := git.Init(memory.NewStorage(), nil /*worktree*/)
+	if err != nil {
+		return false, fmt.Errorf("git.Init: %w", err)
+	}
+	remote, err := repo.CreateRemote(&config.RemoteConfig{
+		Name: git.DefaultRemoteName,
+		URLs: []string{uri},
+	})
+	if err != nil {
+		return false, fmt.Errorf("repo.CreateRemote: %w", err)
+	}
+	refs, err := remote.ListContext(ctx, &git.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("remote.List: %w %s", err, uri)
+	}
+	for _, ref := range refs {
+		if ref.Name().IsBranch() && ref.Hash().String() == commitSHA {
+			return true, nil
+		}
+	}
+
+	err = remote.FetchContext(ctx, &git.FetchOptions{
+		RefSpecs: []config.RefSpec{"+refs/heads/*:refs/remotes/origin/*"},
+		Depth:    reachabilityDepth,
+		Tags:     git.NoTags,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return false, fmt.Errorf("remote.Fetch: %w %s", err, uri)
+	}
+	_, err = repo.CommitObject(plumbing.NewHash(commitSHA))
+	switch {
+	case err == nil:
+		return true, nil
+	case !errors.Is(err, plumbing.ErrObjectNotFound):
+		return false, fmt.Errorf("git.CommitObject: %w", err)
+	}
+	shallow, err := repo.Storer.Shallow()
+	if err != nil {
+		return false, fmt.Errorf("storer.Shallow: %w", err)
+	}
+	if len(shallow) > 0 {
+		return false, fmt.Errorf("%w: %d commits of each branch", errBeyondDepth, reachabilityDepth)
+	}
+	return false, nil
+}
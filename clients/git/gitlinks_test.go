@@ -0,0 +1,170 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gitV5 "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage"
+	"github.com/google/go-cmp/cmp"
+)
+
+const gitlinkSHA = "b796f7d44681514f58a683a3a71ff17c94edb0c1"
+
+func storeObject(t *testing.T, s storage.Storer, o interface {
+	Encode(plumbing.EncodedObject) error
+},
+) plumbing.Hash {
+	t.Helper()
+	obj := s.NewEncodedObject()
+	if err := o.Encode(obj); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	h, err := s.SetEncodedObject(obj)
+	if err != nil {
+		t.Fatalf("SetEncodedObject: %v", err)
+	}
+	return h
+}
+
+// createRepoWithSubmodule creates a repository whose two commits on master have
+// the gitlink third_party/lib and returns it with the first and an unreachable commit.
+func createRepoWithSubmodule(t *testing.T) (path string, r *gitV5.Repository, first, orphan plumbing.Hash) {
+	t.Helper()
+	dir := t.TempDir()
+	r, err := gitV5.PlainInit(dir, false)
+	if err != nil {
+		t.Fatalf("Failed to initialize git repo: %v", err)
+	}
+	libTree := storeObject(t, r.Storer, &object.Tree{
+		Entries: []object.TreeEntry{
+			{Name: "lib", Mode: filemode.Submodule, Hash: plumbing.NewHash(gitlinkSHA)},
+		},
+	})
+	tree := storeObject(t, r.Storer, &object.Tree{
+		Entries: []object.TreeEntry{
+			{Name: "third_party", Mode: filemode.Dir, Hash: libTree},
+		},
+	})
+	sig := object.Signature{Name: "Test Author", Email: "author@example.com", When: time.Now()}
+	first = storeObject(t, r.Storer, &object.Commit{
+		Author:    sig,
+		Committer: sig,
+		Message:   "Add submodule",
+		TreeHash:  tree,
+	})
+	commit := storeObject(t, r.Storer, &object.Commit{
+		Author:       sig,
+		Committer:    sig,
+		Message:      "Update",
+		TreeHash:     tree,
+		ParentHashes: []plumbing.Hash{first},
+	})
+	orphan = storeObject(t, r.Storer, &object.Commit{
+		Author:    sig,
+		Committer: sig,
+		Message:   "Orphan commit",
+		TreeHash:  tree,
+	})
+	ref := plumbing.NewHashReference(plumbing.NewBranchReferenceName("master"), commit)
+	if err := r.Storer.SetReference(ref); err != nil {
+		t.Fatalf("SetReference: %v", err)
+	}
+	return dir, r, first, orphan
+}
+
+func TestListGitlinks(t *testing.T) {
+	t.Parallel()
+	_, r, _, _ := createRepoWithSubmodule(t)
+	c := &Client{gitRepo: r}
+	got, err := c.ListGitlinks()
+	if err != nil {
+		t.Fatalf("ListGitlinks: %v", err)
+	}
+	want := map[string]string{"third_party/lib": gitlinkSHA}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestIsCommitReachable(t *testing.T) {
+	t.Parallel()
+	dir, r, first, orphan := createRepoWithSubmodule(t)
+	head, err := r.Head()
+	if err != nil {
+		t.Fatalf("Head: %v", err)
+	}
+	tests := []struct {
+		name   string
+		commit string
+		want   bool
+	}{
+		{name: "tip of a branch", commit: head.Hash().String(), want: true},
+		{name: "commit of a branch", commit: first.String(), want: true},
+		{name: "orphan commit", commit: orphan.String(), want: false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := isCommitReachable(context.Background(), dir, tt.commit)
+			if err != nil {
+				t.Fatalf("IsCommitReachable: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("IsCommitReachable(%s) = %v, want %v", tt.commit, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsCommitReachableURL(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		uri  string
+		want bool
+	}{
+		{uri: "https://github.com/google/googletest.git", want: true},
+		{uri: "http://example.com/lib.git", want: false},
+		{uri: "file:///etc/passwd", want: false},
+		{uri: "/srv/git/lib.git", want: false},
+		{uri: "../lib.git", want: false},
+		{uri: "ssh://git@github.com/google/googletest.git", want: false},
+		{uri: "git@github.com:google/googletest.git", want: false},
+		{uri: "https:///lib.git", want: false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.uri, func(t *testing.T) {
+			t.Parallel()
+			if got := IsSupportedURL(tt.uri); got != tt.want {
+				t.Errorf("IsSupportedURL(%q) = %t, want %t", tt.uri, got, tt.want)
+			}
+			if tt.want {
+				return
+			}
+			if _, err := IsCommitReachable(context.Background(), tt.uri, gitlinkSHA); !errors.Is(err, ErrUnsupportedURL) {
+				t.Errorf("IsCommitReachable(%q) error = %v, want %v", tt.uri, err, ErrUnsupportedURL)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"sync"
+
+	"github.com/google/go-github/v53/github"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+// workflowPermissionsResponse is defined at
+// docs.github.com/en/rest/actions/permissions#get-default-workflow-permissions-for-a-repository.
+type workflowPermissionsResponse struct {
+	DefaultWorkflowPermissions   string `json:"default_workflow_permissions"`
+	CanApprovePullRequestReviews bool   `json:"can_approve_pull_request_reviews"`
+}
+
+type actionsHandler struct {
+	ghClient   *github.Client
+	repoOnce   *sync.Once
+	orgOnce    *sync.Once
+	ctx        context.Context
+	errRepo    error
+	errOrg     error
+	repourl    *repoURL
+	repoPerms  *clients.WorkflowPermissions
+	orgPerms   *clients.WorkflowPermissions
+	isOrgOwned bool
+}
+
+func (handler *actionsHandler) init(ctx context.Context, repourl *repoURL, isOrgOwned bool) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.isOrgOwned = isOrgOwned
+	handler.repoOnce = new(sync.Once)
+	handler.orgOnce = new(sync.Once)
+	handler.errRepo = nil
+	handler.errOrg = nil
+	handler.repoPerms = nil
+	handler.orgPerms = nil
+}
+
+func (handler *actionsHandler) getWorkflowPermissions(reqURL string) (*clients.WorkflowPermissions, error) {
+	req, err := handler.ghClient.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request for workflow permissions failed with %w", err)
+	}
+	bodyJSON := workflowPermissionsResponse{}
+	resp, err := handler.ghClient.Do(handler.ctx, req, &bodyJSON)
+	if resp != nil {
+		switch resp.StatusCode {
+		// Reading the settings requires admin access.
+		case http.StatusForbidden, http.StatusNotFound:
+			return nil, fmt.Errorf("%w: workflow permissions require admin access", clients.ErrUnsupportedFeature)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("response for workflow permissions failed with %w", err)
+	}
+	return &clients.WorkflowPermissions{
+		DefaultPermissions:           bodyJSON.DefaultWorkflowPermissions,
+		CanApprovePullRequestReviews: bodyJSON.CanApprovePullRequestReviews,
+	}, nil
+}
+
+func (handler *actionsHandler) getRepoWorkflowPermissions() (*clients.WorkflowPermissions, error) {
+	handler.repoOnce.Do(func() {
+		reqURL := path.Join("repos", handler.repourl.owner, handler.repourl.repo, "actions", "permissions", "workflow")
+		handler.repoPerms, handler.errRepo = handler.getWorkflowPermissions(reqURL)
+	})
+	return handler.repoPerms, handler.errRepo
+}
+
+func (handler *actionsHandler) getOrgWorkflowPermissions() (*clients.WorkflowPermissions, error) {
+	handler.orgOnce.Do(func() {
+		if !handler.isOrgOwned {
+			handler.errOrg = fmt.Errorf("%w: repository is not owned by an organization", clients.ErrUnsupportedFeature)
+			return
+		}
+		reqURL := path.Join("orgs", handler.repourl.owner, "actions", "permissions", "workflow")
+		handler.orgPerms, handler.errOrg = handler.getWorkflowPermissions(reqURL)
+	})
+	return handler.orgPerms, handler.errOrg
+}
@@ -0,0 +1,102 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v53/github"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+func Test_getWorkflowPermissions(t *testing.T) {
+	t.Parallel()
+	want := &clients.WorkflowPermissions{
+		DefaultPermissions:           "read",
+		CanApprovePullRequestReviews: true,
+	}
+	tests := []struct {
+		wantOrg    *clients.WorkflowPermissions
+		name       string
+		isOrgOwned bool
+	}{
+		{
+			name:       "organization repository",
+			isOrgOwned: true,
+			wantOrg:    want,
+		},
+		{
+			name:       "user repository",
+			isOrgOwned: false,
+			wantOrg:    nil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			httpClient := &http.Client{
+				Transport: stubTripper{
+					responsePath: "./testdata/valid-workflow-permissions.json",
+				},
+			}
+			handler := &actionsHandler{
+				ghClient: github.NewClient(httpClient),
+			}
+			handler.init(ctx, &repoURL{owner: "ossf-tests", repo: "foo"}, tt.isOrgOwned)
+
+			got, err := handler.getRepoWorkflowPermissions()
+			if err != nil {
+				t.Fatalf("getRepoWorkflowPermissions: %v", err)
+			}
+			if !cmp.Equal(got, want) {
+				t.Errorf("getRepoWorkflowPermissions() = %v", cmp.Diff(got, want))
+			}
+
+			gotOrg, err := handler.getOrgWorkflowPermissions()
+			if tt.wantOrg == nil && !errors.Is(err, clients.ErrUnsupportedFeature) {
+				t.Fatalf("getOrgWorkflowPermissions error: %v, want %v", err, clients.ErrUnsupportedFeature)
+			}
+			if tt.wantOrg != nil && err != nil {
+				t.Fatalf("getOrgWorkflowPermissions: %v", err)
+			}
+			if !cmp.Equal(gotOrg, tt.wantOrg) {
+				t.Errorf("getOrgWorkflowPermissions() = %v", cmp.Diff(gotOrg, tt.wantOrg))
+			}
+		})
+	}
+}
+
+func Test_getWorkflowPermissionsWithoutAdminAccess(t *testing.T) {
+	t.Parallel()
+	handler := &actionsHandler{
+		ghClient: github.NewClient(&http.Client{Transport: routeTripper{
+			"/workflow": {statusCode: http.StatusForbidden},
+		}}),
+	}
+	handler.init(context.Background(), &repoURL{owner: "ossf-tests", repo: "foo"}, true)
+	if _, err := handler.getRepoWorkflowPermissions(); !errors.Is(err, clients.ErrUnsupportedFeature) {
+		t.Errorf("getRepoWorkflowPermissions error: %v, want %v", err, clients.ErrUnsupportedFeature)
+	}
+	if _, err := handler.getOrgWorkflowPermissions(); !errors.Is(err, clients.ErrUnsupportedFeature) {
+		t.Errorf("getOrgWorkflowPermissions error: %v, want %v", err, clients.ErrUnsupportedFeature)
+	}
+}
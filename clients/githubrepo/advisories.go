@@ -0,0 +1,135 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v53/github"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+// privateVulnerabilityReportingResponse is defined at
+// docs.github.com/en/rest/repos/repos#check-if-private-vulnerability-reporting-is-enabled-for-a-repository.
+type privateVulnerabilityReportingResponse struct {
+	Enabled bool `json:"enabled"`
+}
+
+// securityAdvisoryResponse is defined at
+// docs.github.com/en/rest/security-advisories/repository-advisories#list-repository-security-advisories.
+type securityAdvisoryResponse struct {
+	PublishedAt *time.Time `json:"published_at"`
+	GHSAID      string     `json:"ghsa_id"`
+	CVEID       string     `json:"cve_id"`
+	Summary     string     `json:"summary"`
+	Severity    string     `json:"severity"`
+	HTMLURL     string     `json:"html_url"`
+}
+
+type advisoriesHandler struct {
+	ghClient       *github.Client
+	reportingOnce  *sync.Once
+	advisoriesOnce *sync.Once
+	ctx            context.Context
+	errReporting   error
+	errAdvisories  error
+	repourl        *repoURL
+	advisories     []clients.SecurityAdvisory
+	reporting      bool
+}
+
+func (handler *advisoriesHandler) init(ctx context.Context, repourl *repoURL) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.reportingOnce = new(sync.Once)
+	handler.advisoriesOnce = new(sync.Once)
+	handler.errReporting = nil
+	handler.errAdvisories = nil
+	handler.reporting = false
+	handler.advisories = nil
+}
+
+func (handler *advisoriesHandler) isPrivateVulnerabilityReportingEnabled() (bool, error) {
+	handler.reportingOnce.Do(func() {
+		reqURL := path.Join("repos", handler.repourl.owner, handler.repourl.repo, "private-vulnerability-reporting")
+		req, err := handler.ghClient.NewRequest("GET", reqURL, nil)
+		if err != nil {
+			handler.errReporting = fmt.Errorf("request for private vulnerability reporting failed with %w", err)
+			return
+		}
+		bodyJSON := privateVulnerabilityReportingResponse{}
+		resp, err := handler.ghClient.Do(handler.ctx, req, &bodyJSON)
+		if resp != nil {
+			switch resp.StatusCode {
+			// Not available on GitHub Enterprise Server versions predating the feature,
+			// nor to tokens lacking access to the repository settings.
+			case http.StatusForbidden, http.StatusNotFound:
+				handler.errReporting = fmt.Errorf("%w: private vulnerability reporting", clients.ErrUnsupportedFeature)
+				return
+			}
+		}
+		if err != nil {
+			handler.errReporting = fmt.Errorf("response for private vulnerability reporting failed with %w", err)
+			return
+		}
+		handler.reporting = bodyJSON.Enabled
+	})
+	return handler.reporting, handler.errReporting
+}
+
+func (handler *advisoriesHandler) listSecurityAdvisories() ([]clients.SecurityAdvisory, error) {
+	handler.advisoriesOnce.Do(func() {
+		reqURL := path.Join("repos", handler.repourl.owner, handler.repourl.repo, "security-advisories") +
+			"?state=published&per_page=100"
+		req, err := handler.ghClient.NewRequest("GET", reqURL, nil)
+		if err != nil {
+			handler.errAdvisories = fmt.Errorf("request for security advisories failed with %w", err)
+			return
+		}
+		var bodyJSON []securityAdvisoryResponse
+		resp, err := handler.ghClient.Do(handler.ctx, req, &bodyJSON)
+		if resp != nil {
+			switch resp.StatusCode {
+			case http.StatusForbidden, http.StatusNotFound:
+				handler.errAdvisories = fmt.Errorf("%w: security advisories", clients.ErrUnsupportedFeature)
+				return
+			}
+		}
+		if err != nil {
+			handler.errAdvisories = fmt.Errorf("response for security advisories failed with %w", err)
+			return
+		}
+		for i := range bodyJSON {
+			advisory := clients.SecurityAdvisory{
+				ID:       bodyJSON[i].GHSAID,
+				CVEID:    bodyJSON[i].CVEID,
+				Summary:  bodyJSON[i].Summary,
+				Severity: bodyJSON[i].Severity,
+				URL:      bodyJSON[i].HTMLURL,
+			}
+			if bodyJSON[i].PublishedAt != nil {
+				advisory.PublishedAt = *bodyJSON[i].PublishedAt
+			}
+			handler.advisories = append(handler.advisories, advisory)
+		}
+	})
+	return handler.advisories, handler.errAdvisories
+}
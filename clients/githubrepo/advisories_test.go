@@ -0,0 +1,108 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v53/github"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+func newTestAdvisoriesHandler(responsePath string) *advisoriesHandler {
+	httpClient := &http.Client{
+		Transport: stubTripper{
+			responsePath: responsePath,
+		},
+	}
+	handler := &advisoriesHandler{
+		ghClient: github.NewClient(httpClient),
+	}
+	handler.init(context.Background(), &repoURL{owner: "ossf-tests", repo: "foo"})
+	return handler
+}
+
+func Test_isPrivateVulnerabilityReportingEnabled(t *testing.T) {
+	t.Parallel()
+	handler := newTestAdvisoriesHandler("./testdata/valid-private-vulnerability-reporting.json")
+	got, err := handler.isPrivateVulnerabilityReportingEnabled()
+	if err != nil {
+		t.Fatalf("isPrivateVulnerabilityReportingEnabled: %v", err)
+	}
+	if !got {
+		t.Errorf("isPrivateVulnerabilityReportingEnabled() = false, want true")
+	}
+}
+
+func Test_isPrivateVulnerabilityReportingEnabledUnsupported(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		statusCode int
+	}{
+		{
+			name:       "token cannot read the setting",
+			statusCode: http.StatusForbidden,
+		},
+		{
+			name:       "server predates the feature",
+			statusCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			handler := &advisoriesHandler{
+				ghClient: github.NewClient(&http.Client{Transport: routeTripper{
+					"/private-vulnerability-reporting": {statusCode: tt.statusCode},
+				}}),
+			}
+			handler.init(context.Background(), &repoURL{owner: "ossf-tests", repo: "foo"})
+			_, err := handler.isPrivateVulnerabilityReportingEnabled()
+			if !errors.Is(err, clients.ErrUnsupportedFeature) {
+				t.Errorf("isPrivateVulnerabilityReportingEnabled() error = %v, want %v", err, clients.ErrUnsupportedFeature)
+			}
+		})
+	}
+}
+
+func Test_listSecurityAdvisories(t *testing.T) {
+	t.Parallel()
+	handler := newTestAdvisoriesHandler("./testdata/valid-security-advisories.json")
+	got, err := handler.listSecurityAdvisories()
+	if err != nil {
+		t.Fatalf("listSecurityAdvisories: %v", err)
+	}
+	want := []clients.SecurityAdvisory{
+		{
+			PublishedAt: time.Date(2023, 5, 4, 10, 0, 0, 0, time.UTC),
+			ID:          "GHSA-abcd-1234-efgh",
+			CVEID:       "CVE-2023-12345",
+			Summary:     "Path traversal in archive extraction",
+			Severity:    "high",
+			URL:         "https://github.com/ossf-tests/foo/security/advisories/GHSA-abcd-1234-efgh",
+		},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("listSecurityAdvisories() = %v", cmp.Diff(got, want))
+	}
+}
@@ -57,6 +57,11 @@ type Client struct {
 	webhook       *webhookHandler
 	languages     *languagesHandler
 	licenses      *licensesHandler
+	actions       *actionsHandler
+	advisories    *advisoriesHandler
+	security      *securitySettingsHandler
+	environments  *environmentsHandler
+	gitlinks      *gitlinksHandler
 	ctx           context.Context
 	tarball       tarballHandler
 	commitDepth   int
@@ -126,6 +131,21 @@ func (client *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitD
 
 	// Setup licensesHandler.
 	client.licenses.init(client.ctx, client.repourl)
+
+	// Setup actionsHandler.
+	client.actions.init(client.ctx, client.repourl, repo.GetOwner().GetType() == "Organization")
+
+	// Setup advisoriesHandler.
+	client.advisories.init(client.ctx, client.repourl)
+
+	// Setup securitySettingsHandler.
+	client.security.init(client.ctx, client.repourl)
+
+	// Setup environmentsHandler.
+	client.environments.init(client.ctx, client.repourl)
+
+	// Setup gitlinksHandler.
+	client.gitlinks.init(client.ctx, client.repourl)
 	return nil
 }
 
@@ -179,6 +199,25 @@ func (client *Client) IsArchived() (bool, error) {
 	return client.graphClient.isArchived()
 }
 
+// GetArchivedAt implements RepoClient.GetArchivedAt.
+func (client *Client) GetArchivedAt() (*time.Time, error) {
+	return client.graphClient.getArchivedAt()
+}
+
+// ListTopics implements RepoClient.ListTopics.
+func (client *Client) ListTopics() ([]string, error) {
+	return client.repo.Topics, nil
+}
+
+// IsPrivate implements RepoClient.IsPrivate.
+func (client *Client) IsPrivate() (bool, error) {
+	// Internal repositories are only visible to members of the enterprise.
+	if visibility := client.repo.GetVisibility(); visibility != "" {
+		return visibility != "public", nil
+	}
+	return client.repo.GetPrivate(), nil
+}
+
 // GetDefaultBranch implements RepoClient.GetDefaultBranch.
 func (client *Client) GetDefaultBranch() (*clients.BranchRef, error) {
 	return client.branches.getDefaultBranch()
@@ -248,6 +287,56 @@ func (client *Client) ListLicenses() ([]clients.License, error) {
 	return client.licenses.listLicenses()
 }
 
+// GetWorkflowPermissions implements RepoClient.GetWorkflowPermissions.
+// It returns clients.ErrUnsupportedFeature without admin access to the repository.
+func (client *Client) GetWorkflowPermissions() (*clients.WorkflowPermissions, error) {
+	return client.actions.getRepoWorkflowPermissions()
+}
+
+// GetOrgWorkflowPermissions implements RepoClient.GetOrgWorkflowPermissions.
+// It returns clients.ErrUnsupportedFeature without admin access to the organization.
+func (client *Client) GetOrgWorkflowPermissions() (*clients.WorkflowPermissions, error) {
+	return client.actions.getOrgWorkflowPermissions()
+}
+
+// IsPrivateVulnerabilityReportingEnabled implements RepoClient.IsPrivateVulnerabilityReportingEnabled.
+func (client *Client) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	return client.advisories.isPrivateVulnerabilityReportingEnabled()
+}
+
+// ListSecurityAdvisories implements RepoClient.ListSecurityAdvisories.
+func (client *Client) ListSecurityAdvisories() ([]clients.SecurityAdvisory, error) {
+	return client.advisories.listSecurityAdvisories()
+}
+
+// ListCIVariables is not supported for GitHub, whose Actions secrets are not bound to branches.
+func (client *Client) ListCIVariables() ([]clients.CIVariable, error) {
+	return nil, fmt.Errorf("ListCIVariables (GitHub): %w", clients.ErrUnsupportedFeature)
+}
+
+// GetCIJobTokenScope is not supported for GitHub, see GetWorkflowPermissions instead.
+func (client *Client) GetCIJobTokenScope() (*clients.CIJobTokenScope, error) {
+	return nil, fmt.Errorf("GetCIJobTokenScope (GitHub): %w", clients.ErrUnsupportedFeature)
+}
+
+// IsSecuritySettingEnabled implements RepoClient.IsSecuritySettingEnabled.
+// It returns clients.ErrUnsupportedFeature if the token cannot read the setting.
+func (client *Client) IsSecuritySettingEnabled(setting clients.SecuritySetting) (bool, error) {
+	return client.security.isSecuritySettingEnabled(setting)
+}
+
+// ListEnvironments implements RepoClient.ListEnvironments.
+// It returns clients.ErrUnsupportedFeature if the token cannot list them.
+func (client *Client) ListEnvironments() ([]clients.Environment, error) {
+	return client.environments.listEnvironments()
+}
+
+// ListGitlinks implements RepoClient.ListGitlinks.
+// It returns clients.ErrUnsupportedFeature if the tree is too large to be listed.
+func (client *Client) ListGitlinks() (map[string]string, error) {
+	return client.gitlinks.listGitlinks()
+}
+
 // Search implements RepoClient.Search.
 func (client *Client) Search(request clients.SearchRequest) (clients.SearchResponse, error) {
 	return client.search.search(request)
@@ -330,6 +419,21 @@ func CreateGithubRepoClientWithTransport(ctx context.Context, rt http.RoundTripp
 		licenses: &licensesHandler{
 			ghclient: client,
 		},
+		actions: &actionsHandler{
+			ghClient: client,
+		},
+		advisories: &advisoriesHandler{
+			ghClient: client,
+		},
+		security: &securitySettingsHandler{
+			ghClient: client,
+		},
+		environments: &environmentsHandler{
+			ghClient: client,
+		},
+		gitlinks: &gitlinksHandler{
+			ghClient: client,
+		},
 		tarball: tarballHandler{
 			httpClient: httpClient,
 		},
@@ -0,0 +1,107 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/google/go-github/v53/github"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+const (
+	protectionRuleRequiredReviewers = "required_reviewers"
+	protectionRuleWaitTimer         = "wait_timer"
+)
+
+type environmentsHandler struct {
+	ghClient     *github.Client
+	once         *sync.Once
+	ctx          context.Context
+	errSetup     error
+	repourl      *repoURL
+	environments []clients.Environment
+}
+
+func (handler *environmentsHandler) init(ctx context.Context, repourl *repoURL) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.environments = nil
+}
+
+func (handler *environmentsHandler) setup() error {
+	handler.once.Do(func() {
+		opts := &github.EnvironmentListOptions{ListOptions: github.ListOptions{PerPage: 100}}
+		for {
+			envs, resp, err := handler.ghClient.Repositories.ListEnvironments(
+				handler.ctx, handler.repourl.owner, handler.repourl.repo, opts)
+			if resp != nil {
+				switch resp.StatusCode {
+				case http.StatusForbidden, http.StatusNotFound:
+					handler.errSetup = fmt.Errorf("%w: environments", clients.ErrUnsupportedFeature)
+					return
+				}
+			}
+			if err != nil {
+				handler.errSetup = fmt.Errorf("error during ListEnvironments: %w", err)
+				return
+			}
+			for _, env := range envs.Environments {
+				handler.environments = append(handler.environments, environmentFrom(env))
+			}
+			if resp.NextPage == 0 {
+				break
+			}
+			opts.Page = resp.NextPage
+		}
+		if handler.environments == nil {
+			// No environments is different from environments not being listed.
+			handler.environments = []clients.Environment{}
+		}
+	})
+	return handler.errSetup
+}
+
+func environmentFrom(env *github.Environment) clients.Environment {
+	ret := clients.Environment{
+		Name: env.GetName(),
+	}
+	for _, rule := range env.ProtectionRules {
+		switch rule.GetType() {
+		case protectionRuleRequiredReviewers:
+			ret.RequiredReviewers += len(rule.Reviewers)
+		case protectionRuleWaitTimer:
+			ret.WaitTimer = rule.GetWaitTimer()
+		}
+	}
+	if policy := env.DeploymentBranchPolicy; policy != nil {
+		ret.ProtectedBranchesOnly = policy.GetProtectedBranches()
+		ret.CustomBranchPolicies = policy.GetCustomBranchPolicies()
+	}
+	return ret
+}
+
+func (handler *environmentsHandler) listEnvironments() ([]clients.Environment, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during environmentsHandler.setup: %w", err)
+	}
+	return handler.environments, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v53/github"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+func Test_listEnvironments(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		routes  routeTripper
+		want    []clients.Environment
+		name    string
+		wantErr error
+	}{
+		{
+			name: "environments with protection rules",
+			routes: routeTripper{
+				"/environments": {
+					responsePath: "./testdata/valid-environments.json",
+					statusCode:   http.StatusOK,
+				},
+			},
+			want: []clients.Environment{
+				{
+					Name:                 "release",
+					RequiredReviewers:    2,
+					WaitTimer:            30,
+					CustomBranchPolicies: true,
+				},
+				{
+					Name: "staging",
+				},
+			},
+		},
+		{
+			name: "token cannot list environments",
+			routes: routeTripper{
+				"/environments": {statusCode: http.StatusForbidden},
+			},
+			wantErr: clients.ErrUnsupportedFeature,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			handler := &environmentsHandler{
+				ghClient: github.NewClient(&http.Client{Transport: tt.routes}),
+			}
+			handler.init(context.Background(), &repoURL{owner: "ossf-tests", repo: "foo"})
+			got, err := handler.listEnvironments()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("listEnvironments() error = %v, want %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/google/go-github/v53/github"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+// gitlinkEntryType is the type of the tree entries of submodules.
+const gitlinkEntryType = "commit"
+
+type gitlinksHandler struct {
+	ghClient *github.Client
+	once     *sync.Once
+	ctx      context.Context
+	errSetup error
+	repourl  *repoURL
+	gitlinks map[string]string
+}
+
+func (handler *gitlinksHandler) init(ctx context.Context, repourl *repoURL) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.gitlinks = nil
+}
+
+func (handler *gitlinksHandler) setup() error {
+	handler.once.Do(func() {
+		treeSHA := handler.repourl.commitSHA
+		if strings.EqualFold(treeSHA, clients.HeadSHA) {
+			treeSHA = handler.repourl.defaultBranch
+		}
+		tree, resp, err := handler.ghClient.Git.GetTree(
+			handler.ctx, handler.repourl.owner, handler.repourl.repo, treeSHA, true /*recursive*/)
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			// Empty repositories have no tree.
+			handler.gitlinks = map[string]string{}
+			return
+		}
+		if err != nil {
+			handler.errSetup = fmt.Errorf("error during GetTree: %w", err)
+			return
+		}
+		if tree.GetTruncated() {
+			// Gitlinks missing from the truncated tree would be reported as stale.
+			handler.errSetup = fmt.Errorf("%w: truncated tree", clients.ErrUnsupportedFeature)
+			return
+		}
+		handler.gitlinks = map[string]string{}
+		for _, entry := range tree.Entries {
+			if entry.GetType() == gitlinkEntryType {
+				handler.gitlinks[entry.GetPath()] = entry.GetSHA()
+			}
+		}
+	})
+	return handler.errSetup
+}
+
+func (handler *gitlinksHandler) listGitlinks() (map[string]string, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during gitlinksHandler.setup: %w", err)
+	}
+	return handler.gitlinks, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v53/github"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+func Test_listGitlinks(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		routes    routeTripper
+		want      map[string]string
+		name      string
+		commitSHA string
+		wantErr   error
+	}{
+		{
+			name:      "gitlinks of the default branch",
+			commitSHA: clients.HeadSHA,
+			routes: routeTripper{
+				"/git/trees/main": {
+					responsePath: "./testdata/valid-tree.json",
+					statusCode:   http.StatusOK,
+				},
+			},
+			want: map[string]string{
+				"third_party/googletest": "b796f7d44681514f58a683a3a71ff17c94edb0c1",
+				"vendor/lib":             "d0c83d9b5e3f7a1b2c4e6f8091a2b3c4d5e6f708",
+			},
+		},
+		{
+			name:      "gitlinks of a commit",
+			commitSHA: "9fb037999f264ba9a7fc6274d15fa3ae2ab98312",
+			routes: routeTripper{
+				"/git/trees/9fb037999f264ba9a7fc6274d15fa3ae2ab98312": {
+					responsePath: "./testdata/valid-tree.json",
+					statusCode:   http.StatusOK,
+				},
+			},
+			want: map[string]string{
+				"third_party/googletest": "b796f7d44681514f58a683a3a71ff17c94edb0c1",
+				"vendor/lib":             "d0c83d9b5e3f7a1b2c4e6f8091a2b3c4d5e6f708",
+			},
+		},
+		{
+			name:      "empty repository",
+			commitSHA: clients.HeadSHA,
+			routes:    routeTripper{},
+			want:      map[string]string{},
+		},
+		{
+			name:      "truncated tree",
+			commitSHA: clients.HeadSHA,
+			routes: routeTripper{
+				"/git/trees/main": {
+					responsePath: "./testdata/truncated-tree.json",
+					statusCode:   http.StatusOK,
+				},
+			},
+			wantErr: clients.ErrUnsupportedFeature,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			handler := &gitlinksHandler{
+				ghClient: github.NewClient(&http.Client{Transport: tt.routes}),
+			}
+			handler.init(context.Background(), &repoURL{
+				owner:         "ossf-tests",
+				repo:          "foo",
+				defaultBranch: "main",
+				commitSHA:     tt.commitSHA,
+			})
+			got, err := handler.listGitlinks()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("listGitlinks() error = %v, want %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -34,6 +34,7 @@ const (
 	issueCommentsToAnalyze = 30
 	reviewsToAnalyze       = 30
 	labelsToAnalyze        = 30
+	prCommitsToAnalyze     = 30
 
 	// https://docs.github.com/en/graphql/overview/rate-limits-and-node-limits-for-the-graphql-api#node-limit
 	defaultPageLimit = 100
@@ -44,6 +45,7 @@ const (
 type graphqlData struct {
 	Repository struct {
 		IsArchived githubv4.Boolean
+		ArchivedAt *githubv4.DateTime
 		Object     struct {
 			Commit struct {
 				History struct {
@@ -77,20 +79,44 @@ type graphqlData struct {
 								Author struct {
 									Login        githubv4.String
 									ResourcePath githubv4.String
+									User         struct {
+										Email githubv4.String
+									} `graphql:"... on User"`
 								}
 								Number     githubv4.Int
 								HeadRefOid githubv4.String
 								MergedAt   githubv4.DateTime
-								Labels     struct {
+								Commits    struct {
+									Nodes []struct {
+										Commit struct {
+											CommittedDate githubv4.DateTime
+											Author        struct {
+												Email githubv4.String
+												User  struct {
+													Login githubv4.String
+												}
+											}
+										}
+									}
+								} `graphql:"commits(last: $prCommitsToAnalyze)"`
+								Labels struct {
 									Nodes []struct {
 										Name githubv4.String
 									}
 								} `graphql:"labels(last: $labelsToAnalyze)"`
 								Reviews struct {
 									Nodes []struct {
-										State  githubv4.String
+										State       githubv4.String
+										SubmittedAt *githubv4.DateTime
+										Commit      struct {
+											Oid githubv4.GitObjectID
+										}
 										Author struct {
-											Login githubv4.String
+											Login        githubv4.String
+											ResourcePath githubv4.String
+											User         struct {
+												Email githubv4.String
+											} `graphql:"... on User"`
 										}
 									}
 								} `graphql:"reviews(last: $reviewsToAnalyze)"`
@@ -143,6 +169,7 @@ type graphqlHandler struct {
 	repourl     *repoURL
 	commits     []clients.Commit
 	issues      []clients.Issue
+	archivedAt  *time.Time
 	archived    bool
 	commitDepth int
 }
@@ -156,6 +183,7 @@ func (handler *graphqlHandler) init(ctx context.Context, repourl *repoURL, commi
 	handler.commitDepth = commitDepth
 	handler.commits = nil
 	handler.issues = nil
+	handler.archivedAt = nil
 }
 
 func populateCommits(handler *graphqlHandler, vars map[string]interface{}) ([]clients.Commit, error) {
@@ -201,6 +229,7 @@ func (handler *graphqlHandler) setup() error {
 			"issueCommentsToAnalyze": githubv4.Int(issueCommentsToAnalyze),
 			"reviewsToAnalyze":       githubv4.Int(reviewsToAnalyze),
 			"labelsToAnalyze":        githubv4.Int(labelsToAnalyze),
+			"prCommitsToAnalyze":     githubv4.Int(prCommitsToAnalyze),
 			"commitsToAnalyze":       githubv4.Int(handler.commitDepth),
 			"commitExpression":       githubv4.String(commitExpression),
 			"historyCursor":          (*githubv4.String)(nil),
@@ -208,6 +237,9 @@ func (handler *graphqlHandler) setup() error {
 		handler.commits, handler.errSetup = populateCommits(handler, vars)
 		handler.issues = issuesFrom(handler.data)
 		handler.archived = bool(handler.data.Repository.IsArchived)
+		if handler.data.Repository.ArchivedAt != nil {
+			handler.archivedAt = &handler.data.Repository.ArchivedAt.Time
+		}
 	})
 	return handler.errSetup
 }
@@ -239,6 +271,16 @@ func (handler *graphqlHandler) isArchived() (bool, error) {
 	return handler.archived, nil
 }
 
+func (handler *graphqlHandler) getArchivedAt() (*time.Time, error) {
+	if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
+		return nil, fmt.Errorf("%w: GetArchivedAt only supported for HEAD queries", clients.ErrUnsupportedFeature)
+	}
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during graphqlHandler.setup: %w", err)
+	}
+	return handler.archivedAt, nil
+}
+
 func commitsFrom(data *graphqlData, repoOwner, repoName string) ([]clients.Commit, error) {
 	ret := make([]clients.Commit, 0)
 	for _, commit := range data.Repository.Object.Commit.History.Nodes {
@@ -265,16 +307,14 @@ func commitsFrom(data *graphqlData, repoOwner, repoName string) ([]clients.Commi
 				string(pr.Repository.Name) != repoName {
 				continue
 			}
-			// ResourcePath: e.g., for dependabot, "/apps/dependabot", or "/apps/renovate"
-			// Path that can be appended to "https://github.com" for a GitHub resource
-			openedByBot := strings.HasPrefix(string(pr.Author.ResourcePath), "/apps/")
 			associatedPR = clients.PullRequest{
 				Number:   int(pr.Number),
 				HeadSHA:  string(pr.HeadRefOid),
 				MergedAt: pr.MergedAt.Time,
 				Author: clients.User{
 					Login: string(pr.Author.Login),
-					IsBot: openedByBot,
+					IsBot: isBotResourcePath(pr.Author.ResourcePath),
+					Email: string(pr.Author.User.Email),
 				},
 				MergedBy: clients.User{
 					Login: string(pr.MergedBy.Login),
@@ -285,13 +325,28 @@ func commitsFrom(data *graphqlData, repoOwner, repoName string) ([]clients.Commi
 					Name: string(label.Name),
 				})
 			}
+			for _, c := range pr.Commits.Nodes {
+				// Nodes are ordered oldest first, so the last one is the head.
+				associatedPR.HeadCommittedAt = c.Commit.CommittedDate.Time
+				associatedPR.CommitAuthors = append(associatedPR.CommitAuthors, clients.User{
+					Login: string(c.Commit.Author.User.Login),
+					Email: string(c.Commit.Author.Email),
+				})
+			}
 			for _, review := range pr.Reviews.Nodes {
-				associatedPR.Reviews = append(associatedPR.Reviews, clients.Review{
-					State: string(review.State),
+				r := clients.Review{
+					State:     string(review.State),
+					CommitSHA: string(review.Commit.Oid),
 					Author: &clients.User{
 						Login: string(review.Author.Login),
+						IsBot: isBotResourcePath(review.Author.ResourcePath),
+						Email: string(review.Author.User.Email),
 					},
-				})
+				}
+				if review.SubmittedAt != nil {
+					r.SubmittedAt = review.SubmittedAt.Time
+				}
+				associatedPR.Reviews = append(associatedPR.Reviews, r)
 			}
 			break
 		}
@@ -308,6 +363,13 @@ func commitsFrom(data *graphqlData, repoOwner, repoName string) ([]clients.Commi
 	return ret, nil
 }
 
+// isBotResourcePath reports whether an actor is a GitHub App, e.g.,
+// "/apps/dependabot" or "/apps/renovate". ResourcePath is the path that
+// can be appended to "https://github.com" for a GitHub resource.
+func isBotResourcePath(path githubv4.String) bool {
+	return strings.HasPrefix(string(path), "/apps/")
+}
+
 func issuesFrom(data *graphqlData) []clients.Issue {
 	var ret []clients.Issue
 	for _, issue := range data.Repository.Issues.Nodes {
@@ -47,7 +47,12 @@ func (handler *searchCommitsHandler) search(request clients.SearchCommitsOptions
 
 	resp, _, err := handler.ghClient.Search.Commits(handler.ctx,
 		query,
-		&github.SearchOptions{ListOptions: github.ListOptions{PerPage: 100}})
+		&github.SearchOptions{
+			// Most recent commits first.
+			Sort:        "committer-date",
+			Order:       "desc",
+			ListOptions: github.ListOptions{PerPage: 100},
+		})
 	if err != nil {
 		return nil, fmt.Errorf("Search.Code: %w", err)
 	}
@@ -74,7 +79,10 @@ func searchCommitsResponseFrom(resp *github.CommitsSearchResult) []clients.Commi
 	var ret []clients.Commit
 	for _, result := range resp.Commits {
 		ret = append(ret, clients.Commit{
-			Committer: clients.User{ID: *result.Author.ID},
+			SHA:           result.GetSHA(),
+			Message:       result.GetCommit().GetMessage(),
+			CommittedDate: result.GetCommit().GetCommitter().GetDate().Time,
+			Committer:     clients.User{ID: result.GetAuthor().GetID()},
 		})
 	}
 	return ret
@@ -0,0 +1,169 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"sync"
+
+	"github.com/google/go-github/v53/github"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+const statusEnabled = "enabled"
+
+// securityAndAnalysisResponse is the `security_and_analysis` of the repository defined at
+// docs.github.com/en/rest/repos/repos#get-a-repository. It is only returned to admins.
+type securityAndAnalysisResponse struct {
+	SecurityAndAnalysis *struct {
+		SecretScanning               *statusResponse `json:"secret_scanning"`
+		SecretScanningPushProtection *statusResponse `json:"secret_scanning_push_protection"`
+		DependabotSecurityUpdates    *statusResponse `json:"dependabot_security_updates"`
+	} `json:"security_and_analysis"`
+}
+
+type statusResponse struct {
+	Status string `json:"status"`
+}
+
+type securitySettingsHandler struct {
+	ghClient      *github.Client
+	once          *sync.Once
+	ctx           context.Context
+	errSetup      error
+	repourl       *repoURL
+	settings      map[clients.SecuritySetting]bool
+	errorsSetting map[clients.SecuritySetting]error
+}
+
+func (handler *securitySettingsHandler) init(ctx context.Context, repourl *repoURL) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.once = new(sync.Once)
+	handler.errSetup = nil
+	handler.settings = map[clients.SecuritySetting]bool{}
+	handler.errorsSetting = map[clients.SecuritySetting]error{}
+}
+
+func (handler *securitySettingsHandler) setup() error {
+	handler.once.Do(func() {
+		if err := handler.setupSecurityAndAnalysis(); err != nil {
+			handler.errSetup = err
+			return
+		}
+		if err := handler.setupVulnerabilityAlerts(); err != nil {
+			handler.errSetup = err
+			return
+		}
+		handler.errSetup = handler.setupCodeScanning()
+	})
+	return handler.errSetup
+}
+
+func (handler *securitySettingsHandler) setupSecurityAndAnalysis() error {
+	reqURL := path.Join("repos", handler.repourl.owner, handler.repourl.repo)
+	req, err := handler.ghClient.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("request for repository failed with %w", err)
+	}
+	bodyJSON := securityAndAnalysisResponse{}
+	if _, err := handler.ghClient.Do(handler.ctx, req, &bodyJSON); err != nil {
+		return fmt.Errorf("response for repository failed with %w", err)
+	}
+	settings := map[clients.SecuritySetting]*statusResponse{}
+	if s := bodyJSON.SecurityAndAnalysis; s != nil {
+		settings[clients.SecretScanning] = s.SecretScanning
+		settings[clients.SecretPushProtection] = s.SecretScanningPushProtection
+		settings[clients.DependencySecurityUpdates] = s.DependabotSecurityUpdates
+	}
+	for _, setting := range []clients.SecuritySetting{
+		clients.SecretScanning, clients.SecretPushProtection, clients.DependencySecurityUpdates,
+	} {
+		if status := settings[setting]; status != nil {
+			handler.settings[setting] = status.Status == statusEnabled
+		} else {
+			// Not returned without admin access, or without the feature.
+			handler.errorsSetting[setting] = fmt.Errorf("%w: %s", clients.ErrUnsupportedFeature, setting)
+		}
+	}
+	return nil
+}
+
+// setupVulnerabilityAlerts reads whether Dependabot alerts are enabled, defined at
+// docs.github.com/en/rest/repos/repos#check-if-vulnerability-alerts-are-enabled-for-a-repository.
+func (handler *securitySettingsHandler) setupVulnerabilityAlerts() error {
+	// The endpoint returns 404 both when alerts are disabled and when the token
+	// lacks admin access, which `security_and_analysis` tells apart.
+	if _, ok := handler.settings[clients.SecretScanning]; !ok {
+		handler.errorsSetting[clients.DependencyAlerts] = fmt.Errorf("%w: %s",
+			clients.ErrUnsupportedFeature, clients.DependencyAlerts)
+		return nil
+	}
+	reqURL := path.Join("repos", handler.repourl.owner, handler.repourl.repo, "vulnerability-alerts")
+	req, err := handler.ghClient.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("request for vulnerability alerts failed with %w", err)
+	}
+	resp, err := handler.ghClient.Do(handler.ctx, req, nil)
+	switch {
+	case resp != nil && resp.StatusCode == http.StatusNotFound:
+		handler.settings[clients.DependencyAlerts] = false
+	case err != nil:
+		return fmt.Errorf("response for vulnerability alerts failed with %w", err)
+	default:
+		handler.settings[clients.DependencyAlerts] = resp.StatusCode == http.StatusNoContent
+	}
+	return nil
+}
+
+func (handler *securitySettingsHandler) setupCodeScanning() error {
+	config, resp, err := handler.ghClient.CodeScanning.GetDefaultSetupConfiguration(
+		handler.ctx, handler.repourl.owner, handler.repourl.repo)
+	if resp != nil {
+		switch resp.StatusCode {
+		case http.StatusForbidden, http.StatusNotFound:
+			// Missing scope, or code scanning not available for the repository.
+			handler.errorsSetting[clients.CodeScanning] = fmt.Errorf("%w: %s",
+				clients.ErrUnsupportedFeature, clients.CodeScanning)
+			return nil
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("response for code scanning default setup failed with %w", err)
+	}
+	handler.settings[clients.CodeScanning] = config.GetState() == "configured"
+	return nil
+}
+
+var errUnknownSecuritySetting = errors.New("unknown security setting")
+
+func (handler *securitySettingsHandler) isSecuritySettingEnabled(setting clients.SecuritySetting) (bool, error) {
+	if err := handler.setup(); err != nil {
+		return false, fmt.Errorf("error during securitySettingsHandler.setup: %w", err)
+	}
+	if err, ok := handler.errorsSetting[setting]; ok {
+		return false, err
+	}
+	enabled, ok := handler.settings[setting]
+	if !ok {
+		return false, fmt.Errorf("%w: %s", errUnknownSecuritySetting, setting)
+	}
+	return enabled, nil
+}
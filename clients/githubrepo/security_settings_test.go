@@ -0,0 +1,152 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v53/github"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+type stubResponse struct {
+	responsePath string
+	statusCode   int
+}
+
+// routeTripper responds to requests whose path ends with one of its routes.
+type routeTripper map[string]stubResponse
+
+func (r routeTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	for route, resp := range r {
+		if !strings.HasSuffix(req.URL.Path, route) {
+			continue
+		}
+		body := io.NopCloser(strings.NewReader(""))
+		if resp.responsePath != "" {
+			f, err := os.Open(resp.responsePath)
+			if err != nil {
+				return nil, err
+			}
+			body = f
+		}
+		return &http.Response{
+			StatusCode: resp.statusCode,
+			Body:       body,
+			Request:    req,
+		}, nil
+	}
+	return &http.Response{
+		StatusCode: http.StatusNotFound,
+		Body:       io.NopCloser(strings.NewReader("")),
+		Request:    req,
+	}, nil
+}
+
+func Test_isSecuritySettingEnabled(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		routes      routeTripper
+		want        map[clients.SecuritySetting]bool
+		name        string
+		unsupported []clients.SecuritySetting
+	}{
+		{
+			name: "admin access",
+			routes: routeTripper{
+				"/repos/ossf-tests/foo": {
+					responsePath: "./testdata/valid-security-and-analysis.json",
+					statusCode:   http.StatusOK,
+				},
+				"/vulnerability-alerts": {statusCode: http.StatusNoContent},
+				"/code-scanning/default-setup": {
+					responsePath: "./testdata/valid-code-scanning-default-setup.json",
+					statusCode:   http.StatusOK,
+				},
+			},
+			want: map[clients.SecuritySetting]bool{
+				clients.SecretScanning:            true,
+				clients.SecretPushProtection:      false,
+				clients.DependencyAlerts:          true,
+				clients.DependencySecurityUpdates: true,
+				clients.CodeScanning:              true,
+			},
+		},
+		{
+			name: "admin access, alerts disabled",
+			routes: routeTripper{
+				"/repos/ossf-tests/foo": {
+					responsePath: "./testdata/valid-security-and-analysis.json",
+					statusCode:   http.StatusOK,
+				},
+				"/code-scanning/default-setup": {statusCode: http.StatusForbidden},
+			},
+			want: map[clients.SecuritySetting]bool{
+				clients.SecretScanning:            true,
+				clients.SecretPushProtection:      false,
+				clients.DependencyAlerts:          false,
+				clients.DependencySecurityUpdates: true,
+			},
+			unsupported: []clients.SecuritySetting{clients.CodeScanning},
+		},
+		{
+			name: "no admin access",
+			routes: routeTripper{
+				"/repos/ossf-tests/foo": {
+					responsePath: "./testdata/valid-repository-no-admin.json",
+					statusCode:   http.StatusOK,
+				},
+			},
+			unsupported: []clients.SecuritySetting{
+				clients.SecretScanning,
+				clients.SecretPushProtection,
+				clients.DependencyAlerts,
+				clients.DependencySecurityUpdates,
+				clients.CodeScanning,
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			handler := &securitySettingsHandler{
+				ghClient: github.NewClient(&http.Client{Transport: tt.routes}),
+			}
+			handler.init(context.Background(), &repoURL{owner: "ossf-tests", repo: "foo"})
+			for setting, want := range tt.want {
+				got, err := handler.isSecuritySettingEnabled(setting)
+				if err != nil {
+					t.Fatalf("isSecuritySettingEnabled(%s): %v", setting, err)
+				}
+				if got != want {
+					t.Errorf("isSecuritySettingEnabled(%s) = %t, want %t", setting, got, want)
+				}
+			}
+			for _, setting := range tt.unsupported {
+				if _, err := handler.isSecuritySettingEnabled(setting); !errors.Is(err, clients.ErrUnsupportedFeature) {
+					t.Errorf("isSecuritySettingEnabled(%s) error = %v, want %v", setting, err, clients.ErrUnsupportedFeature)
+				}
+			}
+		})
+	}
+}
@@ -59,6 +59,8 @@ func (handler *webhookHandler) setup() error {
 			repoHook := clients.Webhook{
 				ID:             hook.GetID(),
 				UsesAuthSecret: getAuthSecret(hook.Config),
+				VerifiesTLS:    getVerifiesTLS(hook.Config),
+				Events:         hook.Events,
 			}
 			handler.webhook = append(handler.webhook, repoHook)
 		}
@@ -77,6 +79,18 @@ func getAuthSecret(config map[string]interface{}) bool {
 	return false
 }
 
+// getVerifiesTLS returns false if TLS verification is disabled with `insecure_ssl`,
+// which is "0" or "1" in the API responses.
+func getVerifiesTLS(config map[string]interface{}) bool {
+	switch val := config["insecure_ssl"].(type) {
+	case string:
+		return val != "1"
+	case float64:
+		return val != 1
+	}
+	return true
+}
+
 func (handler *webhookHandler) listWebhooks() ([]clients.Webhook, error) {
 	if err := handler.setup(); err != nil {
 		return nil, fmt.Errorf("error during webhookHandler.setup: %w", err)
@@ -57,6 +57,8 @@ func Test_listWebhooks(t *testing.T) {
 				{
 					ID:             12345678,
 					UsesAuthSecret: false,
+					VerifiesTLS:    false,
+					Events:         []string{"push"},
 				},
 			},
 			wantErr: false,
@@ -0,0 +1,117 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlabrepo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+type cicdHandler struct {
+	glClient         *gitlab.Client
+	variablesOnce    *sync.Once
+	jobTokenOnce     *sync.Once
+	errVariables     error
+	errJobToken      error
+	repourl          *repoURL
+	jobTokenScope    *clients.CIJobTokenScope
+	projectVariables []clients.CIVariable
+}
+
+func (handler *cicdHandler) init(repourl *repoURL) {
+	handler.repourl = repourl
+	handler.errVariables = nil
+	handler.errJobToken = nil
+	handler.projectVariables = nil
+	handler.jobTokenScope = nil
+	handler.variablesOnce = new(sync.Once)
+	handler.jobTokenOnce = new(sync.Once)
+}
+
+func (handler *cicdHandler) setupVariables() error {
+	handler.variablesOnce.Do(func() {
+		opts := &gitlab.ListProjectVariablesOptions{PerPage: 100}
+		for {
+			variables, resp, err := handler.glClient.ProjectVariables.ListVariables(handler.repourl.projectID, opts)
+			if err != nil {
+				handler.errVariables = fmt.Errorf("request for project variables failed with %w", accessError(err))
+				return
+			}
+			for _, v := range variables {
+				handler.projectVariables = append(handler.projectVariables, clients.CIVariable{
+					Key:              v.Key,
+					EnvironmentScope: v.EnvironmentScope,
+					Protected:        v.Protected,
+					Masked:           v.Masked,
+				})
+			}
+			if resp == nil || resp.NextPage == 0 {
+				return
+			}
+			opts.Page = resp.NextPage
+		}
+	})
+
+	return handler.errVariables
+}
+
+func (handler *cicdHandler) setupJobTokenScope() error {
+	handler.jobTokenOnce.Do(func() {
+		settings, _, err := handler.glClient.JobTokenScope.GetProjectJobTokenAccessSettings(handler.repourl.projectID)
+		if err != nil {
+			handler.errJobToken = fmt.Errorf("request for job token access settings failed with %w", accessError(err))
+			return
+		}
+		handler.jobTokenScope = &clients.CIJobTokenScope{
+			InboundRestricted:  settings.InboundEnabled,
+			OutboundRestricted: settings.OutboundEnabled,
+		}
+	})
+
+	return handler.errJobToken
+}
+
+func (handler *cicdHandler) listVariables() ([]clients.CIVariable, error) {
+	if err := handler.setupVariables(); err != nil {
+		return nil, fmt.Errorf("error during cicdHandler.setupVariables: %w", err)
+	}
+
+	return handler.projectVariables, nil
+}
+
+func (handler *cicdHandler) getJobTokenScope() (*clients.CIJobTokenScope, error) {
+	if err := handler.setupJobTokenScope(); err != nil {
+		return nil, fmt.Errorf("error during cicdHandler.setupJobTokenScope: %w", err)
+	}
+
+	return handler.jobTokenScope, nil
+}
+
+// accessError reports the settings that the token is not allowed to read,
+// which require the Maintainer role, as unsupported.
+func accessError(err error) error {
+	var errResp *gitlab.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil &&
+		(errResp.Response.StatusCode == http.StatusUnauthorized || errResp.Response.StatusCode == http.StatusForbidden) {
+		return fmt.Errorf("%w: %w", clients.ErrUnsupportedFeature, err)
+	}
+	return err
+}
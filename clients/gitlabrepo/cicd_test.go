@@ -0,0 +1,131 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlabrepo
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+func newTestCICDHandler(t *testing.T, responsePath string) *cicdHandler {
+	t.Helper()
+	httpClient := &http.Client{
+		Transport: stubTripper{
+			responsePath: responsePath,
+		},
+	}
+	client, err := gitlab.NewClient("", gitlab.WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("gitlab.NewClient error: %v", err)
+	}
+	handler := &cicdHandler{
+		glClient: client,
+	}
+	handler.init(&repoURL{
+		owner:     "ossf-tests",
+		projectID: "1",
+		commitSHA: clients.HeadSHA,
+	})
+	return handler
+}
+
+func Test_listVariables(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		responsePath string
+		want         []clients.CIVariable
+		wantErr      bool
+	}{
+		{
+			name:         "valid variables",
+			responsePath: "./testdata/valid-variables",
+			want: []clients.CIVariable{
+				{
+					Key:              "DEPLOY_TOKEN",
+					EnvironmentScope: "production",
+					Protected:        true,
+					Masked:           true,
+				},
+				{
+					Key:              "NPM_TOKEN",
+					EnvironmentScope: "*",
+					Masked:           true,
+				},
+			},
+		},
+		{
+			name:         "failure fetching variables",
+			responsePath: "./testdata/invalid-variables",
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			handler := newTestCICDHandler(t, tt.responsePath)
+			got, err := handler.listVariables()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("listVariables error: %v, wantedErr: %t", err, tt.wantErr)
+			}
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("listVariables() = %v, want %v", got, cmp.Diff(got, tt.want))
+			}
+		})
+	}
+}
+
+func Test_getJobTokenScope(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		want         *clients.CIJobTokenScope
+		name         string
+		responsePath string
+		wantErr      bool
+	}{
+		{
+			name:         "valid job token scope",
+			responsePath: "./testdata/valid-job-token-scope",
+			want: &clients.CIJobTokenScope{
+				InboundRestricted: true,
+			},
+		},
+		{
+			name:         "failure fetching job token scope",
+			responsePath: "./testdata/invalid-job-token-scope",
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			handler := newTestCICDHandler(t, tt.responsePath)
+			got, err := handler.getJobTokenScope()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getJobTokenScope error: %v, wantedErr: %t", err, tt.wantErr)
+			}
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("getJobTokenScope() = %v, want %v", got, cmp.Diff(got, tt.want))
+			}
+		})
+	}
+}
@@ -55,6 +55,8 @@ type Client struct {
 	licenses      *licensesHandler
 	tarball       *tarballHandler
 	graphql       *graphqlHandler
+	cicd          *cicdHandler
+	security      *securitySettingsHandler
 	ctx           context.Context
 	commitDepth   int
 }
@@ -159,6 +161,12 @@ func (client *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitD
 	// Init graphqlHandler
 	client.graphql.init(client.ctx, client.repourl)
 
+	// Init cicdHandler
+	client.cicd.init(client.repourl)
+
+	// Init securitySettingsHandler
+	client.security.init(client.repourl)
+
 	return nil
 }
 
@@ -220,6 +228,20 @@ func (client *Client) IsArchived() (bool, error) {
 	return client.project.isArchived()
 }
 
+// GetArchivedAt is not supported for GitLab, which does not record when a project was archived.
+func (client *Client) GetArchivedAt() (*time.Time, error) {
+	return nil, fmt.Errorf("GetArchivedAt (GitLab): %w", clients.ErrUnsupportedFeature)
+}
+
+// ListTopics implements RepoClient.ListTopics.
+func (client *Client) ListTopics() ([]string, error) {
+	return client.project.listTopics()
+}
+
+func (client *Client) IsPrivate() (bool, error) {
+	return client.project.isPrivate()
+}
+
 func (client *Client) GetDefaultBranch() (*clients.BranchRef, error) {
 	return client.branches.getDefaultBranch()
 }
@@ -265,6 +287,55 @@ func (client *Client) ListLicenses() ([]clients.License, error) {
 	return client.licenses.listLicenses()
 }
 
+// ListCIVariables implements RepoClient.ListCIVariables.
+// It returns clients.ErrUnsupportedFeature without maintainer access to the project.
+func (client *Client) ListCIVariables() ([]clients.CIVariable, error) {
+	return client.cicd.listVariables()
+}
+
+// GetCIJobTokenScope implements RepoClient.GetCIJobTokenScope.
+func (client *Client) GetCIJobTokenScope() (*clients.CIJobTokenScope, error) {
+	return client.cicd.getJobTokenScope()
+}
+
+// IsPrivateVulnerabilityReportingEnabled implements RepoClient.IsPrivateVulnerabilityReportingEnabled
+// by looking at whether users can open confidential issues.
+func (client *Client) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	return client.project.isConfidentialIssueReportingEnabled()
+}
+
+// IsSecuritySettingEnabled implements RepoClient.IsSecuritySettingEnabled by looking at
+// whether the security scanner providing the setting is enabled.
+// It returns clients.ErrUnsupportedFeature if the token cannot read the project's security configuration.
+func (client *Client) IsSecuritySettingEnabled(setting clients.SecuritySetting) (bool, error) {
+	return client.security.isSecuritySettingEnabled(setting)
+}
+
+// ListEnvironments is not yet supported for GitLab.
+func (client *Client) ListEnvironments() ([]clients.Environment, error) {
+	return nil, fmt.Errorf("ListEnvironments (GitLab): %w", clients.ErrUnsupportedFeature)
+}
+
+// ListGitlinks is not yet supported for GitLab.
+func (client *Client) ListGitlinks() (map[string]string, error) {
+	return nil, fmt.Errorf("ListGitlinks (GitLab): %w", clients.ErrUnsupportedFeature)
+}
+
+// ListSecurityAdvisories is not supported for GitLab, which has no per-project advisory database.
+func (client *Client) ListSecurityAdvisories() ([]clients.SecurityAdvisory, error) {
+	return nil, fmt.Errorf("ListSecurityAdvisories (GitLab): %w", clients.ErrUnsupportedFeature)
+}
+
+// GetWorkflowPermissions is not supported for GitLab, see GetCIJobTokenScope instead.
+func (client *Client) GetWorkflowPermissions() (*clients.WorkflowPermissions, error) {
+	return nil, fmt.Errorf("GetWorkflowPermissions (GitLab): %w", clients.ErrUnsupportedFeature)
+}
+
+// GetOrgWorkflowPermissions is not supported for GitLab, see GetCIJobTokenScope instead.
+func (client *Client) GetOrgWorkflowPermissions() (*clients.WorkflowPermissions, error) {
+	return nil, fmt.Errorf("GetOrgWorkflowPermissions (GitLab): %w", clients.ErrUnsupportedFeature)
+}
+
 func (client *Client) Search(request clients.SearchRequest) (clients.SearchResponse, error) {
 	return client.search.search(request)
 }
@@ -287,6 +358,7 @@ func CreateGitlabClientWithToken(ctx context.Context, token, host string) (clien
 	if err != nil {
 		return nil, fmt.Errorf("could not create gitlab client with error: %w", err)
 	}
+	graphql := &graphqlHandler{}
 
 	return &Client{
 		ctx:      ctx,
@@ -330,9 +402,15 @@ func CreateGitlabClientWithToken(ctx context.Context, token, host string) (clien
 		languages: &languagesHandler{
 			glClient: client,
 		},
+		cicd: &cicdHandler{
+			glClient: client,
+		},
 		licenses: &licensesHandler{},
 		tarball:  &tarballHandler{},
-		graphql:  &graphqlHandler{},
+		graphql:  graphql,
+		security: &securitySettingsHandler{
+			graphql: graphql,
+		},
 	}, nil
 }
 
@@ -17,6 +17,7 @@ package gitlabrepo
 import (
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -115,45 +116,35 @@ func (handler *commitsHandler) zip(commitsRaw []*gitlab.Commit, data graphqlData
 	iidToMr := make(map[string]clients.PullRequest)
 	for i := range data.Project.MergeRequests.Nodes {
 		mr := data.Project.MergeRequests.Nodes[i]
+		if fmt.Sprintf("%v", mr.IID) != mr.IID {
+			continue
+		}
+
 		// Two GitLab APIs for reviews (reviews vs. approvals)
 		// Use a map to consolidate results from both APIs by the user ID who performed review
 		reviews := make(map[string]clients.Review)
+
+		// Check reviewers (sometimes unofficial approvals end up here)
 		for _, reviewer := range mr.Reviewers.Nodes {
 			reviews[reviewer.Username] = clients.Review{
-				Author: &clients.User{Login: reviewer.Username},
-				State:  "COMMENTED",
+				Author: &clients.User{Login: reviewer.Username, ID: int64(reviewer.ID.ID)},
+				State:  reviewState(reviewer.MergeRequestInteraction.ReviewState),
 			}
 		}
 
-		if fmt.Sprintf("%v", mr.IID) != mr.IID {
-			continue
-		}
-
-		// Check approvers
+		// Approvals take precedence over the review state of the same user.
 		for _, approver := range mr.Approvers.Nodes {
 			reviews[approver.Username] = clients.Review{
-				Author: &clients.User{Login: approver.Username},
+				Author: &clients.User{Login: approver.Username, ID: int64(approver.ID.ID)},
 				State:  "APPROVED",
 			}
-			break
-		}
-
-		// Check reviewers (sometimes unofficial approvals end up here)
-		for _, reviewer := range mr.Reviewers.Nodes {
-			if reviewer.MergeRequestInteraction.ReviewState != "REVIEWED" {
-				continue
-			}
-			reviews[reviewer.Username] = clients.Review{
-				Author: &clients.User{Login: reviewer.Username},
-				State:  "APPROVED",
-			}
-			break
 		}
 
 		vals := []clients.Review{}
 		for _, v := range reviews {
 			vals = append(vals, v)
 		}
+		sort.Slice(vals, func(i, j int) bool { return vals[i].Author.Login < vals[j].Author.Login })
 
 		var mrno int
 		mrno, err := strconv.Atoi(mr.IID)
@@ -190,6 +181,20 @@ func (handler *commitsHandler) zip(commitsRaw []*gitlab.Commit, data graphqlData
 	return commits
 }
 
+// reviewState converts the state of a GitLab reviewer to the state of a GitHub review.
+func reviewState(state string) string {
+	switch state {
+	// A reviewer who finished their review without requesting changes
+	// has not blocked the merge request.
+	case "APPROVED", "REVIEWED":
+		return "APPROVED"
+	case "REQUESTED_CHANGES":
+		return "CHANGES_REQUESTED"
+	default:
+		return "COMMENTED"
+	}
+}
+
 // Expected email form: <firstname>.<lastname>@<namespace>.com.
 func parseEmailToName(email string) string {
 	if strings.Contains(email, ".") {
@@ -16,9 +16,13 @@ package gitlabrepo
 
 import (
 	"net/http"
+	"net/http/httptest"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/shurcooL/graphql"
 	"github.com/xanzy/go-gitlab"
 
 	"github.com/ossf/scorecard/v4/clients"
@@ -137,3 +141,61 @@ func TestListRawCommits(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeRequestReviews(t *testing.T) {
+	t.Parallel()
+	content, err := os.ReadFile("./testdata/valid-merge-requests")
+	if err != nil {
+		t.Fatalf("os.ReadFile: %v", err)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(content) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	graph := &graphqlHandler{
+		graphClient: graphql.NewClient(srv.URL, srv.Client()),
+		repourl: &repoURL{
+			owner:   "ossf-tests",
+			project: "scorecard",
+		},
+	}
+	data, err := graph.getMergeRequestsDetail(nil)
+	if err != nil {
+		t.Fatalf("getMergeRequestsDetail: %v", err)
+	}
+
+	committedDate := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	handler := &commitsHandler{}
+	commits := handler.zip([]*gitlab.Commit{
+		{ID: "c1", CommittedDate: &committedDate},
+		{ID: "c2", CommittedDate: &committedDate},
+	}, data)
+
+	want := []clients.Commit{
+		{
+			CommittedDate: committedDate,
+			SHA:           "c1",
+			AssociatedMergeRequest: clients.PullRequest{
+				Number:   12,
+				MergedAt: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+				HeadSHA:  "m1",
+				Author:   clients.User{Login: "alice", ID: 1},
+				MergedBy: clients.User{Login: "alice", ID: 1},
+				Reviews: []clients.Review{
+					{Author: &clients.User{Login: "bob", ID: 2}, State: "CHANGES_REQUESTED"},
+					{Author: &clients.User{Login: "carol", ID: 3}, State: "APPROVED"},
+					{Author: &clients.User{Login: "dave", ID: 4}, State: "APPROVED"},
+				},
+			},
+		},
+		{
+			CommittedDate: committedDate,
+			SHA:           "c2",
+		},
+	}
+	if diff := cmp.Diff(want, commits); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -23,12 +23,15 @@ import (
 )
 
 type projectHandler struct {
-	glClient  *gitlab.Client
-	once      *sync.Once
-	errSetup  error
-	repourl   *repoURL
-	createdAt time.Time
-	archived  bool
+	glClient          *gitlab.Client
+	once              *sync.Once
+	errSetup          error
+	repourl           *repoURL
+	createdAt         time.Time
+	visibility        gitlab.VisibilityValue
+	issuesAccessLevel gitlab.AccessControlValue
+	topics            []string
+	archived          bool
 }
 
 func (handler *projectHandler) init(repourl *repoURL) {
@@ -47,6 +50,9 @@ func (handler *projectHandler) setup() error {
 
 		handler.createdAt = *proj.CreatedAt
 		handler.archived = proj.Archived
+		handler.visibility = proj.Visibility
+		handler.issuesAccessLevel = proj.IssuesAccessLevel
+		handler.topics = proj.Topics
 	})
 
 	return handler.errSetup
@@ -60,6 +66,14 @@ func (handler *projectHandler) isArchived() (bool, error) {
 	return handler.archived, nil
 }
 
+func (handler *projectHandler) isPrivate() (bool, error) {
+	if err := handler.setup(); err != nil {
+		return true, fmt.Errorf("error during projectHandler.setup: %w", err)
+	}
+
+	return handler.visibility != gitlab.PublicVisibility, nil
+}
+
 func (handler *projectHandler) getCreatedAt() (time.Time, error) {
 	if err := handler.setup(); err != nil {
 		return time.Now(), fmt.Errorf("error during projectHandler.setup: %w", err)
@@ -67,3 +81,21 @@ func (handler *projectHandler) getCreatedAt() (time.Time, error) {
 
 	return handler.createdAt, nil
 }
+
+// isConfidentialIssueReportingEnabled returns whether anyone can open a confidential issue
+// in the project, which is the way GitLab recommends to privately report vulnerabilities.
+func (handler *projectHandler) isConfidentialIssueReportingEnabled() (bool, error) {
+	if err := handler.setup(); err != nil {
+		return false, fmt.Errorf("error during projectHandler.setup: %w", err)
+	}
+
+	return handler.issuesAccessLevel == gitlab.EnabledAccessControl, nil
+}
+
+func (handler *projectHandler) listTopics() ([]string, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during projectHandler.setup: %w", err)
+	}
+
+	return handler.topics, nil
+}
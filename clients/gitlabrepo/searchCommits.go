@@ -60,9 +60,15 @@ func (handler *searchCommitsHandler) search(request clients.SearchCommitsOptions
 			userMap[commit.CommitterEmail] = user[0]
 		}
 
-		ret = append(ret, clients.Commit{
+		c := clients.Commit{
+			SHA:       commit.ID,
+			Message:   commit.Message,
 			Committer: clients.User{ID: int64(userMap[commit.CommitterEmail].ID)},
-		})
+		}
+		if commit.CommittedDate != nil {
+			c.CommittedDate = *commit.CommittedDate
+		}
+		ret = append(ret, c)
 	}
 
 	return ret, nil
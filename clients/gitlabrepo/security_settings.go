@@ -0,0 +1,104 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlabrepo
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+// securityScannersData is the `securityScanners` of the project, which is
+// null when the token cannot read the project's security configuration.
+type securityScannersData struct {
+	Project *struct {
+		SecurityScanners *securityScanners `graphql:"securityScanners"`
+	} `graphql:"project(fullPath: $fullPath)"`
+}
+
+type securityScanners struct {
+	Enabled   []string `graphql:"enabled"`
+	Available []string `graphql:"available"`
+}
+
+// securityScannerSettings maps GitLab security scanners to the security settings they provide.
+var securityScannerSettings = map[string]clients.SecuritySetting{
+	"SECRET_DETECTION":    clients.SecretScanning,
+	"DEPENDENCY_SCANNING": clients.DependencyAlerts,
+	"SAST":                clients.CodeScanning,
+}
+
+type securitySettingsHandler struct {
+	graphql  *graphqlHandler
+	once     *sync.Once
+	errSetup error
+	repourl  *repoURL
+	scanners *securityScanners
+}
+
+func (handler *securitySettingsHandler) init(repourl *repoURL) {
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.scanners = nil
+}
+
+func (handler *securitySettingsHandler) setup() error {
+	handler.once.Do(func() {
+		data := securityScannersData{}
+		params := map[string]interface{}{
+			"fullPath": fmt.Sprintf("%s/%s", handler.repourl.owner, handler.repourl.project),
+		}
+		if err := handler.graphql.graphClient.Query(handler.graphql.ctx, &data, params); err != nil {
+			handler.errSetup = fmt.Errorf("couldn't query gitlab graphql for security scanners: %w", err)
+			return
+		}
+		if data.Project != nil {
+			handler.scanners = data.Project.SecurityScanners
+		}
+	})
+	return handler.errSetup
+}
+
+func (handler *securitySettingsHandler) isSecuritySettingEnabled(setting clients.SecuritySetting) (bool, error) {
+	if err := handler.setup(); err != nil {
+		return false, fmt.Errorf("error during securitySettingsHandler.setup: %w", err)
+	}
+	return scannerSettingEnabled(handler.scanners, setting)
+}
+
+// scannerSettingEnabled returns whether the security setting is enabled by one of the scanners.
+// Settings without a GitLab scanner, or scanners unavailable to the project, are unsupported.
+func scannerSettingEnabled(scanners *securityScanners, setting clients.SecuritySetting) (bool, error) {
+	if scanners == nil {
+		return false, fmt.Errorf("%w: security scanners (GitLab)", clients.ErrUnsupportedFeature)
+	}
+	available := false
+	for _, scanner := range scanners.Available {
+		if securityScannerSettings[scanner] == setting {
+			available = true
+		}
+	}
+	if !available {
+		return false, fmt.Errorf("%w: %s (GitLab)", clients.ErrUnsupportedFeature, setting)
+	}
+	for _, scanner := range scanners.Enabled {
+		if securityScannerSettings[scanner] == setting {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlabrepo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+func Test_scannerSettingEnabled(t *testing.T) {
+	t.Parallel()
+	scanners := &securityScanners{
+		Enabled:   []string{"SAST", "SECRET_DETECTION"},
+		Available: []string{"SAST", "SECRET_DETECTION", "DEPENDENCY_SCANNING", "DAST"},
+	}
+	tests := []struct {
+		scanners *securityScanners
+		name     string
+		setting  clients.SecuritySetting
+		want     bool
+		wantErr  error
+	}{
+		{
+			name:     "secret detection enabled",
+			scanners: scanners,
+			setting:  clients.SecretScanning,
+			want:     true,
+		},
+		{
+			name:     "sast enabled",
+			scanners: scanners,
+			setting:  clients.CodeScanning,
+			want:     true,
+		},
+		{
+			name:     "dependency scanning available but disabled",
+			scanners: scanners,
+			setting:  clients.DependencyAlerts,
+			want:     false,
+		},
+		{
+			name:     "no gitlab scanner",
+			scanners: scanners,
+			setting:  clients.SecretPushProtection,
+			wantErr:  clients.ErrUnsupportedFeature,
+		},
+		{
+			name: "scanner unavailable",
+			scanners: &securityScanners{
+				Available: []string{"SAST"},
+			},
+			setting: clients.SecretScanning,
+			wantErr: clients.ErrUnsupportedFeature,
+		},
+		{
+			name:    "no access to security configuration",
+			setting: clients.SecretScanning,
+			wantErr: clients.ErrUnsupportedFeature,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := scannerSettingEnabled(tt.scanners, tt.setting)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("scannerSettingEnabled() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("scannerSettingEnabled() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -46,13 +46,15 @@ func (handler *webhookHandler) setup() error {
 			return
 		}
 
-		// TODO: make sure that enablesslverification is similarly equivalent to auth secret.
 		for _, hook := range projectHooks {
 			handler.webhooks = append(handler.webhooks,
 				clients.Webhook{
-					Path:           hook.URL,
-					ID:             int64(hook.ID),
-					UsesAuthSecret: hook.EnableSSLVerification,
+					Path: hook.URL,
+					ID:   int64(hook.ID),
+					// The API never returns the secret token, nor whether one is set.
+					AuthSecretUnknown: true,
+					VerifiesTLS:       hook.EnableSSLVerification,
+					Events:            projectHookEvents(hook),
 				})
 		}
 	})
@@ -60,6 +62,35 @@ func (handler *webhookHandler) setup() error {
 	return handler.errSetup
 }
 
+// projectHookEvents returns the events of a project hook,
+// named after the `*_events` attributes of the API.
+func projectHookEvents(hook *gitlab.ProjectHook) []string {
+	events := []struct {
+		name    string
+		enabled bool
+	}{
+		{"push", hook.PushEvents},
+		{"tag_push", hook.TagPushEvents},
+		{"issues", hook.IssuesEvents},
+		{"confidential_issues", hook.ConfidentialIssuesEvents},
+		{"merge_requests", hook.MergeRequestsEvents},
+		{"note", hook.NoteEvents},
+		{"confidential_note", hook.ConfidentialNoteEvents},
+		{"job", hook.JobEvents},
+		{"pipeline", hook.PipelineEvents},
+		{"wiki_page", hook.WikiPageEvents},
+		{"deployment", hook.DeploymentEvents},
+		{"releases", hook.ReleasesEvents},
+	}
+	ret := []string{}
+	for _, e := range events {
+		if e.enabled {
+			ret = append(ret, e.name)
+		}
+	}
+	return ret
+}
+
 func (handler *webhookHandler) listWebhooks() ([]clients.Webhook, error) {
 	if err := handler.setup(); err != nil {
 		return nil, fmt.Errorf("error during webhookHandler.setup: %w", err)
@@ -54,9 +54,14 @@ func Test_listWebhooks(t *testing.T) {
 			responsePath: "./testdata/valid-webhook",
 			want: []clients.Webhook{
 				{
-					ID:             1,
-					Path:           "http://example.com/hook",
-					UsesAuthSecret: true,
+					ID:                1,
+					Path:              "http://example.com/hook",
+					AuthSecretUnknown: true,
+					VerifiesTLS:       true,
+					Events: []string{
+						"push", "tag_push", "issues", "confidential_issues", "merge_requests", "note",
+						"confidential_note", "job", "pipeline", "wiki_page", "deployment", "releases",
+					},
 				},
 			},
 			wantErr: false,
@@ -75,6 +75,11 @@ func (client *localDirClient) IsArchived() (bool, error) {
 	return false, fmt.Errorf("IsArchived: %w", clients.ErrUnsupportedFeature)
 }
 
+// IsPrivate implements RepoClient.IsPrivate.
+func (client *localDirClient) IsPrivate() (bool, error) {
+	return false, fmt.Errorf("IsPrivate: %w", clients.ErrUnsupportedFeature)
+}
+
 func isDir(p string) (bool, error) {
 	fileInfo, err := os.Stat(p)
 	if err != nil {
@@ -256,6 +261,61 @@ func (client *localDirClient) ListLicenses() ([]clients.License, error) {
 	return nil, fmt.Errorf("ListLicenses: %w", clients.ErrUnsupportedFeature)
 }
 
+// GetArchivedAt implements RepoClient.GetArchivedAt.
+func (client *localDirClient) GetArchivedAt() (*time.Time, error) {
+	return nil, fmt.Errorf("GetArchivedAt: %w", clients.ErrUnsupportedFeature)
+}
+
+// IsSecuritySettingEnabled implements RepoClient.IsSecuritySettingEnabled.
+func (client *localDirClient) IsSecuritySettingEnabled(setting clients.SecuritySetting) (bool, error) {
+	return false, fmt.Errorf("IsSecuritySettingEnabled: %w", clients.ErrUnsupportedFeature)
+}
+
+// ListEnvironments implements RepoClient.ListEnvironments.
+func (client *localDirClient) ListEnvironments() ([]clients.Environment, error) {
+	return nil, fmt.Errorf("ListEnvironments: %w", clients.ErrUnsupportedFeature)
+}
+
+// ListGitlinks implements RepoClient.ListGitlinks.
+func (client *localDirClient) ListGitlinks() (map[string]string, error) {
+	return nil, fmt.Errorf("ListGitlinks: %w", clients.ErrUnsupportedFeature)
+}
+
+// IsPrivateVulnerabilityReportingEnabled implements RepoClient.IsPrivateVulnerabilityReportingEnabled.
+func (client *localDirClient) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	return false, fmt.Errorf("IsPrivateVulnerabilityReportingEnabled: %w", clients.ErrUnsupportedFeature)
+}
+
+// ListSecurityAdvisories implements RepoClient.ListSecurityAdvisories.
+func (client *localDirClient) ListSecurityAdvisories() ([]clients.SecurityAdvisory, error) {
+	return nil, fmt.Errorf("ListSecurityAdvisories: %w", clients.ErrUnsupportedFeature)
+}
+
+// ListTopics implements RepoClient.ListTopics.
+func (client *localDirClient) ListTopics() ([]string, error) {
+	return nil, fmt.Errorf("ListTopics: %w", clients.ErrUnsupportedFeature)
+}
+
+// GetWorkflowPermissions implements RepoClient.GetWorkflowPermissions.
+func (client *localDirClient) GetWorkflowPermissions() (*clients.WorkflowPermissions, error) {
+	return nil, fmt.Errorf("GetWorkflowPermissions: %w", clients.ErrUnsupportedFeature)
+}
+
+// GetOrgWorkflowPermissions implements RepoClient.GetOrgWorkflowPermissions.
+func (client *localDirClient) GetOrgWorkflowPermissions() (*clients.WorkflowPermissions, error) {
+	return nil, fmt.Errorf("GetOrgWorkflowPermissions: %w", clients.ErrUnsupportedFeature)
+}
+
+// ListCIVariables implements RepoClient.ListCIVariables.
+func (client *localDirClient) ListCIVariables() ([]clients.CIVariable, error) {
+	return nil, fmt.Errorf("ListCIVariables: %w", clients.ErrUnsupportedFeature)
+}
+
+// GetCIJobTokenScope implements RepoClient.GetCIJobTokenScope.
+func (client *localDirClient) GetCIJobTokenScope() (*clients.CIJobTokenScope, error) {
+	return nil, fmt.Errorf("GetCIJobTokenScope: %w", clients.ErrUnsupportedFeature)
+}
+
 func (client *localDirClient) GetCreatedAt() (time.Time, error) {
 	return time.Time{}, fmt.Errorf("GetCreatedAt: %w", clients.ErrUnsupportedFeature)
 }
@@ -64,3 +64,42 @@ func (mr *MockCIIBestPracticesClientMockRecorder) GetBadgeLevel(ctx, uri interfa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBadgeLevel", reflect.TypeOf((*MockCIIBestPracticesClient)(nil).GetBadgeLevel), ctx, uri)
 }
+
+// MockCIIBestPracticesBadgeClient is a mock of CIIBestPracticesBadgeClient interface.
+type MockCIIBestPracticesBadgeClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockCIIBestPracticesBadgeClientMockRecorder
+}
+
+// MockCIIBestPracticesBadgeClientMockRecorder is the mock recorder for MockCIIBestPracticesBadgeClient.
+type MockCIIBestPracticesBadgeClientMockRecorder struct {
+	mock *MockCIIBestPracticesBadgeClient
+}
+
+// NewMockCIIBestPracticesBadgeClient creates a new mock instance.
+func NewMockCIIBestPracticesBadgeClient(ctrl *gomock.Controller) *MockCIIBestPracticesBadgeClient {
+	mock := &MockCIIBestPracticesBadgeClient{ctrl: ctrl}
+	mock.recorder = &MockCIIBestPracticesBadgeClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCIIBestPracticesBadgeClient) EXPECT() *MockCIIBestPracticesBadgeClientMockRecorder {
+	return m.recorder
+}
+
+// GetBadge mocks base method.
+func (m *MockCIIBestPracticesBadgeClient) GetBadge(ctx context.Context, uri string) (clients.BadgeLevel, clients.BadgeCriteria, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBadge", ctx, uri)
+	ret0, _ := ret[0].(clients.BadgeLevel)
+	ret1, _ := ret[1].(clients.BadgeCriteria)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBadge indicates an expected call of GetBadge.
+func (mr *MockCIIBestPracticesBadgeClientMockRecorder) GetBadge(ctx, uri interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBadge", reflect.TypeOf((*MockCIIBestPracticesBadgeClient)(nil).GetBadge), ctx, uri)
+}
@@ -66,6 +66,21 @@ func (mr *MockRepoClientMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockRepoClient)(nil).Close))
 }
 
+// GetArchivedAt mocks base method.
+func (m *MockRepoClient) GetArchivedAt() (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchivedAt")
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchivedAt indicates an expected call of GetArchivedAt.
+func (mr *MockRepoClientMockRecorder) GetArchivedAt() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivedAt", reflect.TypeOf((*MockRepoClient)(nil).GetArchivedAt))
+}
+
 // GetBranch mocks base method.
 func (m *MockRepoClient) GetBranch(branch string) (*clients.BranchRef, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +96,21 @@ func (mr *MockRepoClientMockRecorder) GetBranch(branch interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBranch", reflect.TypeOf((*MockRepoClient)(nil).GetBranch), branch)
 }
 
+// GetCIJobTokenScope mocks base method.
+func (m *MockRepoClient) GetCIJobTokenScope() (*clients.CIJobTokenScope, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCIJobTokenScope")
+	ret0, _ := ret[0].(*clients.CIJobTokenScope)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCIJobTokenScope indicates an expected call of GetCIJobTokenScope.
+func (mr *MockRepoClientMockRecorder) GetCIJobTokenScope() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIJobTokenScope", reflect.TypeOf((*MockRepoClient)(nil).GetCIJobTokenScope))
+}
+
 // GetCreatedAt mocks base method.
 func (m *MockRepoClient) GetCreatedAt() (time.Time, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +186,36 @@ func (mr *MockRepoClientMockRecorder) GetOrgRepoClient(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgRepoClient", reflect.TypeOf((*MockRepoClient)(nil).GetOrgRepoClient), arg0)
 }
 
+// GetOrgWorkflowPermissions mocks base method.
+func (m *MockRepoClient) GetOrgWorkflowPermissions() (*clients.WorkflowPermissions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgWorkflowPermissions")
+	ret0, _ := ret[0].(*clients.WorkflowPermissions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgWorkflowPermissions indicates an expected call of GetOrgWorkflowPermissions.
+func (mr *MockRepoClientMockRecorder) GetOrgWorkflowPermissions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgWorkflowPermissions", reflect.TypeOf((*MockRepoClient)(nil).GetOrgWorkflowPermissions))
+}
+
+// GetWorkflowPermissions mocks base method.
+func (m *MockRepoClient) GetWorkflowPermissions() (*clients.WorkflowPermissions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowPermissions")
+	ret0, _ := ret[0].(*clients.WorkflowPermissions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowPermissions indicates an expected call of GetWorkflowPermissions.
+func (mr *MockRepoClientMockRecorder) GetWorkflowPermissions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowPermissions", reflect.TypeOf((*MockRepoClient)(nil).GetWorkflowPermissions))
+}
+
 // InitRepo mocks base method.
 func (m *MockRepoClient) InitRepo(repo clients.Repo, commitSHA string, commitDepth int) error {
 	m.ctrl.T.Helper()
@@ -185,6 +245,66 @@ func (mr *MockRepoClientMockRecorder) IsArchived() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsArchived", reflect.TypeOf((*MockRepoClient)(nil).IsArchived))
 }
 
+// IsPrivate mocks base method.
+func (m *MockRepoClient) IsPrivate() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPrivate")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsPrivate indicates an expected call of IsPrivate.
+func (mr *MockRepoClientMockRecorder) IsPrivate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPrivate", reflect.TypeOf((*MockRepoClient)(nil).IsPrivate))
+}
+
+// IsPrivateVulnerabilityReportingEnabled mocks base method.
+func (m *MockRepoClient) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsPrivateVulnerabilityReportingEnabled")
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsPrivateVulnerabilityReportingEnabled indicates an expected call of IsPrivateVulnerabilityReportingEnabled.
+func (mr *MockRepoClientMockRecorder) IsPrivateVulnerabilityReportingEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPrivateVulnerabilityReportingEnabled", reflect.TypeOf((*MockRepoClient)(nil).IsPrivateVulnerabilityReportingEnabled))
+}
+
+// IsSecuritySettingEnabled mocks base method.
+func (m *MockRepoClient) IsSecuritySettingEnabled(setting clients.SecuritySetting) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSecuritySettingEnabled", setting)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSecuritySettingEnabled indicates an expected call of IsSecuritySettingEnabled.
+func (mr *MockRepoClientMockRecorder) IsSecuritySettingEnabled(setting interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSecuritySettingEnabled", reflect.TypeOf((*MockRepoClient)(nil).IsSecuritySettingEnabled), setting)
+}
+
+// ListCIVariables mocks base method.
+func (m *MockRepoClient) ListCIVariables() ([]clients.CIVariable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCIVariables")
+	ret0, _ := ret[0].([]clients.CIVariable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCIVariables indicates an expected call of ListCIVariables.
+func (mr *MockRepoClientMockRecorder) ListCIVariables() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCIVariables", reflect.TypeOf((*MockRepoClient)(nil).ListCIVariables))
+}
+
 // ListCheckRunsForRef mocks base method.
 func (m *MockRepoClient) ListCheckRunsForRef(ref string) ([]clients.CheckRun, error) {
 	m.ctrl.T.Helper()
@@ -230,6 +350,21 @@ func (mr *MockRepoClientMockRecorder) ListContributors() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContributors", reflect.TypeOf((*MockRepoClient)(nil).ListContributors))
 }
 
+// ListEnvironments mocks base method.
+func (m *MockRepoClient) ListEnvironments() ([]clients.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnvironments")
+	ret0, _ := ret[0].([]clients.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnvironments indicates an expected call of ListEnvironments.
+func (mr *MockRepoClientMockRecorder) ListEnvironments() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockRepoClient)(nil).ListEnvironments))
+}
+
 // ListFiles mocks base method.
 func (m *MockRepoClient) ListFiles(predicate func(string) (bool, error)) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -245,6 +380,21 @@ func (mr *MockRepoClientMockRecorder) ListFiles(predicate interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiles", reflect.TypeOf((*MockRepoClient)(nil).ListFiles), predicate)
 }
 
+// ListGitlinks mocks base method.
+func (m *MockRepoClient) ListGitlinks() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGitlinks")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGitlinks indicates an expected call of ListGitlinks.
+func (mr *MockRepoClientMockRecorder) ListGitlinks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGitlinks", reflect.TypeOf((*MockRepoClient)(nil).ListGitlinks))
+}
+
 // ListIssues mocks base method.
 func (m *MockRepoClient) ListIssues() ([]clients.Issue, error) {
 	m.ctrl.T.Helper()
@@ -305,6 +455,21 @@ func (mr *MockRepoClientMockRecorder) ListReleases() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReleases", reflect.TypeOf((*MockRepoClient)(nil).ListReleases))
 }
 
+// ListSecurityAdvisories mocks base method.
+func (m *MockRepoClient) ListSecurityAdvisories() ([]clients.SecurityAdvisory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecurityAdvisories")
+	ret0, _ := ret[0].([]clients.SecurityAdvisory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecurityAdvisories indicates an expected call of ListSecurityAdvisories.
+func (mr *MockRepoClientMockRecorder) ListSecurityAdvisories() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityAdvisories", reflect.TypeOf((*MockRepoClient)(nil).ListSecurityAdvisories))
+}
+
 // ListStatuses mocks base method.
 func (m *MockRepoClient) ListStatuses(ref string) ([]clients.Status, error) {
 	m.ctrl.T.Helper()
@@ -335,6 +500,21 @@ func (mr *MockRepoClientMockRecorder) ListSuccessfulWorkflowRuns(filename interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSuccessfulWorkflowRuns", reflect.TypeOf((*MockRepoClient)(nil).ListSuccessfulWorkflowRuns), filename)
 }
 
+// ListTopics mocks base method.
+func (m *MockRepoClient) ListTopics() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTopics")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTopics indicates an expected call of ListTopics.
+func (mr *MockRepoClientMockRecorder) ListTopics() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopics", reflect.TypeOf((*MockRepoClient)(nil).ListTopics))
+}
+
 // ListWebhooks mocks base method.
 func (m *MockRepoClient) ListWebhooks() ([]clients.Webhook, error) {
 	m.ctrl.T.Helper()
@@ -172,6 +172,16 @@ func (c *client) IsArchived() (bool, error) {
 	return false, fmt.Errorf("IsArchived: %w", clients.ErrUnsupportedFeature)
 }
 
+// GetArchivedAt implements RepoClient.GetArchivedAt.
+func (c *client) GetArchivedAt() (*time.Time, error) {
+	return nil, fmt.Errorf("GetArchivedAt: %w", clients.ErrUnsupportedFeature)
+}
+
+// IsPrivate implements RepoClient.IsPrivate.
+func (c *client) IsPrivate() (bool, error) {
+	return false, fmt.Errorf("IsPrivate: %w", clients.ErrUnsupportedFeature)
+}
+
 // LocalPath implements RepoClient.LocalPath.
 func (c *client) LocalPath() (string, error) {
 	return "", fmt.Errorf("LocalPath: %w", clients.ErrUnsupportedFeature)
@@ -267,6 +277,56 @@ func (c *client) ListLicenses() ([]clients.License, error) {
 	return nil, fmt.Errorf("ListLicenses: %w", clients.ErrUnsupportedFeature)
 }
 
+// IsSecuritySettingEnabled implements RepoClient.IsSecuritySettingEnabled.
+func (c *client) IsSecuritySettingEnabled(setting clients.SecuritySetting) (bool, error) {
+	return false, fmt.Errorf("IsSecuritySettingEnabled: %w", clients.ErrUnsupportedFeature)
+}
+
+// ListEnvironments implements RepoClient.ListEnvironments.
+func (c *client) ListEnvironments() ([]clients.Environment, error) {
+	return nil, fmt.Errorf("ListEnvironments: %w", clients.ErrUnsupportedFeature)
+}
+
+// ListGitlinks implements RepoClient.ListGitlinks.
+func (c *client) ListGitlinks() (map[string]string, error) {
+	return nil, fmt.Errorf("ListGitlinks: %w", clients.ErrUnsupportedFeature)
+}
+
+// IsPrivateVulnerabilityReportingEnabled implements RepoClient.IsPrivateVulnerabilityReportingEnabled.
+func (c *client) IsPrivateVulnerabilityReportingEnabled() (bool, error) {
+	return false, fmt.Errorf("IsPrivateVulnerabilityReportingEnabled: %w", clients.ErrUnsupportedFeature)
+}
+
+// ListSecurityAdvisories implements RepoClient.ListSecurityAdvisories.
+func (c *client) ListSecurityAdvisories() ([]clients.SecurityAdvisory, error) {
+	return nil, fmt.Errorf("ListSecurityAdvisories: %w", clients.ErrUnsupportedFeature)
+}
+
+// ListTopics implements RepoClient.ListTopics.
+func (c *client) ListTopics() ([]string, error) {
+	return nil, fmt.Errorf("ListTopics: %w", clients.ErrUnsupportedFeature)
+}
+
+// GetWorkflowPermissions implements RepoClient.GetWorkflowPermissions.
+func (c *client) GetWorkflowPermissions() (*clients.WorkflowPermissions, error) {
+	return nil, fmt.Errorf("GetWorkflowPermissions: %w", clients.ErrUnsupportedFeature)
+}
+
+// GetOrgWorkflowPermissions implements RepoClient.GetOrgWorkflowPermissions.
+func (c *client) GetOrgWorkflowPermissions() (*clients.WorkflowPermissions, error) {
+	return nil, fmt.Errorf("GetOrgWorkflowPermissions: %w", clients.ErrUnsupportedFeature)
+}
+
+// ListCIVariables implements RepoClient.ListCIVariables.
+func (c *client) ListCIVariables() ([]clients.CIVariable, error) {
+	return nil, fmt.Errorf("ListCIVariables: %w", clients.ErrUnsupportedFeature)
+}
+
+// GetCIJobTokenScope implements RepoClient.GetCIJobTokenScope.
+func (c *client) GetCIJobTokenScope() (*clients.CIJobTokenScope, error) {
+	return nil, fmt.Errorf("GetCIJobTokenScope: %w", clients.ErrUnsupportedFeature)
+}
+
 // GetCreatedAt implements RepoClient.GetCreatedAt.
 func (c *client) GetCreatedAt() (time.Time, error) {
 	return time.Time{}, fmt.Errorf("GetCreatedAt: %w", clients.ErrUnsupportedFeature)
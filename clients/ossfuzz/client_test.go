@@ -217,6 +217,14 @@ func TestAllClientMethods(t *testing.T) {
 		}
 	}
 
+	// Test IsPrivate
+	{
+		_, err := c.IsPrivate()
+		if !errors.Is(err, clients.ErrUnsupportedFeature) {
+			t.Errorf("IsPrivate: Expected %v, but got %v", clients.ErrUnsupportedFeature, err)
+		}
+	}
+
 	// Test LocalPath
 	{
 		_, err := c.LocalPath()
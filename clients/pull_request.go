@@ -29,6 +29,10 @@ type PullRequest struct {
 	Labels   []Label
 	Reviews  []Review
 	MergedBy User
+	// HeadCommittedAt is the commit time of HeadSHA, i.e. of the last push.
+	HeadCommittedAt time.Time
+	// CommitAuthors are the authors of the commits of the PR.
+	CommitAuthors []User
 }
 
 // Label represents a PR label.
@@ -38,6 +42,9 @@ type Label struct {
 
 // Review represents a PR review.
 type Review struct {
-	Author *User
-	State  string
+	SubmittedAt time.Time
+	Author      *User
+	State       string
+	// CommitSHA is the head of the PR the review was submitted for.
+	CommitSHA string
 }
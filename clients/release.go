@@ -14,8 +14,11 @@
 
 package clients
 
+import "time"
+
 // Release represents a release version of a package/repo.
 type Release struct {
+	PublishedAt     time.Time
 	TagName         string
 	URL             string
 	TargetCommitish string
@@ -33,6 +33,11 @@ type RepoClient interface {
 	InitRepo(repo Repo, commitSHA string, commitDepth int) error
 	URI() string
 	IsArchived() (bool, error)
+	// GetArchivedAt returns when the repository was archived, or nil if it is not archived.
+	// It returns ErrUnsupportedFeature if the forge does not record it.
+	GetArchivedAt() (*time.Time, error)
+	// IsPrivate returns true if the repository is not publicly visible.
+	IsPrivate() (bool, error)
 	ListFiles(predicate func(string) (bool, error)) ([]string, error)
 	// Returns an absolute path to the local repository
 	// in the format that matches the local OS
@@ -55,6 +60,38 @@ type RepoClient interface {
 	ListStatuses(ref string) ([]Status, error)
 	ListWebhooks() ([]Webhook, error)
 	ListProgrammingLanguages() ([]Language, error)
+	// ListTopics returns the topics of the repository.
+	// It returns ErrUnsupportedFeature if the forge has no topics.
+	ListTopics() ([]string, error)
+	// IsSecuritySettingEnabled returns whether the security setting is enabled for the repository.
+	// It returns ErrUnsupportedFeature if the forge has no such setting or the token cannot read it.
+	IsSecuritySettingEnabled(setting SecuritySetting) (bool, error)
+	// ListEnvironments returns the deployment environments of the repository and their protection rules.
+	// It returns ErrUnsupportedFeature if the forge has no environments or the token cannot list them.
+	ListEnvironments() ([]Environment, error)
+	// ListGitlinks maps the paths of the submodules in the tree of the commit to their pinned commit.
+	// It returns ErrUnsupportedFeature if the forge cannot list them.
+	ListGitlinks() (map[string]string, error)
+	// IsPrivateVulnerabilityReportingEnabled returns whether users can privately report
+	// vulnerabilities to the maintainers. It returns ErrUnsupportedFeature if the forge
+	// offers no such channel.
+	IsPrivateVulnerabilityReportingEnabled() (bool, error)
+	// ListSecurityAdvisories returns the security advisories published for the repository.
+	// It returns ErrUnsupportedFeature if the forge has no advisory database.
+	ListSecurityAdvisories() ([]SecurityAdvisory, error)
+	// ListCIVariables returns the CI/CD variables defined in the project settings.
+	// It returns ErrUnsupportedFeature if the forge has no such variables or the token cannot list them.
+	ListCIVariables() ([]CIVariable, error)
+	// GetCIJobTokenScope returns the access settings of the CI/CD job token.
+	// It returns ErrUnsupportedFeature if the forge has no such setting or the token cannot read it.
+	GetCIJobTokenScope() (*CIJobTokenScope, error)
+	// GetWorkflowPermissions returns the default permissions of the workflow token of the repository.
+	// It returns ErrUnsupportedFeature if the forge has no such setting or the token cannot read it.
+	GetWorkflowPermissions() (*WorkflowPermissions, error)
+	// GetOrgWorkflowPermissions returns the default permissions of the workflow token of the
+	// organization owning the repository. It returns ErrUnsupportedFeature if the forge has no
+	// such setting, the repository has no organization or the token cannot read it.
+	GetOrgWorkflowPermissions() (*WorkflowPermissions, error)
 	Search(request SearchRequest) (SearchResponse, error)
 	SearchCommits(request SearchCommitsOptions) ([]Commit, error)
 	Close() error
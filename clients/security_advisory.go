@@ -0,0 +1,29 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clients
+
+import "time"
+
+// SecurityAdvisory represents a security advisory published by the maintainers of a repository,
+// e.g. a GitHub Security Advisory (GHSA).
+type SecurityAdvisory struct {
+	PublishedAt time.Time
+	// ID is the identifier assigned by the hosting platform, e.g. GHSA-xxxx-xxxx-xxxx.
+	ID       string
+	CVEID    string
+	Summary  string
+	Severity string
+	URL      string
+}
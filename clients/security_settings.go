@@ -0,0 +1,45 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clients
+
+// SecuritySetting is a security feature enabled in the settings of a repository.
+type SecuritySetting string
+
+const (
+	// SecretScanning detects secrets pushed to the repository:
+	// GitHub secret scanning, GitLab secret detection.
+	SecretScanning SecuritySetting = "secretScanning"
+	// SecretPushProtection blocks pushes containing secrets:
+	// GitHub push protection.
+	SecretPushProtection SecuritySetting = "secretPushProtection"
+	// DependencyAlerts reports vulnerable dependencies:
+	// GitHub Dependabot alerts, GitLab dependency scanning.
+	DependencyAlerts SecuritySetting = "dependencyAlerts"
+	// DependencySecurityUpdates opens pull requests updating vulnerable dependencies:
+	// GitHub Dependabot security updates.
+	DependencySecurityUpdates SecuritySetting = "dependencySecurityUpdates"
+	// CodeScanning analyzes the code without any workflow in the repository:
+	// GitHub code scanning default setup, GitLab SAST.
+	CodeScanning SecuritySetting = "codeScanning"
+)
+
+// SecuritySettings are all the security settings, in a stable order.
+var SecuritySettings = []SecuritySetting{
+	SecretScanning,
+	SecretPushProtection,
+	DependencyAlerts,
+	DependencySecurityUpdates,
+	CodeScanning,
+}
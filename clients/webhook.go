@@ -14,9 +14,19 @@
 
 package clients
 
+// WebhookAllEvents is the event a webhook subscribed to all events receives.
+const WebhookAllEvents = "*"
+
 // Webhook represents VCS Webhook.
 type Webhook struct {
-	Path           string
+	Path string
+	// Events are the events the webhook receives, e.g. "push".
+	Events         []string
 	ID             int64
 	UsesAuthSecret bool
+	// AuthSecretUnknown is true if the platform does not report
+	// whether the webhook uses a secret.
+	AuthSecretUnknown bool
+	// VerifiesTLS is true if the TLS certificate of the webhook's URL is verified.
+	VerifiesTLS bool
 }
@@ -54,6 +54,16 @@ type packageRegistrationCatalogRoot struct {
 }
 
 func (n packageRegistrationCatalogRoot) latestVersion(manager pmc.Client) (string, error) {
+	entry, err := n.latestEntry(manager)
+	if err != nil {
+		return "", err
+	}
+	base, _ := parseNugetSemVer(entry.Version)
+	return base, nil
+}
+
+// latestEntry returns the catalog entry of the latest listed version which is not a pre-release.
+func (n packageRegistrationCatalogRoot) latestEntry(manager pmc.Client) (packageRegistrationCatalogEntry, error) {
 	for pageIndex := len(n.Pages) - 1; pageIndex >= 0; pageIndex-- {
 		page := n.Pages[pageIndex]
 		if page.Packages == nil {
@@ -66,18 +76,19 @@ func (n packageRegistrationCatalogRoot) latestVersion(manager pmc.Client) (strin
 					return json.NewDecoder(rc).Decode(&page)
 				}, "nuget package registration page")
 			if err != nil {
-				return "", err
+				return packageRegistrationCatalogEntry{}, err
 			}
 		}
 		for packageIndex := len(page.Packages) - 1; packageIndex >= 0; packageIndex-- {
-			base, preReleaseSuffix := parseNugetSemVer(page.Packages[packageIndex].Entry.Version)
+			_, preReleaseSuffix := parseNugetSemVer(page.Packages[packageIndex].Entry.Version)
 			// skipping non listed and pre-releases
 			if page.Packages[packageIndex].Entry.Listed && len(strings.TrimSpace(preReleaseSuffix)) == 0 {
-				return base, nil
+				return page.Packages[packageIndex].Entry, nil
 			}
 		}
 	}
-	return "", sce.WithMessage(sce.ErrScorecardInternal, "failed to get a listed version for package")
+	return packageRegistrationCatalogEntry{}, sce.WithMessage(sce.ErrScorecardInternal,
+		"failed to get a listed version for package")
 }
 
 type packageRegistrationCatalogPage struct {
@@ -90,8 +101,27 @@ type packageRegistrationCatalogItem struct {
 }
 
 type packageRegistrationCatalogEntry struct {
-	Version string `json:"version"`
-	Listed  bool   `json:"listed"`
+	Deprecation *packageDeprecation `json:"deprecation"`
+	Version     string              `json:"version"`
+	Listed      bool                `json:"listed"`
+}
+
+// packageDeprecation is described at
+// https://learn.microsoft.com/en-us/nuget/api/registration-base-url-resource#package-deprecation
+type packageDeprecation struct {
+	AlternatePackage *struct {
+		ID string `json:"id"`
+	} `json:"alternatePackage"`
+	Message string   `json:"message"`
+	Reasons []string `json:"reasons"`
+}
+
+// Deprecation is the deprecation of the latest version of a package.
+type Deprecation struct {
+	// Message is the message of the maintainers, or the reasons of the deprecation.
+	Message string
+	// AlternatePackage is the package to use instead, if any.
+	AlternatePackage string
 }
 
 func (e *packageRegistrationCatalogEntry) UnmarshalJSON(text []byte) error {
@@ -137,6 +167,8 @@ type nuspecRepository struct {
 
 type Client interface {
 	GitRepositoryByPackageName(packageName string) (string, error)
+
+	PackageDeprecation(packageName string) (*Deprecation, error)
 }
 
 type NugetClient struct {
@@ -161,6 +193,34 @@ func (c NugetClient) GitRepositoryByPackageName(packageName string) (string, err
 	return packageURL, nil
 }
 
+// PackageDeprecation returns the deprecation of the latest listed version of
+// the package, or nil if it is not deprecated.
+func (c NugetClient) PackageDeprecation(packageName string) (*Deprecation, error) {
+	_, registrationBaseURL, err := c.baseUrls()
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := c.latestListedEntry(registrationBaseURL, strings.ToLower(packageName))
+	if err != nil {
+		return nil, err
+	}
+	if entry.Deprecation == nil {
+		return nil, nil
+	}
+
+	deprecation := &Deprecation{
+		Message: strings.TrimSpace(entry.Deprecation.Message),
+	}
+	if deprecation.Message == "" {
+		deprecation.Message = strings.Join(entry.Deprecation.Reasons, ", ")
+	}
+	if entry.Deprecation.AlternatePackage != nil {
+		deprecation.AlternatePackage = entry.Deprecation.AlternatePackage.ID
+	}
+	return deprecation, nil
+}
+
 func (c *NugetClient) packageSpec(packageBaseURL, registrationBaseURL, packageName string) (packageNuspec, error) {
 	lowerCasePackageName := strings.ToLower(packageName)
 	lastPackageVersion, err := c.latestListedVersion(registrationBaseURL,
@@ -216,6 +276,23 @@ func (c *NugetClient) baseUrls() (string, string, error) {
 // Gets the latest listed nuget version of a package, based on the protocol defined at
 // https://learn.microsoft.com/en-us/nuget/api/package-base-address-resource#enumerate-package-versions
 func (c *NugetClient) latestListedVersion(baseURL, packageName string) (string, error) {
+	root, err := c.registrationCatalogRoot(baseURL, packageName)
+	if err != nil {
+		return "", err
+	}
+	return root.latestVersion(c.Manager)
+}
+
+// Gets the catalog entry of the latest listed nuget version of a package.
+func (c *NugetClient) latestListedEntry(baseURL, packageName string) (packageRegistrationCatalogEntry, error) {
+	root, err := c.registrationCatalogRoot(baseURL, packageName)
+	if err != nil {
+		return packageRegistrationCatalogEntry{}, err
+	}
+	return root.latestEntry(c.Manager)
+}
+
+func (c *NugetClient) registrationCatalogRoot(baseURL, packageName string) (*packageRegistrationCatalogRoot, error) {
 	packageRegistrationCatalogRoot := &packageRegistrationCatalogRoot{}
 	err := decodeResponseFromClient(func() (*http.Response, error) {
 		//nolint:wrapcheck
@@ -226,9 +303,9 @@ func (c *NugetClient) latestListedVersion(baseURL, packageName string) (string,
 			return json.NewDecoder(rc).Decode(packageRegistrationCatalogRoot)
 		}, "nuget package registration index json")
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	return packageRegistrationCatalogRoot.latestVersion(c.Manager)
+	return packageRegistrationCatalogRoot, nil
 }
 
 func isSupportedProjectURL(projectURL string) bool {
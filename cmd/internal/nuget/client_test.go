@@ -22,6 +22,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"reflect"
 	"slices"
 	"strings"
 	"testing"
@@ -615,3 +616,65 @@ func testResult(wantErr bool, responseFileName string) (*http.Response, error) {
 		Body:       io.NopCloser(bytes.NewBuffer(content)),
 	}, nil
 }
+
+func Test_PackageDeprecation(t *testing.T) {
+	t.Parallel()
+
+	//nolint:govet
+	tests := []struct {
+		name              string
+		registrationIndex string
+		want              *Deprecation
+		wantErr           bool
+	}{
+		{
+			name:              "not deprecated",
+			registrationIndex: "package_registration_index_single.json",
+		},
+		{
+			name:              "deprecated with alternate package",
+			registrationIndex: "package_registration_index_deprecated.json",
+			want: &Deprecation{
+				Message:          "Foo.NET is no longer maintained, please use Bar.NET.",
+				AlternatePackage: "Bar.NET",
+			},
+		},
+		{
+			name:              "deprecated without message",
+			registrationIndex: "package_registration_index_deprecated_reasons.json",
+			want: &Deprecation{
+				Message: "Legacy, CriticalBugs",
+			},
+		},
+		{
+			name:              "error",
+			registrationIndex: "package_registration_index_marshal_error.json",
+			wantErr:           true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			p := pmc.NewMockClient(ctrl)
+			p.EXPECT().GetURI("https://api.nuget.org/v3/index.json").
+				DoAndReturn(func(url string) (*http.Response, error) {
+					return testResult(false, "index.json")
+				})
+			p.EXPECT().Get(gomock.Any(), "nuget-package").
+				DoAndReturn(func(url, packageName string) (*http.Response, error) {
+					return testResult(false, tt.registrationIndex)
+				})
+			client := NugetClient{Manager: p}
+			got, err := client.PackageDeprecation("Nuget-Package")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PackageDeprecation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PackageDeprecation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
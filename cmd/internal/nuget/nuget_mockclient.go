@@ -62,3 +62,18 @@ func (mr *MockClientMockRecorder) GitRepositoryByPackageName(packageName interfa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GitRepositoryByPackageName", reflect.TypeOf((*MockClient)(nil).GitRepositoryByPackageName), packageName)
 }
+
+// PackageDeprecation mocks base method.
+func (m *MockClient) PackageDeprecation(packageName string) (*Deprecation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PackageDeprecation", packageName)
+	ret0, _ := ret[0].(*Deprecation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PackageDeprecation indicates an expected call of PackageDeprecation.
+func (mr *MockClientMockRecorder) PackageDeprecation(packageName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PackageDeprecation", reflect.TypeOf((*MockClient)(nil).PackageDeprecation), packageName)
+}
@@ -22,6 +22,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/ossf/scorecard/v4/checker"
 	ngt "github.com/ossf/scorecard/v4/cmd/internal/nuget"
 	pmc "github.com/ossf/scorecard/v4/cmd/internal/packagemanager"
 	sce "github.com/ossf/scorecard/v4/errors"
@@ -221,3 +222,141 @@ func fetchGitRepositoryFromNuget(packageName string, nugetClient ngt.Client) (st
 	}
 	return repositoryURI, nil
 }
+
+var (
+	// deprecationReplacementRegexp matches the package a deprecation message points to.
+	deprecationReplacementRegexp = regexp.MustCompile(
+		`(?i)\b(?:use|replaced\s+by|superseded\s+by|in\s+favou?r\s+of|moved\s+to|migrate\s+to|switch\s+to)\s+(?:the\s+)?` +
+			"`?" + `(https?://[^\s)>\]]+|@?[\w.-]+(?:/[\w.-]+)*)`)
+	deprecatedDescriptionRegexp = regexp.MustCompile(`(?i)\b(?:deprecated|no\s+longer\s+maintained|unmaintained)\b`)
+)
+
+// pypiInactiveClassifier marks a project which is no longer developed.
+const pypiInactiveClassifier = "Development Status :: 7 - Inactive"
+
+type npmPackageVersion struct {
+	// Deprecated is the deprecation message of the version, if any.
+	Deprecated string `json:"deprecated"`
+}
+
+type pypiPackageInfo struct {
+	Info struct {
+		Classifiers []string `json:"classifiers"`
+	} `json:"info"`
+}
+
+type rubyGemsPackageInfo struct {
+	Info string `json:"info"`
+}
+
+// fetchDeprecationFromPackageManagers returns the deprecation notice of the package
+// in its registry, or nil if the registry does not mark it deprecated.
+func fetchDeprecationFromPackageManagers(npm, pypi, rubygems, nuget string,
+	manager pmc.Client,
+) (*checker.Deprecation, error) {
+	if npm != "" {
+		return fetchDeprecationFromNPM(npm, manager)
+	}
+	if pypi != "" {
+		return fetchDeprecationFromPYPI(pypi, manager)
+	}
+	if rubygems != "" {
+		return fetchDeprecationFromRubyGems(rubygems, manager)
+	}
+	if nuget != "" {
+		nugetClient := ngt.NugetClient{Manager: manager}
+		return fetchDeprecationFromNuget(nuget, nugetClient)
+	}
+	return nil, nil
+}
+
+// Gets the deprecation message of the latest version of the npm package.
+func fetchDeprecationFromNPM(packageName string, manager pmc.Client) (*checker.Deprecation, error) {
+	npmLatestURL := "https://registry.npmjs.org/%s/latest"
+	resp, err := manager.Get(npmLatestURL, packageName)
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("failed to get npm package json: %v", err))
+	}
+
+	defer resp.Body.Close()
+	v := &npmPackageVersion{}
+	err = json.NewDecoder(resp.Body).Decode(v)
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("failed to parse npm package json: %v", err))
+	}
+	if v.Deprecated == "" {
+		return nil, nil
+	}
+	return newRegistryDeprecation("pkg:npm/"+packageName, v.Deprecated, ""), nil
+}
+
+// Gets whether the pypi package is classified as inactive.
+func fetchDeprecationFromPYPI(packageName string, manager pmc.Client) (*checker.Deprecation, error) {
+	pypiSearchURL := "https://pypi.org/pypi/%s/json"
+	resp, err := manager.Get(pypiSearchURL, packageName)
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("failed to get pypi package json: %v", err))
+	}
+
+	defer resp.Body.Close()
+	v := &pypiPackageInfo{}
+	err = json.NewDecoder(resp.Body).Decode(v)
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("failed to parse pypi package json: %v", err))
+	}
+	for _, classifier := range v.Info.Classifiers {
+		if classifier == pypiInactiveClassifier {
+			return newRegistryDeprecation("pkg:pypi/"+packageName, classifier, ""), nil
+		}
+	}
+	return nil, nil
+}
+
+// Gets whether the description of the ruby gem says it is deprecated, since
+// RubyGems has no deprecation metadata.
+func fetchDeprecationFromRubyGems(packageName string, manager pmc.Client) (*checker.Deprecation, error) {
+	rubyGemsSearchURL := "https://rubygems.org/api/v1/gems/%s.json"
+	resp, err := manager.Get(rubyGemsSearchURL, packageName)
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("failed to get ruby gem json: %v", err))
+	}
+
+	defer resp.Body.Close()
+	v := &rubyGemsPackageInfo{}
+	err = json.NewDecoder(resp.Body).Decode(v)
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("failed to parse ruby gem json: %v", err))
+	}
+	if !deprecatedDescriptionRegexp.MatchString(v.Info) {
+		return nil, nil
+	}
+	return newRegistryDeprecation("pkg:gem/"+packageName, v.Info, ""), nil
+}
+
+// Gets the deprecation of the latest listed version of the nuget package.
+func fetchDeprecationFromNuget(packageName string, nugetClient ngt.Client) (*checker.Deprecation, error) {
+	deprecation, err := nugetClient.PackageDeprecation(packageName)
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal,
+			fmt.Sprintf("could not get deprecation of nuget package: %v", err))
+	}
+	if deprecation == nil {
+		return nil, nil
+	}
+	return newRegistryDeprecation("pkg:nuget/"+packageName, deprecation.Message, deprecation.AlternatePackage), nil
+}
+
+func newRegistryDeprecation(location, message, replacement string) *checker.Deprecation {
+	message = strings.TrimSpace(message)
+	if replacement == "" {
+		if m := deprecationReplacementRegexp.FindStringSubmatch(message); m != nil {
+			replacement = strings.TrimRight(m[1], ".")
+		}
+	}
+	return &checker.Deprecation{
+		Source:      checker.DeprecationSourceRegistry,
+		Location:    location,
+		Message:     message,
+		Replacement: replacement,
+	}
+}
@@ -24,7 +24,9 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
 
+	"github.com/ossf/scorecard/v4/checker"
 	ngt "github.com/ossf/scorecard/v4/cmd/internal/nuget"
 	pmc "github.com/ossf/scorecard/v4/cmd/internal/packagemanager"
 )
@@ -788,3 +790,169 @@ func Test_fetchGitRepositoryFromNuget(t *testing.T) {
 		})
 	}
 }
+
+func Test_fetchDeprecationFromPackageManagers(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		npm      string
+		pypi     string
+		rubygems string
+		result   string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *checker.Deprecation
+		wantErr bool
+	}{
+		{
+			name: "deprecated npm package",
+			args: args{
+				npm:    "npm-package",
+				result: `{"name": "npm-package", "version": "2.0.0", "deprecated": "Use @foo/bar instead."}`,
+			},
+			want: &checker.Deprecation{
+				Source:      checker.DeprecationSourceRegistry,
+				Location:    "pkg:npm/npm-package",
+				Message:     "Use @foo/bar instead.",
+				Replacement: "@foo/bar",
+			},
+		},
+		{
+			name: "npm package",
+			args: args{
+				npm:    "npm-package",
+				result: `{"name": "npm-package", "version": "2.0.0"}`,
+			},
+		},
+		{
+			name: "npm error",
+			args: args{
+				npm:    "npm-package",
+				result: "foo",
+			},
+			wantErr: true,
+		},
+		{
+			name: "inactive pypi package",
+			args: args{
+				pypi: "pypi-package",
+				result: `{"info": {"classifiers": ["Programming Language :: Python :: 3", ` +
+					`"Development Status :: 7 - Inactive"]}}`,
+			},
+			want: &checker.Deprecation{
+				Source:   checker.DeprecationSourceRegistry,
+				Location: "pkg:pypi/pypi-package",
+				Message:  "Development Status :: 7 - Inactive",
+			},
+		},
+		{
+			name: "pypi package",
+			args: args{
+				pypi:   "pypi-package",
+				result: `{"info": {"classifiers": ["Development Status :: 5 - Production/Stable"]}}`,
+			},
+		},
+		{
+			name: "deprecated ruby gem",
+			args: args{
+				rubygems: "ruby-gem",
+				result: `{"name": "ruby-gem", "info": "DEPRECATED: this gem is superseded by ` +
+					`https://github.com/foo/bar."}`,
+			},
+			want: &checker.Deprecation{
+				Source:      checker.DeprecationSourceRegistry,
+				Location:    "pkg:gem/ruby-gem",
+				Message:     "DEPRECATED: this gem is superseded by https://github.com/foo/bar.",
+				Replacement: "https://github.com/foo/bar",
+			},
+		},
+		{
+			name: "ruby gem",
+			args: args{
+				rubygems: "ruby-gem",
+				result:   `{"name": "ruby-gem", "info": "A gem which does foo."}`,
+			},
+		},
+		{
+			name: "no package",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			p := pmc.NewMockClient(ctrl)
+			p.EXPECT().Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(url, packageName string) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(bytes.NewBufferString(tt.args.result)),
+					}, nil
+				}).AnyTimes()
+			got, err := fetchDeprecationFromPackageManagers(tt.args.npm, tt.args.pypi, tt.args.rubygems, "", p)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("fetchDeprecationFromPackageManagers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("fetchDeprecationFromPackageManagers() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_fetchDeprecationFromNuget(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		deprecation *ngt.Deprecation
+		want        *checker.Deprecation
+		wantErr     bool
+	}{
+		{
+			name: "deprecated package",
+			deprecation: &ngt.Deprecation{
+				Message:          "Legacy",
+				AlternatePackage: "Bar.NET",
+			},
+			want: &checker.Deprecation{
+				Source:      checker.DeprecationSourceRegistry,
+				Location:    "pkg:nuget/nuget-package",
+				Message:     "Legacy",
+				Replacement: "Bar.NET",
+			},
+		},
+		{
+			name: "package",
+		},
+		{
+			name:    "error from nuget client",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			n := ngt.NewMockClient(ctrl)
+			n.EXPECT().PackageDeprecation("nuget-package").
+				DoAndReturn(func(packageName string) (*ngt.Deprecation, error) {
+					if tt.wantErr {
+						return nil, errors.New("error")
+					}
+					return tt.deprecation, nil
+				})
+			got, err := fetchDeprecationFromNuget("nuget-package", n)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("fetchDeprecationFromNuget() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("fetchDeprecationFromNuget() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
 	"sort"
 	"strings"
 
@@ -27,6 +28,7 @@ import (
 
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/clients/githubrepo"
 	pmc "github.com/ossf/scorecard/v4/cmd/internal/packagemanager"
 	docs "github.com/ossf/scorecard/v4/docs/checks"
 	sce "github.com/ossf/scorecard/v4/errors"
@@ -34,6 +36,7 @@ import (
 	"github.com/ossf/scorecard/v4/options"
 	"github.com/ossf/scorecard/v4/pkg"
 	"github.com/ossf/scorecard/v4/policy"
+	"github.com/ossf/scorecard/v4/probes/notDeprecated"
 )
 
 const (
@@ -93,6 +96,18 @@ func rootCmd(o *options.Options) error {
 
 	ctx := context.Background()
 	logger := sclog.NewLogger(sclog.ParseLevel(o.LogLevel))
+
+	// Deprecation notices of package registries are only used by the notDeprecated probe.
+	var deprecations []checker.Deprecation
+	if slices.Contains(o.Probes(), notDeprecated.Probe) {
+		deprecation, err := fetchDeprecationFromPackageManagers(o.NPM, o.PyPI, o.RubyGems, o.Nuget, p)
+		switch {
+		case err != nil:
+			logger.Error(err, "fetching the deprecation notice of the package")
+		case deprecation != nil:
+			deprecations = append(deprecations, *deprecation)
+		}
+	}
 	repoURI, repoClient, ossFuzzRepoClient, ciiClient, vulnsClient, err := checker.GetClients(
 		ctx, o.Repo, o.Local, logger) // MODIFIED
 	if err != nil {
@@ -122,6 +137,22 @@ func rootCmd(o *options.Options) error {
 		return fmt.Errorf("GetEnabled: %w", err)
 	}
 
+	var actionsOpts *pkg.ThirdPartyActionsOptions
+	if o.ActionsDepth > 0 {
+		actionsChecks, err := policy.GetEnabled(nil, o.ActionsChecks, nil)
+		if err != nil {
+			return fmt.Errorf("GetEnabled: %w", err)
+		}
+		actionsRepoClient := githubrepo.CreateGithubRepoClient(ctx, logger)
+		defer actionsRepoClient.Close()
+		actionsOpts = &pkg.ThirdPartyActionsOptions{
+			RepoClient: actionsRepoClient,
+			Checks:     actionsChecks,
+			Depth:      o.ActionsDepth,
+			MaxRepos:   o.ActionsMaxRepos,
+		}
+	}
+
 	enabledProbes := o.Probes()
 	if o.Format == options.FormatDefault {
 		if len(enabledProbes) > 0 {
@@ -142,6 +173,11 @@ func rootCmd(o *options.Options) error {
 		ossFuzzRepoClient,
 		ciiClient,
 		vulnsClient,
+		&pkg.Options{
+			ThirdPartyActions: actionsOpts,
+			Deprecations:      deprecations,
+			LookBackDays:      o.LookBackDays,
+		},
 	)
 	if err != nil {
 		return fmt.Errorf("RunScorecard: %w", err)
@@ -33,17 +33,30 @@ import (
 const ciiBaseURL = "https://www.bestpractices.dev/projects.json"
 
 type ciiPageResp struct {
-	RepoURL    string `json:"repo_url"`
-	BadgeLevel string `json:"badge_level"`
+	RepoURL string
+	Badge   clients.BadgeResponse
+}
+
+// UnmarshalJSON reads the repo URL, and the badge level and criteria of a project.
+func (resp *ciiPageResp) UnmarshalJSON(data []byte) error {
+	var project struct {
+		RepoURL string `json:"repo_url"`
+	}
+	if err := json.Unmarshal(data, &project); err != nil {
+		return fmt.Errorf("error during json.Unmarshal: %w", err)
+	}
+	if err := json.Unmarshal(data, &resp.Badge); err != nil {
+		return fmt.Errorf("error during json.Unmarshal: %w", err)
+	}
+	resp.RepoURL = project.RepoURL
+	return nil
 }
 
 func writeToCIIDataBucket(ctx context.Context, pageResp []ciiPageResp, bucketURL string) error {
 	for _, project := range pageResp {
 		projectURL := strings.TrimPrefix(project.RepoURL, "https://")
 		projectURL = strings.TrimPrefix(projectURL, "http://")
-		jsonData, err := clients.BadgeResponse{
-			BadgeLevel: project.BadgeLevel,
-		}.AsJSON()
+		jsonData, err := project.Badge.AsJSON()
 		if err != nil {
 			return fmt.Errorf("error during AsJSON: %w", err)
 		}
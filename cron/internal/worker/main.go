@@ -205,7 +205,7 @@ func processRequest(ctx context.Context,
 		}
 
 		result, err := pkg.RunScorecard(ctx, repo, commitSHA, 0, checksToRun,
-			repoClient, ossFuzzRepoClient, ciiClient, vulnsClient)
+			repoClient, ossFuzzRepoClient, ciiClient, vulnsClient, nil)
 		if errors.Is(err, sce.ErrRepoUnreachable) {
 			// Not accessible repo - continue.
 			continue
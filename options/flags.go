@@ -20,6 +20,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/checks"
 )
 
@@ -72,6 +73,22 @@ const (
 	FlagCommitDepth = "commit-depth"
 
 	FlagProbes = "probes"
+
+	// FlagActionsDepth is the flag name for specifying how many levels of
+	// third-party GitHub Actions to score.
+	FlagActionsDepth = "actions-depth"
+
+	// FlagActionsChecks is the flag name for specifying which checks to run
+	// against the repositories of third-party GitHub Actions.
+	FlagActionsChecks = "actions-checks"
+
+	// FlagActionsMaxRepos is the flag name for specifying the maximum number
+	// of third-party action repositories to score.
+	FlagActionsMaxRepos = "actions-max-repos"
+
+	// FlagLookBackDays is the flag name for specifying the number of days
+	// in which activity is looked for.
+	FlagLookBackDays = "lookback-days"
 )
 
 // Command is an interface for handling options for command-line utilities.
@@ -177,6 +194,35 @@ func (o *Options) AddFlags(cmd *cobra.Command) {
 		"Probes to run.",
 	)
 
+	cmd.Flags().IntVar(
+		&o.ActionsDepth,
+		FlagActionsDepth,
+		o.ActionsDepth,
+		"levels of third-party GitHub Actions to score, 0 disables the scoring",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&o.ActionsChecks,
+		FlagActionsChecks,
+		o.ActionsChecks,
+		"Checks to run against the repositories of third-party GitHub Actions.",
+	)
+
+	cmd.Flags().IntVar(
+		&o.ActionsMaxRepos,
+		FlagActionsMaxRepos,
+		o.ActionsMaxRepos,
+		"maximum number of third-party action repositories to score",
+	)
+
+	cmd.Flags().IntVar(
+		&o.LookBackDays,
+		FlagLookBackDays,
+		o.LookBackDays,
+		fmt.Sprintf("number of days in which activity is looked for, 0 uses the default of %d days",
+			checker.DefaultLookBackDays),
+	)
+
 	// TODO(options): Extract logic
 	allowedFormats := []string{
 		FormatDefault,
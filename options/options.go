@@ -24,6 +24,7 @@ import (
 
 	"github.com/caarlos0/env/v6"
 
+	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/clients"
 	sclog "github.com/ossf/scorecard/v4/log"
 )
@@ -46,6 +47,13 @@ type Options struct {
 	Metadata    []string
 	CommitDepth int
 	ShowDetails bool
+	// Scoring of the third-party GitHub Actions used by the repository.
+	ActionsChecks   []string
+	ActionsDepth    int
+	ActionsMaxRepos int
+	// LookBackDays is the number of days in which activity is looked for,
+	// or zero for the default.
+	LookBackDays int `env:"SCORECARD_LOOKBACK_DAYS"`
 	// Feature flags.
 	EnableSarif                 bool `env:"ENABLE_SARIF"`
 	EnableScorecardV6           bool `env:"SCORECARD_V6"`
@@ -69,6 +77,12 @@ func New() *Options {
 	if opts.LogLevel == "" {
 		opts.LogLevel = DefaultLogLevel
 	}
+	if opts.ActionsChecks == nil {
+		opts.ActionsChecks = DefaultActionsChecks
+	}
+	if opts.ActionsMaxRepos == 0 {
+		opts.ActionsMaxRepos = DefaultActionsMaxRepos
+	}
 	return opts
 }
 
@@ -76,6 +90,10 @@ const (
 	// DefaultCommit specifies the default commit reference to use.
 	DefaultCommit = clients.HeadSHA
 
+	// DefaultActionsMaxRepos specifies the default maximum number of
+	// third-party action repositories to score.
+	DefaultActionsMaxRepos = 20
+
 	// Formats.
 	// FormatJSON specifies that results should be output in JSON format.
 	FormatJSON = "json"
@@ -109,6 +127,13 @@ var (
 	// DefaultLogLevel retrieves the default log level.
 	DefaultLogLevel = sclog.DefaultLevel.String()
 
+	// DefaultActionsChecks specifies the default checks to run against
+	// the repositories of the third-party GitHub Actions.
+	DefaultActionsChecks = []string{"Maintained", "Code-Review", "Contributors"}
+
+	errActionsLimitNegative            = errors.New("actions depth and max repos must not be negative")
+	errActionsMaxReposTooLow           = errors.New("actions max repos must be at least 1 when actions depth is set")
+	errLookBackDaysTooShort            = fmt.Errorf("look back days must be at least %d", checker.MinLookBackDays)
 	errCommitIsEmpty                   = errors.New("commit should be non-empty")
 	errFormatNotSupported              = errors.New("unsupported format")
 	errFormatSupportedWithExperimental = errors.New("format supported only with SCORECARD_EXPERIMENTAL=1")
@@ -184,6 +209,28 @@ func (o *Options) Validate() error {
 		)
 	}
 
+	// Validate the limits of the third-party actions scoring.
+	if o.ActionsDepth < 0 || o.ActionsMaxRepos < 0 {
+		errs = append(
+			errs,
+			errActionsLimitNegative,
+		)
+	}
+	if o.ActionsDepth > 0 && o.ActionsMaxRepos < 1 {
+		errs = append(
+			errs,
+			errActionsMaxReposTooLow,
+		)
+	}
+
+	// Validate the look back window, zero meaning the default one.
+	if o.LookBackDays != 0 && o.LookBackDays < checker.MinLookBackDays {
+		errs = append(
+			errs,
+			errLookBackDaysTooShort,
+		)
+	}
+
 	// Validate `commit` is non-empty.
 	if o.Commit == "" {
 		errs = append(
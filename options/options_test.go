@@ -35,6 +35,9 @@ func TestOptions_Validate(t *testing.T) {
 		ChecksToRun       []string
 		Metadata          []string
 		ShowDetails       bool
+		ActionsDepth      int
+		ActionsMaxRepos   int
+		LookBackDays      int
 		EnableSarif       bool
 		EnableScorecardV6 bool
 	}
@@ -84,6 +87,56 @@ func TestOptions_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "negative actions depth",
+			fields: fields{
+				Repo:         "github.com/oss/scorecard",
+				Commit:       "HEAD",
+				ActionsDepth: -1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "actions depth",
+			fields: fields{
+				Repo:            "github.com/oss/scorecard",
+				Commit:          "HEAD",
+				Format:          "default",
+				ActionsDepth:    2,
+				ActionsMaxRepos: 20,
+			},
+			wantErr: false,
+		},
+		{
+			name: "actions depth without repositories to score",
+			fields: fields{
+				Repo:         "github.com/oss/scorecard",
+				Commit:       "HEAD",
+				Format:       "default",
+				ActionsDepth: 2,
+			},
+			wantErr: true,
+		},
+		{
+			name: "look back window shorter than a week",
+			fields: fields{
+				Repo:         "github.com/oss/scorecard",
+				Commit:       "HEAD",
+				Format:       "default",
+				LookBackDays: 6,
+			},
+			wantErr: true,
+		},
+		{
+			name: "look back window",
+			fields: fields{
+				Repo:         "github.com/oss/scorecard",
+				Commit:       "HEAD",
+				Format:       "default",
+				LookBackDays: 30,
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -103,6 +156,9 @@ func TestOptions_Validate(t *testing.T) {
 				ChecksToRun:       tt.fields.ChecksToRun,
 				Metadata:          tt.fields.Metadata,
 				ShowDetails:       tt.fields.ShowDetails,
+				ActionsDepth:      tt.fields.ActionsDepth,
+				ActionsMaxRepos:   tt.fields.ActionsMaxRepos,
+				LookBackDays:      tt.fields.LookBackDays,
 				EnableSarif:       tt.fields.EnableSarif,
 				EnableScorecardV6: tt.fields.EnableScorecardV6,
 			}
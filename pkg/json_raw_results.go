@@ -46,6 +46,16 @@ type jsonFile struct {
 	EndOffset uint    `json:"endOffset,omitempty"`
 }
 
+type jsonThirdPartyAction struct {
+	Scores    map[string]int `json:"scores,omitempty"`
+	Score     *float64       `json:"score,omitempty"`
+	Repo      string         `json:"repo"`
+	UsedBy    string         `json:"usedBy,omitempty"`
+	Error     string         `json:"error,omitempty"`
+	Locations []jsonFile     `json:"locations"`
+	Depth     int            `json:"depth"`
+}
+
 type jsonTool struct {
 	URL   *string          `json:"url"`
 	Desc  *string          `json:"desc"`
@@ -74,13 +84,33 @@ type jsonBranchProtection struct {
 }
 
 type jsonBranchProtectionMetadata struct {
+	Codeowners      *jsonCodeowners        `json:"codeowners,omitempty"`
 	Branches        []jsonBranchProtection `json:"branches"`
 	CodeownersFiles []string               `json:"codeownersFiles"`
+	SensitivePaths  []jsonSensitivePath    `json:"sensitivePaths,omitempty"`
+}
+
+type jsonCodeowners struct {
+	Path   string                `json:"path"`
+	Errors []jsonCodeownersError `json:"errors,omitempty"`
+}
+
+type jsonCodeownersError struct {
+	Text string `json:"text"`
+	Line uint   `json:"line"`
+}
+
+type jsonSensitivePath struct {
+	Path   string   `json:"path"`
+	Kind   string   `json:"kind"`
+	Owners []string `json:"owners"`
 }
 
 type jsonReview struct {
-	State    string   `json:"state"`
-	Reviewer jsonUser `json:"reviewer"`
+	SubmittedAt *time.Time `json:"submittedAt,omitempty"`
+	State       string     `json:"state"`
+	CommitSHA   string     `json:"commit,omitempty"`
+	Reviewer    jsonUser   `json:"reviewer"`
 }
 
 type jsonUser struct {
@@ -116,6 +146,8 @@ type jsonDefaultBranchChangeset struct {
 	Reviews        []jsonReview `json:"reviews"`
 	Authors        []jsonUser   `json:"authors"`
 	Commits        []jsonCommit `json:"commits"`
+	MergedBy       *jsonUser    `json:"mergedBy,omitempty"`
+	HeadSHA        string       `json:"headSHA,omitempty"`
 	// TODO: check runs, etc.
 }
 
@@ -135,8 +167,8 @@ type jsonDatabaseVulnerability struct {
 }
 
 type jsonArchivedStatus struct {
-	Status bool `json:"status"`
-	// TODO: add fields, e.g. date of archival, etc.
+	ArchivedAt *time.Time `json:"archivedAt,omitempty"`
+	Status     bool       `json:"status"`
 }
 
 type jsonCreatedAtTime struct {
@@ -170,7 +202,8 @@ type jsonReleaseAsset struct {
 }
 
 type jsonOssfBestPractices struct {
-	Badge string `json:"badge"`
+	Criteria map[string]string `json:"criteria,omitempty"`
+	Badge    string            `json:"badge"`
 }
 
 type jsonLicenseInfo struct {
@@ -198,10 +231,11 @@ type jsonWorkflowJob struct {
 }
 
 type jsonPackage struct {
-	Name *string          `json:"name,omitempty"`
-	Job  *jsonWorkflowJob `json:"job,omitempty"`
-	File *jsonFile        `json:"file,omitempty"`
-	Runs []jsonRun        `json:"runs,omitempty"`
+	Name      *string          `json:"name,omitempty"`
+	Job       *jsonWorkflowJob `json:"job,omitempty"`
+	File      *jsonFile        `json:"file,omitempty"`
+	Ecosystem string           `json:"ecosystem,omitempty"`
+	Runs      []jsonRun        `json:"runs,omitempty"`
 }
 
 type jsonRun struct {
@@ -290,6 +324,8 @@ type jsonRawResults struct {
 	Packages []jsonPackage `json:"packages"`
 	// Dependency pinning.
 	DependencyPinning jsonPinningDependenciesData `json:"dependencyPinning"`
+	// Repositories of the third-party GitHub Actions.
+	ThirdPartyActions []jsonThirdPartyAction `json:"thirdPartyActions,omitempty"`
 }
 
 func asPointer(s string) *string {
@@ -359,6 +395,8 @@ func (r *jsonScorecardRawResult) addPackagingRawResults(pk *checker.PackagingDat
 			Path:   p.File.Path,
 			Offset: p.File.Offset,
 		}
+		jpk.Name = p.Name
+		jpk.Ecosystem = string(p.Ecosystem)
 
 		if p.File.Snippet != "" {
 			jpk.File.Snippet = asPointer(p.File.Snippet)
@@ -406,6 +444,34 @@ func (r *jsonScorecardRawResult) addDependencyPinningRawResults(pd *checker.Pinn
 	return nil
 }
 
+//nolint:unparam
+func (r *jsonScorecardRawResult) addThirdPartyActionsRawResults(ta *checker.ThirdPartyActionsData) error {
+	for i := range ta.Actions {
+		a := &ta.Actions[i]
+		v := jsonThirdPartyAction{
+			Scores:    a.Scores,
+			Repo:      a.Repo,
+			UsedBy:    a.UsedBy,
+			Error:     a.Error,
+			Locations: []jsonFile{},
+			Depth:     a.Depth,
+		}
+		if score, ok := a.Score(); ok {
+			v.Score = &score
+		}
+		for j := range a.Locations {
+			loc := &a.Locations[j]
+			v.Locations = append(v.Locations, jsonFile{
+				Path:      loc.Path,
+				Offset:    loc.Offset,
+				EndOffset: loc.EndOffset,
+			})
+		}
+		r.Results.ThirdPartyActions = append(r.Results.ThirdPartyActions, v)
+	}
+	return nil
+}
+
 //nolint:unparam
 func (r *jsonScorecardRawResult) addDangerousWorkflowRawResults(df *checker.DangerousWorkflowData) error {
 	r.Results.Workflows = []jsonWorkflow{}
@@ -490,7 +556,10 @@ func (r *jsonScorecardRawResult) addSignedReleasesRawResults(sr *checker.SignedR
 //nolint:unparam
 func (r *jsonScorecardRawResult) addMaintainedRawResults(mr *checker.MaintainedData) error {
 	// Set archived status.
-	r.Results.ArchivedStatus = jsonArchivedStatus{Status: mr.ArchivedStatus.Status}
+	r.Results.ArchivedStatus = jsonArchivedStatus{
+		ArchivedAt: mr.ArchivedStatus.ArchivedAt,
+		Status:     mr.ArchivedStatus.Status,
+	}
 
 	r.Results.CreatedAtTime = jsonCreatedAtTime{Time: mr.CreatedAt}
 
@@ -557,13 +626,18 @@ func (r *jsonScorecardRawResult) setDefaultCommitData(changesets []checker.Chang
 		reviews := []jsonReview{}
 		for j := range cs.Reviews {
 			r := cs.Reviews[j]
-			reviews = append(reviews, jsonReview{
-				State: r.State,
+			review := jsonReview{
+				State:     r.State,
+				CommitSHA: r.CommitSHA,
 				Reviewer: jsonUser{
 					Login: r.Author.Login,
 					IsBot: r.Author.IsBot,
 				},
-			})
+			}
+			if !r.SubmittedAt.IsZero() {
+				review.SubmittedAt = &r.SubmittedAt
+			}
+			reviews = append(reviews, review)
 		}
 
 		// Only add the Merge Request opener as the PR author
@@ -571,6 +645,14 @@ func (r *jsonScorecardRawResult) setDefaultCommitData(changesets []checker.Chang
 			Login: cs.Author.Login,
 		}}
 
+		var mergedBy *jsonUser
+		if cs.MergedBy.Login != "" {
+			mergedBy = &jsonUser{
+				Login: cs.MergedBy.Login,
+				IsBot: cs.MergedBy.IsBot,
+			}
+		}
+
 		r.Results.DefaultBranchChangesets = append(r.Results.DefaultBranchChangesets,
 			jsonDefaultBranchChangeset{
 				RevisionID:     cs.RevisionID,
@@ -578,6 +660,8 @@ func (r *jsonScorecardRawResult) setDefaultCommitData(changesets []checker.Chang
 				Commits:        commits,
 				Reviews:        reviews,
 				Authors:        authors,
+				MergedBy:       mergedBy,
+				HeadSHA:        cs.HeadSHA,
 			},
 		)
 	}
@@ -587,6 +671,12 @@ func (r *jsonScorecardRawResult) setDefaultCommitData(changesets []checker.Chang
 //nolint:unparam
 func (r *jsonScorecardRawResult) addOssfBestPracticesRawResults(cbp *checker.CIIBestPracticesData) error {
 	r.Results.OssfBestPractices.Badge = cbp.Badge.String()
+	if cbp.Criteria != nil {
+		r.Results.OssfBestPractices.Criteria = map[string]string{}
+		for name, status := range cbp.Criteria {
+			r.Results.OssfBestPractices.Criteria[name] = string(status)
+		}
+	}
 	return nil
 }
 
@@ -730,6 +820,17 @@ func (r *jsonScorecardRawResult) addBranchProtectionRawResults(bp *checker.Branc
 	r.Results.BranchProtections.Branches = branches
 
 	r.Results.BranchProtections.CodeownersFiles = bp.CodeownersFiles
+	if bp.Codeowners != nil {
+		codeowners := &jsonCodeowners{Path: bp.Codeowners.Path}
+		for _, e := range bp.Codeowners.Errors {
+			codeowners.Errors = append(codeowners.Errors, jsonCodeownersError{Text: e.Text, Line: e.Line})
+		}
+		r.Results.BranchProtections.Codeowners = codeowners
+	}
+	for _, p := range bp.SensitivePaths {
+		r.Results.BranchProtections.SensitivePaths = append(r.Results.BranchProtections.SensitivePaths,
+			jsonSensitivePath{Path: p.Path, Kind: string(p.Kind), Owners: p.Owners})
+	}
 
 	return nil
 }
@@ -814,6 +915,11 @@ func (r *jsonScorecardRawResult) fillJSONRawResults(raw *checker.RawResults) err
 		return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
 	}
 
+	// Third-party actions.
+	if err := r.addThirdPartyActionsRawResults(&raw.ThirdPartyActionsResults); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+	}
+
 	return nil
 }
 
@@ -415,6 +415,52 @@ func TestJsonScorecardRawResult_AddSignedReleasesRawResults(t *testing.T) {
 	}
 }
 
+func TestJsonScorecardRawResult_AddThirdPartyActionsRawResults(t *testing.T) {
+	t.Parallel()
+
+	score := 5.0
+	input := &checker.ThirdPartyActionsData{
+		Actions: []checker.ThirdPartyAction{
+			{
+				Repo:      "actions/checkout",
+				Depth:     1,
+				Scores:    map[string]int{"Maintained": 10, "Contributors": 0, "Code-Review": -1},
+				Locations: []checker.File{{Path: "ci.yml", Offset: 5, EndOffset: 5, Snippet: "actions/checkout@v4"}},
+			},
+			{
+				Repo:   "actions/toolkit",
+				UsedBy: "actions/checkout",
+				Depth:  2,
+				Error:  "limit reached",
+			},
+		},
+	}
+	want := []jsonThirdPartyAction{
+		{
+			Repo:      "actions/checkout",
+			Depth:     1,
+			Scores:    map[string]int{"Maintained": 10, "Contributors": 0, "Code-Review": -1},
+			Score:     &score,
+			Locations: []jsonFile{{Path: "ci.yml", Offset: 5, EndOffset: 5}},
+		},
+		{
+			Repo:      "actions/toolkit",
+			UsedBy:    "actions/checkout",
+			Depth:     2,
+			Error:     "limit reached",
+			Locations: []jsonFile{},
+		},
+	}
+
+	r := &jsonScorecardRawResult{}
+	if err := r.addThirdPartyActionsRawResults(input); err != nil {
+		t.Fatalf("addThirdPartyActionsRawResults() error = %v", err)
+	}
+	if diff := cmp.Diff(want, r.Results.ThirdPartyActions); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestJsonScorecardRawResult_AddMaintainedRawResults(t *testing.T) {
 	t.Parallel()
 	c := clients.RepoAssociationNone
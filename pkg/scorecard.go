@@ -80,6 +80,21 @@ func getRepoCommitHash(r clients.RepoClient) (string, error) {
 	return commits[0].SHA, nil
 }
 
+// Options are the settings of a run which have a default.
+// A nil *Options runs with the defaults.
+type Options struct {
+	// ThirdPartyActions configures the scoring of the repositories of the
+	// third-party GitHub Actions used by the repository. They are scored only
+	// if it is non-nil and its depth is positive.
+	ThirdPartyActions *ThirdPartyActionsOptions
+	// Deprecations are the deprecation notices of the project found outside
+	// of the repository, e.g. in a package registry.
+	Deprecations []checker.Deprecation
+	// LookBackDays is the number of days in which activity is looked for,
+	// or zero for checker.DefaultLookBackDays.
+	LookBackDays int
+}
+
 func runScorecard(ctx context.Context,
 	repo clients.Repo,
 	commitSHA string,
@@ -90,7 +105,11 @@ func runScorecard(ctx context.Context,
 	ossFuzzRepoClient clients.RepoClient,
 	ciiClient clients.CIIBestPracticesClient,
 	vulnsClient clients.VulnerabilitiesClient,
+	opts *Options,
 ) (ScorecardResult, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
 	if err := repoClient.InitRepo(repo, commitSHA, commitDepth); err != nil {
 		// No need to call sce.WithMessage() since InitRepo will do that for us.
 		//nolint:wrapcheck
@@ -147,13 +166,15 @@ func runScorecard(ctx context.Context,
 		OssFuzzRepo:           ossFuzzRepoClient,
 		CIIClient:             ciiClient,
 		VulnerabilitiesClient: vulnsClient,
+		Deprecations:          opts.Deprecations,
+		LookBackDays:          opts.LookBackDays,
 		Repo:                  repo,
 		RawResults:            &ret.RawResults,
 	}
 
 	// If the user runs probes
 	if len(probesToRun) > 0 {
-		err = runEnabledProbes(request, probesToRun, &ret)
+		err = runEnabledProbes(request, probesToRun, opts.ThirdPartyActions, &ret)
 		if err != nil {
 			return ScorecardResult{}, err
 		}
@@ -167,6 +188,10 @@ func runScorecard(ctx context.Context,
 		ret.Checks = append(ret.Checks, result)
 	}
 
+	if err := scoreThirdPartyActions(request, opts.ThirdPartyActions); err != nil {
+		return ScorecardResult{}, err
+	}
+
 	if value, _ := os.LookupEnv(options.EnvVarScorecardExperimental); value == "1" {
 		// Run the probes.
 		var findings []finding.Finding
@@ -188,6 +213,7 @@ func runScorecard(ctx context.Context,
 
 func runEnabledProbes(request *checker.CheckRequest,
 	probesToRun []string,
+	actionsOpts *ThirdPartyActionsOptions,
 	ret *ScorecardResult,
 ) error {
 	// Add RawResults to request
@@ -195,6 +221,9 @@ func runEnabledProbes(request *checker.CheckRequest,
 	if err != nil {
 		return err
 	}
+	if err := scoreThirdPartyActions(request, actionsOpts); err != nil {
+		return err
+	}
 
 	probeFindings := make([]finding.Finding, 0)
 	for _, probeName := range probesToRun {
@@ -216,6 +245,7 @@ func runEnabledProbes(request *checker.CheckRequest,
 }
 
 // RunScorecard runs enabled Scorecard checks on a Repo.
+// opts may be nil to run with the defaults.
 func RunScorecard(ctx context.Context,
 	repo clients.Repo,
 	commitSHA string,
@@ -225,6 +255,7 @@ func RunScorecard(ctx context.Context,
 	ossFuzzRepoClient clients.RepoClient,
 	ciiClient clients.CIIBestPracticesClient,
 	vulnsClient clients.VulnerabilitiesClient,
+	opts *Options,
 ) (ScorecardResult, error) {
 	return runScorecard(ctx,
 		repo,
@@ -236,10 +267,12 @@ func RunScorecard(ctx context.Context,
 		ossFuzzRepoClient,
 		ciiClient,
 		vulnsClient,
+		opts,
 	)
 }
 
 // ExperimentalRunProbes is experimental. Do not depend on it, it may be removed at any point.
+// opts may be nil to run with the defaults.
 func ExperimentalRunProbes(ctx context.Context,
 	repo clients.Repo,
 	commitSHA string,
@@ -250,6 +283,7 @@ func ExperimentalRunProbes(ctx context.Context,
 	ossFuzzRepoClient clients.RepoClient,
 	ciiClient clients.CIIBestPracticesClient,
 	vulnsClient clients.VulnerabilitiesClient,
+	opts *Options,
 ) (ScorecardResult, error) {
 	return runScorecard(ctx,
 		repo,
@@ -261,5 +295,6 @@ func ExperimentalRunProbes(ctx context.Context,
 		ossFuzzRepoClient,
 		ciiClient,
 		vulnsClient,
+		opts,
 	)
 }
@@ -243,7 +243,7 @@ func assignRawData(probeCheckName string, request *checker.CheckRequest, ret *Sc
 		}
 		ret.RawResults.SecurityPolicyResults = rawData
 	case checks.CheckDependencyUpdateTool:
-		rawData, err := raw.DependencyUpdateTool(request.RepoClient)
+		rawData, err := raw.DependencyUpdateTool(request)
 		if err != nil {
 			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
 		}
@@ -290,6 +290,18 @@ func assignRawData(probeCheckName string, request *checker.CheckRequest, ret *Sc
 			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
 		}
 		ret.RawResults.VulnerabilitiesResults = rawData
+	case checks.CheckCIIBestPractices:
+		rawData, err := raw.CIIBestPractices(request)
+		if err != nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+		}
+		ret.RawResults.CIIBestPracticesResults = rawData
+	case checks.CheckCITests:
+		rawData, err := raw.CITests(request.RepoClient)
+		if err != nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+		}
+		ret.RawResults.CITestResults = rawData
 	case checks.CheckSAST:
 		rawData, err := raw.SAST(request)
 		if err != nil {
@@ -302,12 +314,36 @@ func assignRawData(probeCheckName string, request *checker.CheckRequest, ret *Sc
 			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
 		}
 		ret.RawResults.DangerousWorkflowResults = rawData
+	case checks.CheckTokenPermissions:
+		rawData, err := raw.TokenPermissions(request)
+		if err != nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+		}
+		ret.RawResults.TokenPermissionsResults = rawData
 	case checks.CheckMaintained:
 		rawData, err := raw.Maintained(request)
 		if err != nil {
 			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
 		}
 		ret.RawResults.MaintainedResults = rawData
+	case checks.CheckPinnedDependencies:
+		rawData, err := raw.PinningDependencies(request)
+		if err != nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+		}
+		ret.RawResults.PinningDependenciesResults = rawData
+	case checks.CheckSecuritySettings:
+		rawData, err := raw.SecuritySettings(request)
+		if err != nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+		}
+		ret.RawResults.SecuritySettingsResults = rawData
+	case checks.CheckInstallScripts:
+		rawData, err := raw.InstallScripts(request)
+		if err != nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+		}
+		ret.RawResults.InstallScriptsResults = rawData
 	}
 	return nil
 }
@@ -320,12 +356,14 @@ func populateRawResults(request *checker.CheckRequest, probesToRun []string, ret
 	ret.RawResults.Metadata.Metadata["localPath"] = localPath
 	probeCheckNames := make([]string, 0)
 	for _, probeName := range probesToRun {
-		probeCheckName := probes.CheckMap[probeName]
-		if !contains(probeCheckNames, probeCheckName) {
-			probeCheckNames = append(probeCheckNames, probeCheckName)
-			err := assignRawData(probeCheckName, request, ret)
-			if err != nil {
-				return err
+		checkNames := append([]string{probes.CheckMap[probeName]}, probes.DependentChecks[probeName]...)
+		for _, probeCheckName := range checkNames {
+			if !contains(probeCheckNames, probeCheckName) {
+				probeCheckNames = append(probeCheckNames, probeCheckName)
+				err := assignRawData(probeCheckName, request, ret)
+				if err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -0,0 +1,218 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks"
+	"github.com/ossf/scorecard/v4/checks/raw"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/clients/githubrepo"
+	sce "github.com/ossf/scorecard/v4/errors"
+)
+
+// ThirdPartyActionsOptions configures the scoring of the repositories
+// of the third-party GitHub Actions used by a repository.
+type ThirdPartyActionsOptions struct {
+	// RepoClient is used to score the action repositories one at a time.
+	// It must not be the client of the scored repository.
+	RepoClient clients.RepoClient
+	// Checks are the checks run against each action repository.
+	Checks checker.CheckNameToFnMap
+	// Depth is the number of levels of actions scored: 1 scores the actions
+	// used by the repository, 2 also the actions used by these actions, etc.
+	// Zero disables the scoring.
+	Depth int
+	// MaxRepos is the maximum number of action repositories scored.
+	MaxRepos int
+}
+
+// scoreActionRepoFn scores an action repository with the given checks.
+type scoreActionRepoFn func(repo string, checksToRun checker.CheckNameToFnMap) (ScorecardResult, error)
+
+type actionReference struct {
+	repo     string
+	location checker.File
+}
+
+// scoreThirdPartyActions scores the repositories of the third-party actions
+// used by the workflows of the repository and stores the results
+// in the raw results of the request.
+func scoreThirdPartyActions(request *checker.CheckRequest, opts *ThirdPartyActionsOptions) error {
+	if opts == nil || opts.Depth <= 0 {
+		return nil
+	}
+	pinning := request.RawResults.PinningDependenciesResults
+	if pinning.Dependencies == nil {
+		// The Pinned-Dependencies check did not run.
+		var err error
+		pinning, err = raw.PinningDependencies(request)
+		if err != nil {
+			return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+		}
+	}
+	self := request.RawResults.Metadata.Metadata["repository.name"]
+	score := func(repo string, checksToRun checker.CheckNameToFnMap) (ScorecardResult, error) {
+		return scoreActionRepo(request.Ctx, repo, checksToRun, request, opts.RepoClient)
+	}
+	request.RawResults.ThirdPartyActionsResults = checker.ThirdPartyActionsData{
+		Actions: scoreActionRepos(self, pinning.Dependencies, opts, score),
+	}
+	return nil
+}
+
+// scoreActionRepo runs the checks against an action repository with the look
+// back window of the request. There is no cache: scoreActionRepos only avoids
+// scoring a repository twice in a run, and every run scores it again.
+func scoreActionRepo(ctx context.Context,
+	name string,
+	checksToRun checker.CheckNameToFnMap,
+	request *checker.CheckRequest,
+	repoClient clients.RepoClient,
+) (ScorecardResult, error) {
+	repo, err := githubrepo.MakeGithubRepo(name)
+	if err != nil {
+		return ScorecardResult{}, fmt.Errorf("MakeGithubRepo: %w", err)
+	}
+	return RunScorecard(ctx, repo, clients.HeadSHA, 0, checksToRun,
+		repoClient, request.OssFuzzRepo, request.CIIClient, request.VulnerabilitiesClient,
+		&Options{LookBackDays: request.LookBackDays})
+}
+
+// scoreActionRepos scores the action repositories level by level, each
+// repository at most once, until the depth or the maximum number
+// of scored repositories is reached.
+func scoreActionRepos(self string,
+	deps []checker.Dependency,
+	opts *ThirdPartyActionsOptions,
+	score scoreActionRepoFn,
+) []checker.ThirdPartyAction {
+	actions := []checker.ThirdPartyAction{}
+	seen := map[string]bool{strings.ToLower(self): true}
+	scored := 0
+	type level struct {
+		usedBy string
+		deps   []checker.Dependency
+	}
+	levels := []level{{deps: deps}}
+	for depth := 1; depth <= opts.Depth && len(levels) > 0; depth++ {
+		var next []level
+		for _, l := range levels {
+			for _, refs := range groupActionReferences(l.deps) {
+				if seen[strings.ToLower(refs[0].repo)] {
+					continue
+				}
+				seen[strings.ToLower(refs[0].repo)] = true
+				action := checker.ThirdPartyAction{
+					Repo:   refs[0].repo,
+					UsedBy: l.usedBy,
+					Depth:  depth,
+				}
+				for i := range refs {
+					action.Locations = append(action.Locations, refs[i].location)
+				}
+				if scored >= opts.MaxRepos {
+					action.Error = fmt.Sprintf("limit of %d scored repositories reached", opts.MaxRepos)
+					actions = append(actions, action)
+					continue
+				}
+				scored++
+				checksToRun := opts.Checks
+				if depth < opts.Depth {
+					// The raw results of Pinned-Dependencies list the actions
+					// used by the action repository.
+					checksToRun = withPinnedDependencies(opts.Checks)
+				}
+				result, err := score(action.Repo, checksToRun)
+				if err != nil {
+					action.Error = err.Error()
+					actions = append(actions, action)
+					continue
+				}
+				action.Scores = map[string]int{}
+				for i := range result.Checks {
+					if _, ok := opts.Checks[result.Checks[i].Name]; ok {
+						action.Scores[result.Checks[i].Name] = result.Checks[i].Score
+					}
+				}
+				actions = append(actions, action)
+				next = append(next, level{
+					usedBy: action.Repo,
+					deps:   result.RawResults.PinningDependenciesResults.Dependencies,
+				})
+			}
+		}
+		levels = next
+	}
+	return actions
+}
+
+func withPinnedDependencies(checksToRun checker.CheckNameToFnMap) checker.CheckNameToFnMap {
+	if _, ok := checksToRun[checks.CheckPinnedDependencies]; ok {
+		return checksToRun
+	}
+	ret := checker.CheckNameToFnMap{}
+	for name, check := range checksToRun {
+		ret[name] = check
+	}
+	ret[checks.CheckPinnedDependencies] = checks.GetAll()[checks.CheckPinnedDependencies]
+	return ret
+}
+
+// groupActionReferences groups the references to third-party actions
+// by owner/repo, sorted by repository.
+func groupActionReferences(deps []checker.Dependency) [][]actionReference {
+	byRepo := map[string][]actionReference{}
+	for i := range deps {
+		dep := &deps[i]
+		if dep.Type != checker.DependencyUseTypeGHAction || dep.Name == nil || dep.Location == nil {
+			continue
+		}
+		repo, ok := actionRepo(*dep.Name)
+		if !ok {
+			continue
+		}
+		key := strings.ToLower(repo)
+		byRepo[key] = append(byRepo[key], actionReference{repo: repo, location: *dep.Location})
+	}
+	keys := make([]string, 0, len(byRepo))
+	for key := range byRepo {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	ret := make([][]actionReference, 0, len(keys))
+	for _, key := range keys {
+		ret = append(ret, byRepo[key])
+	}
+	return ret
+}
+
+// actionRepo returns the owner/repo of an action reference
+// such as `github/codeql-action/init`.
+func actionRepo(name string) (string, bool) {
+	if strings.HasPrefix(name, "./") || strings.HasPrefix(name, "docker://") {
+		return "", false
+	}
+	parts := strings.Split(name, "/")
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", false
+	}
+	return parts[0] + "/" + parts[1], true
+}
@@ -0,0 +1,256 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks"
+)
+
+var errScoreActionRepo = errors.New("scoring failed")
+
+func actionDependency(name, path string, line uint) checker.Dependency {
+	return checker.Dependency{
+		Name: &name,
+		Type: checker.DependencyUseTypeGHAction,
+		Location: &checker.File{
+			Path:   path,
+			Offset: line,
+		},
+	}
+}
+
+func TestActionRepo(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		want string
+		ok   bool
+	}{
+		{name: "actions/checkout", want: "actions/checkout", ok: true},
+		{name: "github/codeql-action/init", want: "github/codeql-action", ok: true},
+		{name: "./.github/actions/setup"},
+		{name: "docker://alpine:3.8"},
+		{name: "checkout"},
+		{name: "/checkout"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := actionRepo(tt.name)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("actionRepo(%q) = %q, %v, want %q, %v", tt.name, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestScoreActionRepos(t *testing.T) {
+	t.Parallel()
+	const workflow = ".github/workflows/ci.yml"
+	deps := []checker.Dependency{
+		actionDependency("github/codeql-action/init", workflow, 10),
+		actionDependency("github/codeql-action/analyze", workflow, 20),
+		actionDependency("actions/checkout", workflow, 5),
+		actionDependency("./.github/actions/setup", workflow, 7),
+		actionDependency("owner/repo/.github/actions/build", workflow, 9),
+		{Name: asPointer("python"), Type: checker.DependencyUseTypeDockerfileContainerImage},
+	}
+	results := map[string]ScorecardResult{
+		"actions/checkout": {
+			Checks: []checker.CheckResult{
+				{Name: checks.CheckMaintained, Score: 10},
+				{Name: checks.CheckContributors, Score: 6},
+				{Name: checks.CheckPinnedDependencies, Score: 3},
+			},
+			RawResults: checker.RawResults{
+				PinningDependenciesResults: checker.PinningDependenciesData{
+					Dependencies: []checker.Dependency{
+						actionDependency("actions/toolkit", workflow, 3),
+						actionDependency("github/codeql-action/init", workflow, 4),
+					},
+				},
+			},
+		},
+		"github/codeql-action": {
+			Checks: []checker.CheckResult{
+				{Name: checks.CheckMaintained, Score: 10},
+				{Name: checks.CheckContributors, Score: checker.InconclusiveResultScore},
+			},
+		},
+		"actions/toolkit": {
+			Checks: []checker.CheckResult{
+				{Name: checks.CheckMaintained, Score: 0},
+			},
+		},
+	}
+	tests := []struct {
+		name       string
+		want       []checker.ThirdPartyAction
+		wantScored []string
+		// wantPinning are the repositories whose used actions are collected.
+		wantPinning []string
+		depth       int
+		maxRepos    int
+	}{
+		{
+			name:       "one level",
+			depth:      1,
+			maxRepos:   10,
+			wantScored: []string{"actions/checkout", "github/codeql-action"},
+			want: []checker.ThirdPartyAction{
+				{
+					Repo:      "actions/checkout",
+					Depth:     1,
+					Scores:    map[string]int{checks.CheckMaintained: 10, checks.CheckContributors: 6},
+					Locations: []checker.File{{Path: workflow, Offset: 5}},
+				},
+				{
+					Repo:   "github/codeql-action",
+					Depth:  1,
+					Scores: map[string]int{checks.CheckMaintained: 10, checks.CheckContributors: checker.InconclusiveResultScore},
+					Locations: []checker.File{
+						{Path: workflow, Offset: 10},
+						{Path: workflow, Offset: 20},
+					},
+				},
+			},
+		},
+		{
+			name:        "two levels",
+			depth:       2,
+			maxRepos:    10,
+			wantScored:  []string{"actions/checkout", "github/codeql-action", "actions/toolkit"},
+			wantPinning: []string{"actions/checkout", "github/codeql-action"},
+			want: []checker.ThirdPartyAction{
+				{
+					Repo:      "actions/checkout",
+					Depth:     1,
+					Scores:    map[string]int{checks.CheckMaintained: 10, checks.CheckContributors: 6},
+					Locations: []checker.File{{Path: workflow, Offset: 5}},
+				},
+				{
+					Repo:   "github/codeql-action",
+					Depth:  1,
+					Scores: map[string]int{checks.CheckMaintained: 10, checks.CheckContributors: checker.InconclusiveResultScore},
+					Locations: []checker.File{
+						{Path: workflow, Offset: 10},
+						{Path: workflow, Offset: 20},
+					},
+				},
+				{
+					Repo:      "actions/toolkit",
+					UsedBy:    "actions/checkout",
+					Depth:     2,
+					Scores:    map[string]int{checks.CheckMaintained: 0},
+					Locations: []checker.File{{Path: workflow, Offset: 3}},
+				},
+			},
+		},
+		{
+			name:        "limit reached",
+			depth:       2,
+			maxRepos:    1,
+			wantScored:  []string{"actions/checkout"},
+			wantPinning: []string{"actions/checkout"},
+			want: []checker.ThirdPartyAction{
+				{
+					Repo:      "actions/checkout",
+					Depth:     1,
+					Scores:    map[string]int{checks.CheckMaintained: 10, checks.CheckContributors: 6},
+					Locations: []checker.File{{Path: workflow, Offset: 5}},
+				},
+				{
+					Repo:  "github/codeql-action",
+					Depth: 1,
+					Error: "limit of 1 scored repositories reached",
+					Locations: []checker.File{
+						{Path: workflow, Offset: 10},
+						{Path: workflow, Offset: 20},
+					},
+				},
+				{
+					Repo:      "actions/toolkit",
+					UsedBy:    "actions/checkout",
+					Depth:     2,
+					Error:     "limit of 1 scored repositories reached",
+					Locations: []checker.File{{Path: workflow, Offset: 3}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			opts := &ThirdPartyActionsOptions{
+				Checks: checker.CheckNameToFnMap{
+					checks.CheckMaintained:   checker.Check{},
+					checks.CheckContributors: checker.Check{},
+				},
+				Depth:    tt.depth,
+				MaxRepos: tt.maxRepos,
+			}
+			var scored, pinning []string
+			score := func(repo string, checksToRun checker.CheckNameToFnMap) (ScorecardResult, error) {
+				scored = append(scored, repo)
+				if _, ok := checksToRun[checks.CheckPinnedDependencies]; ok {
+					pinning = append(pinning, repo)
+				}
+				if result, ok := results[repo]; ok {
+					return result, nil
+				}
+				return ScorecardResult{}, errScoreActionRepo
+			}
+			got := scoreActionRepos("owner/repo", deps, opts, score)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantScored, scored); diff != "" {
+				t.Errorf("scored repositories mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantPinning, pinning); diff != "" {
+				t.Errorf("Pinned-Dependencies repositories mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestScoreActionReposError(t *testing.T) {
+	t.Parallel()
+	deps := []checker.Dependency{actionDependency("actions/checkout", "ci.yml", 1)}
+	opts := &ThirdPartyActionsOptions{Depth: 1, MaxRepos: 1}
+	score := func(string, checker.CheckNameToFnMap) (ScorecardResult, error) {
+		return ScorecardResult{}, errScoreActionRepo
+	}
+	got := scoreActionRepos("owner/repo", deps, opts, score)
+	want := []checker.ThirdPartyAction{
+		{
+			Repo:      "actions/checkout",
+			Depth:     1,
+			Error:     errScoreActionRepo.Error(),
+			Locations: []checker.File{{Path: "ci.yml", Offset: 1}},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package actionsFromLowScoringRepos
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe = "actionsFromLowScoringRepos"
+	// RepoKey is the repository of the actions.
+	RepoKey = "repo"
+	// UsedByKey is the action repository using the actions, if any.
+	UsedByKey = "usedBy"
+	// DepthKey is 1 for the actions used by the project, 2 for the actions
+	// used by these actions, etc.
+	DepthKey = "depth"
+	// ScoreKey is the average score of the repository.
+	ScoreKey = "score"
+
+	lowScore = 5
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	actions := raw.ThirdPartyActionsResults.Actions
+	if actions == nil {
+		f, err := finding.NewWith(fs, Probe,
+			"third-party actions were not scored", nil,
+			finding.OutcomeNotAvailable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+	if len(actions) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no third-party actions detected", nil,
+			finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	findings := make([]finding.Finding, 0, len(actions))
+	for i := range actions {
+		f, err := actionFinding(&actions[i])
+		if err != nil {
+			return nil, Probe, err
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
+
+func actionFinding(a *checker.ThirdPartyAction) (*finding.Finding, error) {
+	var loc *finding.Location
+	// The locations of the actions used by other actions are
+	// in the other repositories.
+	if a.Depth == 1 && len(a.Locations) > 0 {
+		loc = a.Locations[0].Location()
+	}
+
+	var f *finding.Finding
+	var err error
+	score, ok := a.Score()
+	switch {
+	case a.Error != "":
+		f, err = finding.NewNotAvailable(fs, Probe,
+			fmt.Sprintf("action repository %s was not scored: %s", a.Repo, a.Error), loc)
+	case !ok:
+		f, err = finding.NewNotAvailable(fs, Probe,
+			fmt.Sprintf("action repository %s has no conclusive score", a.Repo), loc)
+	case score < lowScore:
+		f, err = finding.NewNegative(fs, Probe,
+			fmt.Sprintf("action repository %s has a low score: %.1f", a.Repo, score), loc)
+	default:
+		f, err = finding.NewPositive(fs, Probe,
+			fmt.Sprintf("action repository %s has a score of %.1f", a.Repo, score), loc)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create finding: %w", err)
+	}
+	f = f.WithValue(RepoKey, a.Repo).
+		WithValue(DepthKey, strconv.Itoa(a.Depth))
+	if a.UsedBy != "" {
+		f = f.WithValue(UsedByKey, a.UsedBy)
+	}
+	if ok {
+		f = f.WithValue(ScoreKey, strconv.FormatFloat(score, 'f', 1, 64))
+	}
+	return f, nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package actionsFromLowScoringRepos
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name: "not scored",
+			raw:  &checker.RawResults{},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotAvailable,
+			},
+		},
+		{
+			name: "no third-party actions",
+			raw: &checker.RawResults{
+				ThirdPartyActionsResults: checker.ThirdPartyActionsData{
+					Actions: []checker.ThirdPartyAction{},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "high and low scores",
+			raw: &checker.RawResults{
+				ThirdPartyActionsResults: checker.ThirdPartyActionsData{
+					Actions: []checker.ThirdPartyAction{
+						{
+							Repo:      "actions/checkout",
+							Depth:     1,
+							Scores:    map[string]int{"Maintained": 10, "Contributors": 10},
+							Locations: []checker.File{{Path: ".github/workflows/ci.yml", Offset: 5}},
+						},
+						{
+							Repo:   "someone/abandoned-action",
+							UsedBy: "actions/checkout",
+							Depth:  2,
+							Scores: map[string]int{"Maintained": 0, "Contributors": 3},
+						},
+						{
+							Repo:   "someone/threshold-action",
+							Depth:  1,
+							Scores: map[string]int{"Maintained": 5, "Contributors": -1},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomePositive,
+				finding.OutcomeNegative,
+				finding.OutcomePositive,
+			},
+		},
+		{
+			name: "not scored repositories",
+			raw: &checker.RawResults{
+				ThirdPartyActionsResults: checker.ThirdPartyActionsData{
+					Actions: []checker.ThirdPartyAction{
+						{
+							Repo:  "someone/action",
+							Depth: 1,
+							Error: "limit of 20 scored repositories reached",
+						},
+						{
+							Repo:   "someone/other-action",
+							Depth:  1,
+							Scores: map[string]int{"Maintained": -1},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotAvailable,
+				finding.OutcomeNotAvailable,
+			},
+		},
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(Probe, s); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+		})
+	}
+}
+
+func TestRun_values(t *testing.T) {
+	t.Parallel()
+	raw := &checker.RawResults{
+		ThirdPartyActionsResults: checker.ThirdPartyActionsData{
+			Actions: []checker.ThirdPartyAction{
+				{
+					Repo:      "actions/checkout",
+					Depth:     1,
+					Scores:    map[string]int{"Maintained": 10, "Contributors": 3},
+					Locations: []checker.File{{Path: ".github/workflows/ci.yml", Offset: 5}},
+				},
+				{
+					Repo:   "someone/abandoned-action",
+					UsedBy: "actions/checkout",
+					Depth:  2,
+					Scores: map[string]int{"Maintained": 0},
+				},
+			},
+		},
+	}
+	findings, _, err := Run(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []map[string]string{
+		{RepoKey: "actions/checkout", DepthKey: "1", ScoreKey: "6.5"},
+		{RepoKey: "someone/abandoned-action", DepthKey: "2", UsedByKey: "actions/checkout", ScoreKey: "0.0"},
+	}
+	got := make([]map[string]string, 0, len(findings))
+	for i := range findings {
+		got = append(got, findings[i].Values)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+	if findings[0].Location == nil || findings[0].Location.Path != ".github/workflows/ci.yml" {
+		t.Errorf("expected the location of the workflow, got %v", findings[0].Location)
+	}
+	if findings[1].Location != nil {
+		t.Errorf("expected no location for an action used by another action, got %v", findings[1].Location)
+	}
+}
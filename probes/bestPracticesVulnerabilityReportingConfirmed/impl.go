@@ -0,0 +1,48 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package bestPracticesVulnerabilityReportingConfirmed
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/bestpractices"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "bestPracticesVulnerabilityReportingConfirmed"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+	//nolint:wrapcheck
+	return bestpractices.Run(raw, fs, Probe, "vulnerability_report_process", "vulnerability reporting process", evidence)
+}
+
+func evidence(raw *checker.RawResults) (known, confirmed bool) {
+	r := &raw.SecurityPolicyResults
+	pvr := r.PrivateVulnerabilityReportingEnabled
+	if len(r.PolicyFiles) == 0 && pvr == nil && r.SecurityAdvisories == nil {
+		return false, false
+	}
+	return true, len(r.PolicyFiles) > 0 || (pvr != nil && *pvr)
+}
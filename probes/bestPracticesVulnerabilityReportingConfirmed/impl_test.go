@@ -0,0 +1,124 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package bestPracticesVulnerabilityReportingConfirmed
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	enabled := true
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name: "claim confirmed",
+			raw: &checker.RawResults{
+				CIIBestPracticesResults: checker.CIIBestPracticesData{
+					Criteria: clients.BadgeCriteria{"vulnerability_report_process": clients.CriterionMet},
+				},
+				SecurityPolicyResults: checker.SecurityPolicyData{
+					PrivateVulnerabilityReportingEnabled: &enabled,
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomePositive,
+			},
+		},
+		{
+			name: "claim not confirmed",
+			raw: &checker.RawResults{
+				CIIBestPracticesResults: checker.CIIBestPracticesData{
+					Criteria: clients.BadgeCriteria{"vulnerability_report_process": clients.CriterionMet},
+				},
+				SecurityPolicyResults: checker.SecurityPolicyData{
+					SecurityAdvisories: []clients.SecurityAdvisory{},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNegative,
+			},
+		},
+		{
+			name: "results not available",
+			raw: &checker.RawResults{
+				CIIBestPracticesResults: checker.CIIBestPracticesData{
+					Criteria: clients.BadgeCriteria{"vulnerability_report_process": clients.CriterionMet},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotAvailable,
+			},
+		},
+		{
+			name: "criterion not claimed",
+			raw: &checker.RawResults{
+				CIIBestPracticesResults: checker.CIIBestPracticesData{
+					Criteria: clients.BadgeCriteria{"vulnerability_report_process": clients.CriterionUnmet},
+				},
+				SecurityPolicyResults: checker.SecurityPolicyData{
+					PrivateVulnerabilityReportingEnabled: &enabled,
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "no badge",
+			raw:  &checker.RawResults{},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(Probe, s); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package codeApprovedAfterLastPush
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/codereview"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "codeApprovedAfterLastPush"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+	//nolint:wrapcheck
+	return codereview.Run(raw, fs, Probe, approvedAfterLastPush, codereview.Texts{
+		NotApplicable: "no approvals with a known submission time",
+		Negative:      "changeset %s was changed after its last approval",
+		Positive:      "%d changesets were approved after their last push",
+	})
+}
+
+func approvedAfterLastPush(c *checker.Changeset) (applicable, ok bool) {
+	for _, review := range codereview.Approvals(c) {
+		known, current := isCurrent(c, &review)
+		if !known {
+			continue
+		}
+		applicable = true
+		if current {
+			return true, true
+		}
+	}
+	return applicable, false
+}
+
+// isCurrent reports whether the review was submitted for the head of the
+// changeset, preferring the reviewed commit over timestamps when known.
+func isCurrent(c *checker.Changeset, review *clients.Review) (known, current bool) {
+	switch {
+	case review.CommitSHA != "" && c.HeadSHA != "":
+		return true, review.CommitSHA == c.HeadSHA
+	case !review.SubmittedAt.IsZero() && !c.HeadCommittedAt.IsZero():
+		return true, !review.SubmittedAt.Before(c.HeadCommittedAt)
+	default:
+		return false, false
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package codeApprovedByHuman
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/codereview"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "codeApprovedByHuman"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+	//nolint:wrapcheck
+	return codereview.Run(raw, fs, Probe, approvedByHuman, codereview.Texts{
+		NotApplicable: "no approved changesets",
+		Negative:      "changeset %s was only approved by bots",
+		Positive:      "%d approved changesets were approved by a human",
+	})
+}
+
+func approvedByHuman(c *checker.Changeset) (applicable, ok bool) {
+	approvals := codereview.Approvals(c)
+	if len(approvals) == 0 {
+		return false, false
+	}
+	for i := range approvals {
+		if !approvals[i].Author.IsBot {
+			return true, true
+		}
+	}
+	return true, false
+}
@@ -0,0 +1,151 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package codeApprovedByHuman
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name: "no approvals",
+			raw: &checker.RawResults{
+				CodeReviewResults: checker.CodeReviewData{
+					DefaultBranchChangesets: []checker.Changeset{
+						{
+							RevisionID: "1",
+							Author:     clients.User{Login: "alice"},
+							Reviews: []clients.Review{
+								{Author: &clients.User{Login: "bob"}, State: "COMMENTED"},
+								{Author: &clients.User{Login: "alice"}, State: "APPROVED"},
+							},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeNotApplicable},
+		},
+		{
+			name: "approved by a human and a bot",
+			raw: &checker.RawResults{
+				CodeReviewResults: checker.CodeReviewData{
+					DefaultBranchChangesets: []checker.Changeset{
+						{
+							RevisionID: "1",
+							Author:     clients.User{Login: "alice"},
+							Reviews: []clients.Review{
+								{Author: &clients.User{Login: "approve-bot", IsBot: true}, State: "APPROVED"},
+								{Author: &clients.User{Login: "bob"}, State: "APPROVED"},
+							},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomePositive},
+		},
+		{
+			name: "approved only by bots",
+			raw: &checker.RawResults{
+				CodeReviewResults: checker.CodeReviewData{
+					DefaultBranchChangesets: []checker.Changeset{
+						{
+							RevisionID: "1",
+							Author:     clients.User{Login: "alice"},
+							Reviews: []clients.Review{
+								{Author: &clients.User{Login: "approve-bot", IsBot: true}, State: "APPROVED"},
+							},
+						},
+						{
+							RevisionID: "2",
+							Author:     clients.User{Login: "alice"},
+							Reviews: []clients.Review{
+								{Author: &clients.User{Login: "bob"}, State: "APPROVED"},
+							},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeNegative},
+		},
+		{
+			name: "implicit approval of the merger is not counted",
+			raw: &checker.RawResults{
+				CodeReviewResults: checker.CodeReviewData{
+					DefaultBranchChangesets: []checker.Changeset{
+						{
+							ReviewPlatform: checker.ReviewPlatformGitHub,
+							RevisionID:     "1",
+							Commits: []clients.Commit{
+								{
+									AssociatedMergeRequest: clients.PullRequest{
+										Reviews: []clients.Review{
+											{Author: &clients.User{Login: "approve-bot", IsBot: true}, State: "APPROVED"},
+										},
+									},
+								},
+							},
+							Author: clients.User{Login: "alice"},
+							Reviews: []clients.Review{
+								{Author: &clients.User{Login: "approve-bot", IsBot: true}, State: "APPROVED"},
+								{Author: &clients.User{Login: "bob"}, State: "APPROVED"},
+							},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeNegative},
+		},
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(Probe, s); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package codeNotSelfApproved
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/codereview"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "codeNotSelfApproved"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+	//nolint:wrapcheck
+	return codereview.Run(raw, fs, Probe, notSelfApproved, codereview.Texts{
+		NotApplicable: "no approvals with known author emails",
+		Negative:      "changeset %s was approved by an account sharing an email with its author",
+		Positive:      "%d changesets were approved by accounts not sharing an email with the author",
+	})
+}
+
+func notSelfApproved(c *checker.Changeset) (applicable, ok bool) {
+	emails := authorEmails(c)
+	approvals := codereview.Approvals(c)
+	if len(emails) == 0 || len(approvals) == 0 {
+		return false, false
+	}
+	for i := range approvals {
+		if _, found := emails[strings.ToLower(approvals[i].Author.Email)]; found {
+			return true, false
+		}
+	}
+	return true, true
+}
+
+// authorEmails returns the emails of the changeset author: the public email
+// of their account, and the emails of the commits they pushed.
+func authorEmails(c *checker.Changeset) map[string]struct{} {
+	emails := map[string]struct{}{}
+	if c.Author.Email != "" {
+		emails[strings.ToLower(c.Author.Email)] = struct{}{}
+	}
+	for _, u := range c.CommitAuthors {
+		if u.Email != "" && (u.Login == "" || u.Login == c.Author.Login) {
+			emails[strings.ToLower(u.Email)] = struct{}{}
+		}
+	}
+	return emails
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package codeNotSelfMerged
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/codereview"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "codeNotSelfMerged"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+	//nolint:wrapcheck
+	return codereview.Run(raw, fs, Probe, notSelfMerged, codereview.Texts{
+		NotApplicable: "no changesets with a known merger",
+		Negative:      "changeset %s was merged by its author without approval",
+		Positive:      "%d changesets were approved or merged by someone other than the author",
+	})
+}
+
+func notSelfMerged(c *checker.Changeset) (applicable, ok bool) {
+	if c.MergedBy.Login == "" || c.Author.Login == "" {
+		return false, false
+	}
+	return true, c.MergedBy.Login != c.Author.Login || len(codereview.Approvals(c)) > 0
+}
@@ -0,0 +1,97 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package declaredLicensesAreValidSPDX
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "declaredLicensesAreValidSPDX"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	manifests := raw.LicenseResults.ManifestLicenses
+	headers := raw.LicenseResults.HeaderLicenses
+	if len(manifests) == 0 && len(headers) == 0 {
+		f, err := finding.NewWith(fs, Probe, "no license declared in package manifests or source file headers",
+			nil, finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	var findings []finding.Finding
+	for i := range manifests {
+		m := &manifests[i]
+		var f *finding.Finding
+		var err error
+		if m.Valid {
+			f, err = finding.NewPositive(fs, Probe,
+				fmt.Sprintf("license '%s' is a valid SPDX expression", m.Expression), m.File.Location())
+		} else {
+			f, err = finding.NewNegative(fs, Probe,
+				fmt.Sprintf("license '%s' is not a valid SPDX expression", m.Expression), m.File.Location())
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+
+	if len(headers) == 0 {
+		return findings, Probe, nil
+	}
+	// Report each invalid header expression once, at its first occurrence.
+	invalid := make(map[string]int)
+	for i := range headers {
+		h := &headers[i]
+		if h.Valid {
+			continue
+		}
+		invalid[h.Expression]++
+		if invalid[h.Expression] > 1 {
+			continue
+		}
+		f, err := finding.NewNegative(fs, Probe,
+			fmt.Sprintf("SPDX-License-Identifier '%s' is not a valid SPDX expression", h.Expression),
+			h.File.Location())
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	if len(invalid) == 0 {
+		f, err := finding.NewPositive(fs, Probe,
+			fmt.Sprintf("%d SPDX-License-Identifier headers are valid SPDX expressions", len(headers)), nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package declaredLicensesAreValidSPDX
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name: "valid and invalid manifests",
+			raw: &checker.RawResults{
+				LicenseResults: checker.LicenseData{
+					ManifestLicenses: []checker.LicenseDeclaration{
+						{
+							File:       checker.File{Path: "package.json"},
+							Expression: "MIT OR Apache-2.0",
+							SpdxIDs:    []string{"MIT", "Apache-2.0"},
+							Valid:      true,
+						},
+						{
+							File:       checker.File{Path: "pom.xml"},
+							Expression: "Apache 2",
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomePositive,
+				finding.OutcomeNegative,
+			},
+		},
+		{
+			name: "valid headers",
+			raw: &checker.RawResults{
+				LicenseResults: checker.LicenseData{
+					HeaderLicenses: []checker.LicenseDeclaration{
+						{File: checker.File{Path: "a.go"}, Expression: "MIT", SpdxIDs: []string{"MIT"}, Valid: true},
+						{File: checker.File{Path: "b.go"}, Expression: "MIT", SpdxIDs: []string{"MIT"}, Valid: true},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomePositive,
+			},
+		},
+		{
+			name: "invalid headers reported once per expression",
+			raw: &checker.RawResults{
+				LicenseResults: checker.LicenseData{
+					HeaderLicenses: []checker.LicenseDeclaration{
+						{File: checker.File{Path: "a.go"}, Expression: "MIT/X11"},
+						{File: checker.File{Path: "b.go"}, Expression: "MIT/X11"},
+						{File: checker.File{Path: "c.go"}, Expression: "MIT", SpdxIDs: []string{"MIT"}, Valid: true},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNegative,
+			},
+		},
+		{
+			name: "no declaration",
+			raw:  &checker.RawResults{},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(Probe, s); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+		})
+	}
+}
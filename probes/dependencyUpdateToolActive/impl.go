@@ -0,0 +1,91 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package dependencyUpdateToolActive
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe          = "dependencyUpdateToolActive"
+	ToolKey        = "tool"
+	LookbackDayKey = "lookBackDays"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	lookBackDays := raw.DependencyUpdateToolResults.LookBack()
+	threshold := time.Now().AddDate(0 /*years*/, 0 /*months*/, -lookBackDays /*days*/)
+	var findings []finding.Finding
+	tools := raw.DependencyUpdateToolResults.Tools
+	for i := range tools {
+		tool := &tools[i]
+		// Only tools with a configuration file can be configured but inactive.
+		if len(tool.Files) == 0 || tool.Files[0].Path == "" || tool.Activity == nil {
+			continue
+		}
+		var text string
+		var outcome finding.Outcome
+		if active(tool.Activity, threshold) {
+			text = fmt.Sprintf("%s merged updates within the last %d days", tool.Name, lookBackDays)
+			outcome = finding.OutcomePositive
+		} else {
+			text = fmt.Sprintf("%s is configured but merged no updates within the last %d days", tool.Name, lookBackDays)
+			outcome = finding.OutcomeNegative
+		}
+		f, err := finding.NewWith(fs, Probe, text, tool.Files[0].Location(), outcome)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValues(map[string]string{
+			ToolKey:        tool.Name,
+			LookbackDayKey: strconv.Itoa(lookBackDays),
+		})
+		findings = append(findings, *f)
+	}
+	if len(findings) > 0 {
+		return findings, Probe, nil
+	}
+
+	f, err := finding.NewWith(fs, Probe,
+		"no configured dependency update tool with known activity", nil, finding.OutcomeNotApplicable)
+	if err != nil {
+		return nil, Probe, fmt.Errorf("create finding: %w", err)
+	}
+	return []finding.Finding{*f}, Probe, nil
+}
+
+func active(a *checker.ToolActivity, threshold time.Time) bool {
+	for i := range a.Commits {
+		if a.Commits[i].CommittedDate.After(threshold) {
+			return true
+		}
+	}
+	return false
+}
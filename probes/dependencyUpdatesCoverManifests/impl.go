@@ -0,0 +1,98 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package dependencyUpdatesCoverManifests
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe        = "dependencyUpdatesCoverManifests"
+	EcosystemKey = "ecosystem"
+	DirectoryKey = "directory"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := raw.DependencyUpdateToolResults
+	var coverage []checker.DependencyUpdateCoverage
+	configured := false
+	for i := range r.Tools {
+		if r.Tools[i].Coverage != nil {
+			configured = true
+			coverage = append(coverage, r.Tools[i].Coverage...)
+		}
+	}
+	if !configured || len(r.Manifests) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no parsable dependency update configuration or no dependency manifests found",
+			nil, finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	var findings []finding.Finding
+	for i := range r.Manifests {
+		m := &r.Manifests[i]
+		if covered(coverage, m) {
+			continue
+		}
+		f, err := finding.NewWith(fs, Probe,
+			fmt.Sprintf("%s dependencies in %s are not updated by any configured tool", m.Ecosystem, m.Directory),
+			m.File.Location(), finding.OutcomeNegative)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValues(map[string]string{
+			EcosystemKey: m.Ecosystem,
+			DirectoryKey: m.Directory,
+		})
+		findings = append(findings, *f)
+	}
+	if len(findings) > 0 {
+		return findings, Probe, nil
+	}
+
+	f, err := finding.NewWith(fs, Probe,
+		fmt.Sprintf("all %d dependency manifests are covered by the configured tools", len(r.Manifests)),
+		nil, finding.OutcomePositive)
+	if err != nil {
+		return nil, Probe, fmt.Errorf("create finding: %w", err)
+	}
+	return []finding.Finding{*f}, Probe, nil
+}
+
+func covered(coverage []checker.DependencyUpdateCoverage, m *checker.DependencyManifest) bool {
+	for i := range coverage {
+		if coverage[i].Covers(m) {
+			return true
+		}
+	}
+	return false
+}
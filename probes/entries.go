@@ -19,14 +19,32 @@ import (
 
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/actionsCannotApprovePullRequests"
+	"github.com/ossf/scorecard/v4/probes/actionsFromLowScoringRepos"
+	"github.com/ossf/scorecard/v4/probes/bestPracticesContinuousIntegrationConfirmed"
+	"github.com/ossf/scorecard/v4/probes/bestPracticesStaticAnalysisConfirmed"
+	"github.com/ossf/scorecard/v4/probes/bestPracticesVulnerabilityReportingConfirmed"
 	"github.com/ossf/scorecard/v4/probes/blocksDeleteOnBranches"
 	"github.com/ossf/scorecard/v4/probes/blocksForcePushOnBranches"
 	"github.com/ossf/scorecard/v4/probes/branchProtectionAppliesToAdmins"
 	"github.com/ossf/scorecard/v4/probes/branchesAreProtected"
 	"github.com/ossf/scorecard/v4/probes/codeApproved"
+	"github.com/ossf/scorecard/v4/probes/codeApprovedAfterLastPush"
+	"github.com/ossf/scorecard/v4/probes/codeApprovedByHuman"
+	"github.com/ossf/scorecard/v4/probes/codeNotSelfApproved"
+	"github.com/ossf/scorecard/v4/probes/codeNotSelfMerged"
 	"github.com/ossf/scorecard/v4/probes/codeReviewOneReviewers"
+	"github.com/ossf/scorecard/v4/probes/codeScanningEnabled"
+	"github.com/ossf/scorecard/v4/probes/codeownersFileIsValid"
 	"github.com/ossf/scorecard/v4/probes/contributorsFromOrgOrCompany"
+	"github.com/ossf/scorecard/v4/probes/declaredLicensesAreValidSPDX"
+	"github.com/ossf/scorecard/v4/probes/dependencyAlertsEnabled"
+	"github.com/ossf/scorecard/v4/probes/dependencyManifestsHaveCodeOwners"
+	"github.com/ossf/scorecard/v4/probes/dependencySecurityUpdatesEnabled"
+	"github.com/ossf/scorecard/v4/probes/dependencyUpdateToolActive"
+	"github.com/ossf/scorecard/v4/probes/dependencyUpdatesCoverManifests"
 	"github.com/ossf/scorecard/v4/probes/dismissesStaleReviews"
+	"github.com/ossf/scorecard/v4/probes/dockerfilesHaveCodeOwners"
 	"github.com/ossf/scorecard/v4/probes/freeOfUnverifiedBinaryArtifacts"
 	"github.com/ossf/scorecard/v4/probes/fuzzedWithCLibFuzzer"
 	"github.com/ossf/scorecard/v4/probes/fuzzedWithClusterFuzzLite"
@@ -40,19 +58,47 @@ import (
 	"github.com/ossf/scorecard/v4/probes/fuzzedWithPythonAtheris"
 	"github.com/ossf/scorecard/v4/probes/fuzzedWithRustCargofuzz"
 	"github.com/ossf/scorecard/v4/probes/fuzzedWithSwiftLibFuzzer"
+	"github.com/ossf/scorecard/v4/probes/fuzzingRunsContinuously"
+	"github.com/ossf/scorecard/v4/probes/hasDangerousWorkflowGitHubEnvInjection"
 	"github.com/ossf/scorecard/v4/probes/hasDangerousWorkflowScriptInjection"
+	"github.com/ossf/scorecard/v4/probes/hasDangerousWorkflowSecretsInherit"
+	"github.com/ossf/scorecard/v4/probes/hasDangerousWorkflowUnprotectedVariables"
 	"github.com/ossf/scorecard/v4/probes/hasDangerousWorkflowUntrustedCheckout"
 	"github.com/ossf/scorecard/v4/probes/hasFSFOrOSIApprovedLicense"
+	"github.com/ossf/scorecard/v4/probes/hasFuzzTargets"
+	"github.com/ossf/scorecard/v4/probes/hasInstallScriptDownloads"
+	"github.com/ossf/scorecard/v4/probes/hasInstallScripts"
 	"github.com/ossf/scorecard/v4/probes/hasLicenseFile"
 	"github.com/ossf/scorecard/v4/probes/hasLicenseFileAtTopDir"
 	"github.com/ossf/scorecard/v4/probes/hasOSVVulnerabilities"
 	"github.com/ossf/scorecard/v4/probes/hasOpenSSFBadge"
 	"github.com/ossf/scorecard/v4/probes/hasRecentCommits"
+	"github.com/ossf/scorecard/v4/probes/hasRecentReleases"
+	"github.com/ossf/scorecard/v4/probes/hasSelfHostedRunnerExposedToForks"
+	"github.com/ossf/scorecard/v4/probes/idTokenWriteIsJobLevel"
 	"github.com/ossf/scorecard/v4/probes/issueActivityByProjectMember"
+	"github.com/ossf/scorecard/v4/probes/jobTokenAccessIsRestricted"
+	"github.com/ossf/scorecard/v4/probes/licenseDeclarationsAreConsistent"
 	"github.com/ossf/scorecard/v4/probes/notArchived"
 	"github.com/ossf/scorecard/v4/probes/notCreatedRecently"
+	"github.com/ossf/scorecard/v4/probes/notDeprecated"
+	"github.com/ossf/scorecard/v4/probes/packagedAsContainerImage"
+	"github.com/ossf/scorecard/v4/probes/packagedAsHelmChart"
+	"github.com/ossf/scorecard/v4/probes/packagedToCratesIO"
+	"github.com/ossf/scorecard/v4/probes/packagedToMaven"
+	"github.com/ossf/scorecard/v4/probes/packagedToNpm"
+	"github.com/ossf/scorecard/v4/probes/packagedToNuGet"
+	"github.com/ossf/scorecard/v4/probes/packagedToPyPI"
+	"github.com/ossf/scorecard/v4/probes/packagedToRubyGems"
 	"github.com/ossf/scorecard/v4/probes/packagedWithAutomatedWorkflow"
+	"github.com/ossf/scorecard/v4/probes/packagedWithGoReleaser"
 	"github.com/ossf/scorecard/v4/probes/pinsDependencies"
+	"github.com/ossf/scorecard/v4/probes/privateVulnerabilityReportingEnabled"
+	"github.com/ossf/scorecard/v4/probes/publishesSecurityAdvisories"
+	"github.com/ossf/scorecard/v4/probes/publishesWithTrustedPublishing"
+	"github.com/ossf/scorecard/v4/probes/releaseEnvironmentRequiresReviewers"
+	"github.com/ossf/scorecard/v4/probes/releaseJobsUseProtectedEnvironment"
+	"github.com/ossf/scorecard/v4/probes/releaseScriptsHaveCodeOwners"
 	"github.com/ossf/scorecard/v4/probes/releasesAreSigned"
 	"github.com/ossf/scorecard/v4/probes/releasesHaveProvenance"
 	"github.com/ossf/scorecard/v4/probes/requiresApproversForPullRequests"
@@ -60,18 +106,29 @@ import (
 	"github.com/ossf/scorecard/v4/probes/requiresLastPushApproval"
 	"github.com/ossf/scorecard/v4/probes/requiresPRsToChangeCode"
 	"github.com/ossf/scorecard/v4/probes/requiresUpToDateBranches"
+	"github.com/ossf/scorecard/v4/probes/respondsToIssues"
 	"github.com/ossf/scorecard/v4/probes/runsStatusChecksBeforeMerging"
 	"github.com/ossf/scorecard/v4/probes/sastToolConfigured"
 	"github.com/ossf/scorecard/v4/probes/sastToolRunsOnAllCommits"
+	"github.com/ossf/scorecard/v4/probes/secretPushProtectionEnabled"
+	"github.com/ossf/scorecard/v4/probes/secretScanningEnabled"
 	"github.com/ossf/scorecard/v4/probes/securityPolicyContainsLinks"
 	"github.com/ossf/scorecard/v4/probes/securityPolicyContainsText"
 	"github.com/ossf/scorecard/v4/probes/securityPolicyContainsVulnerabilityDisclosure"
+	"github.com/ossf/scorecard/v4/probes/securityPolicyDeclaresSupportedVersions"
+	"github.com/ossf/scorecard/v4/probes/securityPolicyNotExpired"
 	"github.com/ossf/scorecard/v4/probes/securityPolicyPresent"
+	"github.com/ossf/scorecard/v4/probes/submoduleCommitsAreReachable"
+	"github.com/ossf/scorecard/v4/probes/submodulesFromTrustedHosts"
 	"github.com/ossf/scorecard/v4/probes/testsRunInCI"
 	"github.com/ossf/scorecard/v4/probes/toolDependabotInstalled"
 	"github.com/ossf/scorecard/v4/probes/toolPyUpInstalled"
 	"github.com/ossf/scorecard/v4/probes/toolRenovateInstalled"
+	"github.com/ossf/scorecard/v4/probes/webhooksHaveMinimalEvents"
 	"github.com/ossf/scorecard/v4/probes/webhooksUseSecrets"
+	"github.com/ossf/scorecard/v4/probes/webhooksVerifyTLS"
+	"github.com/ossf/scorecard/v4/probes/workflowTokenIsReadOnlyByDefault"
+	"github.com/ossf/scorecard/v4/probes/workflowsHaveCodeOwners"
 )
 
 // ProbeImpl is the implementation of a probe.
@@ -88,6 +145,12 @@ var (
 		securityPolicyContainsVulnerabilityDisclosure.Run,
 		securityPolicyContainsText.Run,
 	}
+	// SecurityPolicyDisclosureChannels are the probes the SecurityPolicy
+	// check runs in experimental mode to credit forge reporting channels.
+	SecurityPolicyDisclosureChannels = []ProbeImpl{
+		privateVulnerabilityReportingEnabled.Run,
+		publishesSecurityAdvisories.Run,
+	}
 	// DependencyToolUpdates is all the probes for the
 	// DependencyUpdateTool check.
 	DependencyToolUpdates = []ProbeImpl{
@@ -134,6 +197,9 @@ var (
 	DangerousWorkflows = []ProbeImpl{
 		hasDangerousWorkflowScriptInjection.Run,
 		hasDangerousWorkflowUntrustedCheckout.Run,
+		hasDangerousWorkflowGitHubEnvInjection.Run,
+		hasDangerousWorkflowSecretsInherit.Run,
+		hasDangerousWorkflowUnprotectedVariables.Run,
 	}
 
 	Maintained = []ProbeImpl{
@@ -141,6 +207,8 @@ var (
 		hasRecentCommits.Run,
 		issueActivityByProjectMember.Run,
 		notCreatedRecently.Run,
+		hasRecentReleases.Run,
+		respondsToIssues.Run,
 	}
 	CIIBestPractices = []ProbeImpl{
 		hasOpenSSFBadge.Run,
@@ -150,6 +218,8 @@ var (
 	}
 	Webhook = []ProbeImpl{
 		webhooksUseSecrets.Run,
+		webhooksVerifyTLS.Run,
+		webhooksHaveMinimalEvents.Run,
 	}
 	CITests = []ProbeImpl{
 		testsRunInCI.Run,
@@ -174,12 +244,29 @@ var (
 	PinnedDependencies = []ProbeImpl{
 		pinsDependencies.Run,
 	}
+	// SecuritySettings are the probes for the Security-Settings check.
+	SecuritySettings = []ProbeImpl{
+		secretScanningEnabled.Run,
+		secretPushProtectionEnabled.Run,
+		dependencyAlertsEnabled.Run,
+		dependencySecurityUpdatesEnabled.Run,
+		codeScanningEnabled.Run,
+	}
+	// InstallScripts are the probes for the Install-Scripts check.
+	InstallScripts = []ProbeImpl{
+		hasInstallScripts.Run,
+		hasInstallScriptDownloads.Run,
+	}
 
 	probeRunners = map[string]func(*checker.RawResults) ([]finding.Finding, string, error){
 		securityPolicyPresent.Probe:                         securityPolicyPresent.Run,
 		securityPolicyContainsLinks.Probe:                   securityPolicyContainsLinks.Run,
 		securityPolicyContainsVulnerabilityDisclosure.Probe: securityPolicyContainsVulnerabilityDisclosure.Run,
 		securityPolicyContainsText.Probe:                    securityPolicyContainsText.Run,
+		securityPolicyNotExpired.Probe:                      securityPolicyNotExpired.Run,
+		securityPolicyDeclaresSupportedVersions.Probe:       securityPolicyDeclaresSupportedVersions.Run,
+		privateVulnerabilityReportingEnabled.Probe:          privateVulnerabilityReportingEnabled.Run,
+		publishesSecurityAdvisories.Probe:                   publishesSecurityAdvisories.Run,
 		toolRenovateInstalled.Probe:                         toolRenovateInstalled.Run,
 		toolDependabotInstalled.Probe:                       toolDependabotInstalled.Run,
 		toolPyUpInstalled.Probe:                             toolPyUpInstalled.Run,
@@ -195,19 +282,72 @@ var (
 		fuzzedWithPropertyBasedHaskell.Probe:                fuzzedWithPropertyBasedHaskell.Run,
 		fuzzedWithPropertyBasedTypescript.Probe:             fuzzedWithPropertyBasedTypescript.Run,
 		fuzzedWithPropertyBasedJavascript.Probe:             fuzzedWithPropertyBasedJavascript.Run,
+		hasFuzzTargets.Probe:                                hasFuzzTargets.Run,
+		fuzzingRunsContinuously.Probe:                       fuzzingRunsContinuously.Run,
 		packagedWithAutomatedWorkflow.Probe:                 packagedWithAutomatedWorkflow.Run,
 		hasLicenseFile.Probe:                                hasLicenseFile.Run,
 		hasFSFOrOSIApprovedLicense.Probe:                    hasFSFOrOSIApprovedLicense.Run,
 		hasLicenseFileAtTopDir.Probe:                        hasLicenseFileAtTopDir.Run,
+		declaredLicensesAreValidSPDX.Probe:                  declaredLicensesAreValidSPDX.Run,
+		licenseDeclarationsAreConsistent.Probe:              licenseDeclarationsAreConsistent.Run,
 		contributorsFromOrgOrCompany.Probe:                  contributorsFromOrgOrCompany.Run,
 		hasOSVVulnerabilities.Probe:                         hasOSVVulnerabilities.Run,
 		sastToolRunsOnAllCommits.Probe:                      sastToolRunsOnAllCommits.Run,
 		hasDangerousWorkflowScriptInjection.Probe:           hasDangerousWorkflowScriptInjection.Run,
 		hasDangerousWorkflowUntrustedCheckout.Probe:         hasDangerousWorkflowUntrustedCheckout.Run,
+		hasDangerousWorkflowGitHubEnvInjection.Probe:        hasDangerousWorkflowGitHubEnvInjection.Run,
+		hasDangerousWorkflowSecretsInherit.Probe:            hasDangerousWorkflowSecretsInherit.Run,
+		hasDangerousWorkflowUnprotectedVariables.Probe:      hasDangerousWorkflowUnprotectedVariables.Run,
+		hasSelfHostedRunnerExposedToForks.Probe:             hasSelfHostedRunnerExposedToForks.Run,
 		notArchived.Probe:                                   notArchived.Run,
 		hasRecentCommits.Probe:                              hasRecentCommits.Run,
 		issueActivityByProjectMember.Probe:                  issueActivityByProjectMember.Run,
+		hasRecentReleases.Probe:                             hasRecentReleases.Run,
+		respondsToIssues.Probe:                              respondsToIssues.Run,
+		notDeprecated.Probe:                                 notDeprecated.Run,
+		codeNotSelfMerged.Probe:                             codeNotSelfMerged.Run,
+		codeApprovedByHuman.Probe:                           codeApprovedByHuman.Run,
+		codeApprovedAfterLastPush.Probe:                     codeApprovedAfterLastPush.Run,
+		codeNotSelfApproved.Probe:                           codeNotSelfApproved.Run,
+		packagedToNpm.Probe:                                 packagedToNpm.Run,
+		packagedToPyPI.Probe:                                packagedToPyPI.Run,
+		packagedToCratesIO.Probe:                            packagedToCratesIO.Run,
+		packagedToMaven.Probe:                               packagedToMaven.Run,
+		packagedToRubyGems.Probe:                            packagedToRubyGems.Run,
+		packagedToNuGet.Probe:                               packagedToNuGet.Run,
+		packagedWithGoReleaser.Probe:                        packagedWithGoReleaser.Run,
+		packagedAsContainerImage.Probe:                      packagedAsContainerImage.Run,
+		packagedAsHelmChart.Probe:                           packagedAsHelmChart.Run,
+		dependencyUpdatesCoverManifests.Probe:               dependencyUpdatesCoverManifests.Run,
+		dependencyUpdateToolActive.Probe:                    dependencyUpdateToolActive.Run,
+		codeownersFileIsValid.Probe:                         codeownersFileIsValid.Run,
+		workflowsHaveCodeOwners.Probe:                       workflowsHaveCodeOwners.Run,
+		releaseScriptsHaveCodeOwners.Probe:                  releaseScriptsHaveCodeOwners.Run,
+		dockerfilesHaveCodeOwners.Probe:                     dockerfilesHaveCodeOwners.Run,
+		dependencyManifestsHaveCodeOwners.Probe:             dependencyManifestsHaveCodeOwners.Run,
+		webhooksVerifyTLS.Probe:                             webhooksVerifyTLS.Run,
+		webhooksHaveMinimalEvents.Probe:                     webhooksHaveMinimalEvents.Run,
+		bestPracticesStaticAnalysisConfirmed.Probe:          bestPracticesStaticAnalysisConfirmed.Run,
+		bestPracticesVulnerabilityReportingConfirmed.Probe:  bestPracticesVulnerabilityReportingConfirmed.Run,
+		bestPracticesContinuousIntegrationConfirmed.Probe:   bestPracticesContinuousIntegrationConfirmed.Run,
+		secretScanningEnabled.Probe:                         secretScanningEnabled.Run,
+		secretPushProtectionEnabled.Probe:                   secretPushProtectionEnabled.Run,
+		dependencyAlertsEnabled.Probe:                       dependencyAlertsEnabled.Run,
+		dependencySecurityUpdatesEnabled.Probe:              dependencySecurityUpdatesEnabled.Run,
+		codeScanningEnabled.Probe:                           codeScanningEnabled.Run,
+		releaseJobsUseProtectedEnvironment.Probe:            releaseJobsUseProtectedEnvironment.Run,
+		releaseEnvironmentRequiresReviewers.Probe:           releaseEnvironmentRequiresReviewers.Run,
+		publishesWithTrustedPublishing.Probe:                publishesWithTrustedPublishing.Run,
+		idTokenWriteIsJobLevel.Probe:                        idTokenWriteIsJobLevel.Run,
+		actionsFromLowScoringRepos.Probe:                    actionsFromLowScoringRepos.Run,
+		submodulesFromTrustedHosts.Probe:                    submodulesFromTrustedHosts.Run,
+		hasInstallScripts.Probe:                             hasInstallScripts.Run,
+		hasInstallScriptDownloads.Probe:                     hasInstallScriptDownloads.Run,
+		submoduleCommitsAreReachable.Probe:                  submoduleCommitsAreReachable.Run,
 		notCreatedRecently.Probe:                            notCreatedRecently.Run,
+		jobTokenAccessIsRestricted.Probe:                    jobTokenAccessIsRestricted.Run,
+		workflowTokenIsReadOnlyByDefault.Probe:              workflowTokenIsReadOnlyByDefault.Run,
+		actionsCannotApprovePullRequests.Probe:              actionsCannotApprovePullRequests.Run,
 	}
 
 	CheckMap = map[string]string{
@@ -215,6 +355,10 @@ var (
 		securityPolicyContainsLinks.Probe:                   "Security-Policy",
 		securityPolicyContainsVulnerabilityDisclosure.Probe: "Security-Policy",
 		securityPolicyContainsText.Probe:                    "Security-Policy",
+		securityPolicyNotExpired.Probe:                      "Security-Policy",
+		securityPolicyDeclaresSupportedVersions.Probe:       "Security-Policy",
+		privateVulnerabilityReportingEnabled.Probe:          "Security-Policy",
+		publishesSecurityAdvisories.Probe:                   "Security-Policy",
 		toolRenovateInstalled.Probe:                         "Dependency-Update-Tool",
 		toolDependabotInstalled.Probe:                       "Dependency-Update-Tool",
 		toolPyUpInstalled.Probe:                             "Dependency-Update-Tool",
@@ -230,19 +374,80 @@ var (
 		fuzzedWithPropertyBasedHaskell.Probe:                "Fuzzing",
 		fuzzedWithPropertyBasedTypescript.Probe:             "Fuzzing",
 		fuzzedWithPropertyBasedJavascript.Probe:             "Fuzzing",
+		hasFuzzTargets.Probe:                                "Fuzzing",
+		fuzzingRunsContinuously.Probe:                       "Fuzzing",
 		packagedWithAutomatedWorkflow.Probe:                 "Packaging",
 		hasLicenseFile.Probe:                                "License",
 		hasFSFOrOSIApprovedLicense.Probe:                    "License",
 		hasLicenseFileAtTopDir.Probe:                        "License",
+		declaredLicensesAreValidSPDX.Probe:                  "License",
+		licenseDeclarationsAreConsistent.Probe:              "License",
 		contributorsFromOrgOrCompany.Probe:                  "Contributors",
 		hasOSVVulnerabilities.Probe:                         "Vulnerabilities",
 		sastToolRunsOnAllCommits.Probe:                      "SAST",
 		hasDangerousWorkflowScriptInjection.Probe:           "Dangerous-Workflow",
 		hasDangerousWorkflowUntrustedCheckout.Probe:         "Dangerous-Workflow",
+		hasDangerousWorkflowGitHubEnvInjection.Probe:        "Dangerous-Workflow",
+		hasDangerousWorkflowSecretsInherit.Probe:            "Dangerous-Workflow",
+		hasDangerousWorkflowUnprotectedVariables.Probe:      "Dangerous-Workflow",
+		hasSelfHostedRunnerExposedToForks.Probe:             "Dangerous-Workflow",
 		notArchived.Probe:                                   "Maintained",
 		hasRecentCommits.Probe:                              "Maintained",
 		issueActivityByProjectMember.Probe:                  "Maintained",
 		notCreatedRecently.Probe:                            "Maintained",
+		hasRecentReleases.Probe:                             "Maintained",
+		respondsToIssues.Probe:                              "Maintained",
+		notDeprecated.Probe:                                 "Maintained",
+		codeNotSelfMerged.Probe:                             "Code-Review",
+		codeApprovedByHuman.Probe:                           "Code-Review",
+		codeApprovedAfterLastPush.Probe:                     "Code-Review",
+		codeNotSelfApproved.Probe:                           "Code-Review",
+		packagedToNpm.Probe:                                 "Packaging",
+		packagedToPyPI.Probe:                                "Packaging",
+		packagedToCratesIO.Probe:                            "Packaging",
+		packagedToMaven.Probe:                               "Packaging",
+		packagedToRubyGems.Probe:                            "Packaging",
+		packagedToNuGet.Probe:                               "Packaging",
+		packagedWithGoReleaser.Probe:                        "Packaging",
+		packagedAsContainerImage.Probe:                      "Packaging",
+		packagedAsHelmChart.Probe:                           "Packaging",
+		dependencyUpdatesCoverManifests.Probe:               "Dependency-Update-Tool",
+		dependencyUpdateToolActive.Probe:                    "Dependency-Update-Tool",
+		codeownersFileIsValid.Probe:                         "Branch-Protection",
+		workflowsHaveCodeOwners.Probe:                       "Branch-Protection",
+		releaseScriptsHaveCodeOwners.Probe:                  "Branch-Protection",
+		dockerfilesHaveCodeOwners.Probe:                     "Branch-Protection",
+		dependencyManifestsHaveCodeOwners.Probe:             "Branch-Protection",
+		webhooksVerifyTLS.Probe:                             "Webhooks",
+		webhooksHaveMinimalEvents.Probe:                     "Webhooks",
+		bestPracticesStaticAnalysisConfirmed.Probe:          "CII-Best-Practices",
+		bestPracticesVulnerabilityReportingConfirmed.Probe:  "CII-Best-Practices",
+		bestPracticesContinuousIntegrationConfirmed.Probe:   "CII-Best-Practices",
+		secretScanningEnabled.Probe:                         "Security-Settings",
+		secretPushProtectionEnabled.Probe:                   "Security-Settings",
+		dependencyAlertsEnabled.Probe:                       "Security-Settings",
+		dependencySecurityUpdatesEnabled.Probe:              "Security-Settings",
+		codeScanningEnabled.Probe:                           "Security-Settings",
+		releaseJobsUseProtectedEnvironment.Probe:            "Packaging",
+		releaseEnvironmentRequiresReviewers.Probe:           "Packaging",
+		publishesWithTrustedPublishing.Probe:                "Packaging",
+		idTokenWriteIsJobLevel.Probe:                        "Token-Permissions",
+		actionsFromLowScoringRepos.Probe:                    "Pinned-Dependencies",
+		submodulesFromTrustedHosts.Probe:                    "Pinned-Dependencies",
+		hasInstallScripts.Probe:                             "Install-Scripts",
+		hasInstallScriptDownloads.Probe:                     "Install-Scripts",
+		submoduleCommitsAreReachable.Probe:                  "Pinned-Dependencies",
+		jobTokenAccessIsRestricted.Probe:                    "Token-Permissions",
+		workflowTokenIsReadOnlyByDefault.Probe:              "Token-Permissions",
+		actionsCannotApprovePullRequests.Probe:              "Token-Permissions",
+	}
+
+	// DependentChecks lists the checks other than the one in CheckMap
+	// whose raw results a probe needs.
+	DependentChecks = map[string][]string{
+		bestPracticesStaticAnalysisConfirmed.Probe:         {"SAST"},
+		bestPracticesVulnerabilityReportingConfirmed.Probe: {"Security-Policy"},
+		bestPracticesContinuousIntegrationConfirmed.Probe:  {"CI-Tests"},
 	}
 
 	errProbeNotFound = errors.New("probe not found")
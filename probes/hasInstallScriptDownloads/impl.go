@@ -0,0 +1,93 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package hasInstallScriptDownloads
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe = "hasInstallScriptDownloads"
+	// DependencyTypeKey is the type of the download, e.g. `downloadThenRun`.
+	DependencyTypeKey = "dependencyType"
+	// ScriptKey is the name of the install script running the download.
+	ScriptKey = "script"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	scripts := raw.InstallScriptsResults.Scripts
+	if len(scripts) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no install scripts detected", nil,
+			finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	var findings []finding.Finding
+	for i := range scripts {
+		script := &scripts[i]
+		for j := range script.Downloads {
+			d := &script.Downloads[j]
+			loc := d.Location.Location()
+			if loc == nil {
+				loc = script.File.Location()
+			}
+			f, err := finding.NewNegative(fs, Probe,
+				fmt.Sprintf("install script %s of %s runs an unpinned %s", script.Name, script.File.Path, d.Type),
+				loc)
+			if err != nil {
+				return nil, Probe, fmt.Errorf("create finding: %w", err)
+			}
+			f = f.WithValue(DependencyTypeKey, string(d.Type)).
+				WithValue(ScriptKey, script.Name)
+			findings = append(findings, *f)
+		}
+		for j := range script.ProcessingErrors {
+			e := &script.ProcessingErrors[j]
+			f, err := finding.NewWith(fs, Probe,
+				fmt.Sprintf("Possibly incomplete results: %s", e.Err), &e.Location,
+				finding.OutcomeError)
+			if err != nil {
+				return nil, Probe, fmt.Errorf("create finding: %w", err)
+			}
+			findings = append(findings, *f.WithValue(ScriptKey, script.Name))
+		}
+	}
+	if len(findings) == 0 {
+		f, err := finding.NewPositive(fs, Probe,
+			fmt.Sprintf("none of the %d install scripts downloads code", len(scripts)), nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+	return findings, Probe, nil
+}
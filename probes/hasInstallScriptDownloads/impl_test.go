@@ -0,0 +1,156 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package hasInstallScriptDownloads
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	sce "github.com/ossf/scorecard/v4/errors"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name: "no install scripts",
+			raw: &checker.RawResults{
+				InstallScriptsResults: checker.InstallScriptsData{
+					Manifests: []checker.File{{Path: "package.json"}},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "install scripts without downloads",
+			raw: &checker.RawResults{
+				InstallScriptsResults: checker.InstallScriptsData{
+					Manifests: []checker.File{{Path: "package.json"}},
+					Scripts: []checker.InstallScript{
+						{
+							Name: "postinstall",
+							Type: checker.InstallScriptNpmLifecycle,
+							File: checker.File{Path: "package.json", Offset: 5},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomePositive,
+			},
+		},
+		{
+			name: "install scripts with downloads",
+			raw: &checker.RawResults{
+				InstallScriptsResults: checker.InstallScriptsData{
+					Manifests: []checker.File{{Path: "package.json"}, {Path: "example.gemspec"}},
+					Scripts: []checker.InstallScript{
+						{
+							Name: "postinstall",
+							Type: checker.InstallScriptNpmLifecycle,
+							File: checker.File{Path: "package.json", Offset: 5},
+							Downloads: []checker.Dependency{
+								{
+									Type:     checker.DependencyUseTypeDownloadThenRun,
+									Location: &checker.File{Path: "package.json", Offset: 5},
+								},
+							},
+						},
+						{
+							Name: "ext/native/extconf.rb",
+							Type: checker.InstallScriptGemExtension,
+							File: checker.File{Path: "example.gemspec", Offset: 4},
+							Downloads: []checker.Dependency{
+								{
+									Type:     checker.DependencyUseTypeDownloadThenRun,
+									Location: &checker.File{Path: "ext/native/extconf.rb", Offset: 3},
+								},
+								{
+									Type: checker.DependencyUseTypePipCommand,
+								},
+							},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNegative,
+				finding.OutcomeNegative,
+				finding.OutcomeNegative,
+			},
+		},
+		{
+			name: "install script which does not parse",
+			raw: &checker.RawResults{
+				InstallScriptsResults: checker.InstallScriptsData{
+					Manifests: []checker.File{{Path: "package.json"}},
+					Scripts: []checker.InstallScript{
+						{
+							Name: "postinstall",
+							Type: checker.InstallScriptNpmLifecycle,
+							File: checker.File{Path: "package.json", Offset: 5},
+							ProcessingErrors: []checker.ElementError{
+								{
+									Err:      sce.ErrorShellParsing,
+									Location: finding.Location{Path: "package.json"},
+								},
+							},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeError,
+			},
+		},
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(Probe, s); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+		})
+	}
+}
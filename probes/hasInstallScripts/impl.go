@@ -0,0 +1,77 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package hasInstallScripts
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe = "hasInstallScripts"
+	// TypeKey is the mechanism running the script, e.g. `npmLifecycleScript`.
+	TypeKey = "type"
+	// NameKey is the name of the script, e.g. `postinstall`.
+	NameKey = "name"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := raw.InstallScriptsResults
+	if len(r.Manifests) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no package manifests detected", nil,
+			finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	if len(r.Scripts) == 0 {
+		f, err := finding.NewPositive(fs, Probe,
+			fmt.Sprintf("none of the %d package manifests runs code at install time", len(r.Manifests)), nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	var findings []finding.Finding
+	for i := range r.Scripts {
+		script := &r.Scripts[i]
+		f, err := finding.NewNegative(fs, Probe,
+			fmt.Sprintf("%s runs %s at install time: %s", script.File.Path, script.Name, script.Type),
+			script.File.Location())
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(TypeKey, string(script.Type)).
+			WithValue(NameKey, script.Name)
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
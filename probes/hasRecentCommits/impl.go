@@ -19,7 +19,6 @@ import (
 	"embed"
 	"fmt"
 	"strconv"
-	"time"
 
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/finding"
@@ -33,7 +32,6 @@ const (
 	Probe          = "hasRecentCommits"
 	NumCommitsKey  = "commitsWithinThreshold"
 	LookbackDayKey = "lookBackDays"
-	lookBackDays   = 90
 )
 
 func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
@@ -44,7 +42,7 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 	var findings []finding.Finding
 
 	r := raw.MaintainedResults
-	threshold := time.Now().AddDate(0 /*years*/, 0 /*months*/, -1*lookBackDays /*days*/)
+	threshold := r.LookBackThreshold()
 	commitsWithinThreshold := 0
 
 	for i := range r.DefaultBranchCommits {
@@ -69,7 +67,7 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 	}
 	f = f.WithValues(map[string]string{
 		NumCommitsKey:  strconv.Itoa(commitsWithinThreshold),
-		LookbackDayKey: strconv.Itoa(lookBackDays),
+		LookbackDayKey: strconv.Itoa(r.LookBack()),
 	})
 	findings = append(findings, *f)
 
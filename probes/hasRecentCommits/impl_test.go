@@ -78,7 +78,7 @@ func Test_Run(t *testing.T) {
 			},
 			values: map[string]string{
 				NumCommitsKey:  "5",
-				LookbackDayKey: strconv.Itoa(lookBackDays),
+				LookbackDayKey: strconv.Itoa(checker.DefaultLookBackDays),
 			},
 			outcomes: []finding.Outcome{finding.OutcomePositive},
 		},
@@ -91,7 +91,7 @@ func Test_Run(t *testing.T) {
 			},
 			values: map[string]string{
 				NumCommitsKey:  "20",
-				LookbackDayKey: strconv.Itoa(lookBackDays),
+				LookbackDayKey: strconv.Itoa(checker.DefaultLookBackDays),
 			},
 			outcomes: []finding.Outcome{finding.OutcomePositive},
 		},
@@ -0,0 +1,69 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package hasRecentReleases
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe          = "hasRecentReleases"
+	NumReleasesKey = "releasesWithinThreshold"
+	LookbackDayKey = "lookBackDays"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := raw.MaintainedResults
+	threshold := r.LookBackThreshold()
+	releasesWithinThreshold := 0
+	for i := range r.Releases {
+		if r.Releases[i].PublishedAt.After(threshold) {
+			releasesWithinThreshold++
+		}
+	}
+
+	var text string
+	var outcome finding.Outcome
+	if releasesWithinThreshold > 0 {
+		text = "Found a release within the threshold."
+		outcome = finding.OutcomePositive
+	} else {
+		text = "Did not find a release within the threshold."
+		outcome = finding.OutcomeNegative
+	}
+	f, err := finding.NewWith(fs, Probe, text, nil, outcome)
+	if err != nil {
+		return nil, Probe, fmt.Errorf("create finding: %w", err)
+	}
+	f = f.WithValues(map[string]string{
+		NumReleasesKey: strconv.Itoa(releasesWithinThreshold),
+		LookbackDayKey: strconv.Itoa(r.LookBack()),
+	})
+	return []finding.Finding{*f}, Probe, nil
+}
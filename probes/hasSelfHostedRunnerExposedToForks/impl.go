@@ -0,0 +1,96 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package hasSelfHostedRunnerExposedToForks
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "hasSelfHostedRunnerExposedToForks"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := raw.DangerousWorkflowResults
+
+	if r.NumWorkflows == 0 {
+		return notApplicable("Project does not have any workflows.")
+	}
+	if r.PrivateRepo != nil && *r.PrivateRepo {
+		return notApplicable("Project is not publicly visible.")
+	}
+
+	var findings []finding.Finding
+	for i := range r.SelfHostedRunnerJobs {
+		job := &r.SelfHostedRunnerJobs[i]
+		if len(job.ForkTriggers) == 0 {
+			continue
+		}
+		f, err := finding.NewWith(fs, Probe,
+			fmt.Sprintf("self-hosted runner '%v' reachable from forks via %s",
+				job.File.Snippet, strings.Join(job.ForkTriggers, ", ")),
+			nil, finding.OutcomeNegative)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithLocation(&finding.Location{
+			Path:      job.File.Path,
+			Type:      job.File.Type,
+			LineStart: &job.File.Offset,
+			Snippet:   &job.File.Snippet,
+		})
+		findings = append(findings, *f)
+	}
+	if len(findings) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"Project does not run workflows triggered from forks on self-hosted runners.", nil,
+			finding.OutcomePositive)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+	// Forks can only reach the runners of public projects.
+	if r.PrivateRepo == nil {
+		f, err := finding.NewWith(fs, Probe,
+			"Project runs workflows triggered from forks on self-hosted runners, but its visibility is unknown.", nil,
+			finding.OutcomeNotAvailable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+	return findings, Probe, nil
+}
+
+func notApplicable(msg string) ([]finding.Finding, string, error) {
+	f, err := finding.NewWith(fs, Probe, msg, nil, finding.OutcomeNotApplicable)
+	if err != nil {
+		return nil, Probe, fmt.Errorf("create finding: %w", err)
+	}
+	return []finding.Finding{*f}, Probe, nil
+}
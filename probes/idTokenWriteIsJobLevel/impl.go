@@ -0,0 +1,83 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package idTokenWriteIsJobLevel
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe = "idTokenWriteIsJobLevel"
+	// JobKey is the key of the job granted the permission.
+	JobKey = "job"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	var findings []finding.Finding
+	for i := range raw.TokenPermissionsResults.TokenPermissions {
+		p := &raw.TokenPermissionsResults.TokenPermissions[i]
+		if !grantsIDTokenWrite(p) {
+			continue
+		}
+		var f *finding.Finding
+		var err error
+		switch *p.LocationType {
+		case checker.PermissionLocationTop:
+			f, err = finding.NewNegative(fs, Probe,
+				"workflow grants 'id-token: write' to all its jobs", p.File.Location())
+		default:
+			f, err = finding.NewPositive(fs, Probe,
+				"job is granted 'id-token: write'", p.File.Location())
+			if err == nil && p.Job != nil && p.Job.ID != nil {
+				f = f.WithValue(JobKey, *p.Job.ID)
+			}
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no workflow grants 'id-token: write'", nil,
+			finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
+
+func grantsIDTokenWrite(p *checker.TokenPermission) bool {
+	return p.Name != nil && strings.EqualFold(*p.Name, "id-token") &&
+		p.Value != nil && strings.EqualFold(*p.Value, "write") &&
+		p.LocationType != nil && p.File != nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bestpractices
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+// CriterionKey is the name of the self-attested criterion.
+const CriterionKey = "criterion"
+
+// Evidence reports whether Scorecard's own results confirm a criterion.
+// known is false if the results needed to confirm it were not collected.
+type Evidence func(raw *checker.RawResults) (known, confirmed bool)
+
+// Run returns one finding comparing the self-attested status of the
+// criterion with Scorecard's own results. The description describes
+// what the criterion claims, e.g. "static analysis".
+func Run(raw *checker.RawResults, fs embed.FS, probeID, criterion, description string,
+	evidence Evidence,
+) ([]finding.Finding, string, error) {
+	var text string
+	var outcome finding.Outcome
+	known, confirmed := evidence(raw)
+	switch {
+	case raw.CIIBestPracticesResults.Criteria[criterion] != clients.CriterionMet:
+		text = fmt.Sprintf("project does not claim %s in its OpenSSF Best Practices badge", description)
+		outcome = finding.OutcomeNotApplicable
+	case !known:
+		text = fmt.Sprintf("could not determine whether the project uses %s", description)
+		outcome = finding.OutcomeNotAvailable
+	case confirmed:
+		text = fmt.Sprintf("claimed %s is confirmed by Scorecard", description)
+		outcome = finding.OutcomePositive
+	default:
+		text = fmt.Sprintf("project claims %s but Scorecard did not detect any", description)
+		outcome = finding.OutcomeNegative
+	}
+	f, err := finding.NewWith(fs, probeID, text, nil, outcome)
+	if err != nil {
+		return nil, probeID, fmt.Errorf("create finding: %w", err)
+	}
+	f = f.WithValue(CriterionKey, criterion)
+	return []finding.Finding{*f}, probeID, nil
+}
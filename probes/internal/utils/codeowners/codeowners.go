@@ -0,0 +1,92 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codeowners
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+// OwnersKey is the comma-separated list of code owners of the file.
+const OwnersKey = "owners"
+
+// Run returns one negative finding for each file of the kind without code
+// owners, or a single positive finding if all of them have code owners.
+// The description describes the files of the kind, e.g. "workflow".
+func Run(raw *checker.RawResults, fs embed.FS, probeID string,
+	kind checker.SensitivePathKind, description string,
+) ([]finding.Finding, string, error) {
+	r := &raw.BranchProtectionResults
+	var paths []*checker.SensitivePath
+	for i := range r.SensitivePaths {
+		if r.SensitivePaths[i].Kind == kind {
+			paths = append(paths, &r.SensitivePaths[i])
+		}
+	}
+	if len(paths) == 0 {
+		f, err := finding.NewWith(fs, probeID,
+			fmt.Sprintf("no %s files found", description), nil, finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, probeID, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, probeID, nil
+	}
+	if r.Codeowners == nil {
+		f, err := finding.NewWith(fs, probeID, "no CODEOWNERS file found", nil, finding.OutcomeNegative)
+		if err != nil {
+			return nil, probeID, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, probeID, nil
+	}
+
+	var findings []finding.Finding
+	for _, p := range paths {
+		if len(p.Owners) > 0 {
+			continue
+		}
+		f, err := finding.NewWith(fs, probeID,
+			fmt.Sprintf("%s file has no code owners", description),
+			&finding.Location{Path: p.Path, Type: finding.FileTypeSource}, finding.OutcomeNegative)
+		if err != nil {
+			return nil, probeID, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	if len(findings) > 0 {
+		return findings, probeID, nil
+	}
+
+	owners := map[string]bool{}
+	var list []string
+	for _, p := range paths {
+		for _, owner := range p.Owners {
+			if !owners[owner] {
+				owners[owner] = true
+				list = append(list, owner)
+			}
+		}
+	}
+	f, err := finding.NewWith(fs, probeID,
+		fmt.Sprintf("all %d %s files have code owners", len(paths), description), nil, finding.OutcomePositive)
+	if err != nil {
+		return nil, probeID, fmt.Errorf("create finding: %w", err)
+	}
+	f = f.WithValue(OwnersKey, strings.Join(list, ","))
+	return []finding.Finding{*f}, probeID, nil
+}
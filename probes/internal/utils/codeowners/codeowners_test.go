@@ -0,0 +1,148 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codeowners_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/dependencyManifestsHaveCodeOwners"
+	"github.com/ossf/scorecard/v4/probes/dockerfilesHaveCodeOwners"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+	"github.com/ossf/scorecard/v4/probes/releaseScriptsHaveCodeOwners"
+	"github.com/ossf/scorecard/v4/probes/workflowsHaveCodeOwners"
+)
+
+// Test_Run tests the probes of each kind of sensitive path.
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	probes := []struct {
+		id    string
+		run   func(*checker.RawResults) ([]finding.Finding, string, error)
+		kind  checker.SensitivePathKind
+		paths [2]string
+	}{
+		{
+			dependencyManifestsHaveCodeOwners.Probe, dependencyManifestsHaveCodeOwners.Run,
+			checker.SensitivePathDependencyManifest, [2]string{"go.mod", "web/package.json"},
+		},
+		{
+			dockerfilesHaveCodeOwners.Probe, dockerfilesHaveCodeOwners.Run,
+			checker.SensitivePathDockerfile, [2]string{"Dockerfile", "images/base/Dockerfile"},
+		},
+		{
+			releaseScriptsHaveCodeOwners.Probe, releaseScriptsHaveCodeOwners.Run,
+			checker.SensitivePathReleaseScript, [2]string{"scripts/release.sh", ".goreleaser.yaml"},
+		},
+		{
+			workflowsHaveCodeOwners.Probe, workflowsHaveCodeOwners.Run,
+			checker.SensitivePathWorkflow, [2]string{".github/workflows/ci.yml", ".github/workflows/release.yml"},
+		},
+	}
+	for _, p := range probes {
+		p := p
+		//nolint:govet
+		tests := []struct {
+			name     string
+			raw      *checker.RawResults
+			outcomes []finding.Outcome
+			err      error
+		}{
+			{
+				name: "all files owned",
+				raw: &checker.RawResults{
+					BranchProtectionResults: checker.BranchProtectionsData{
+						Codeowners: &checker.Codeowners{Path: "CODEOWNERS"},
+						SensitivePaths: []checker.SensitivePath{
+							{Path: p.paths[0], Kind: p.kind, Owners: []string{"@org/maintainers"}},
+							{Path: p.paths[1], Kind: p.kind, Owners: []string{"@org/security"}},
+							{Path: "README.md", Kind: "other"},
+						},
+					},
+				},
+				outcomes: []finding.Outcome{
+					finding.OutcomePositive,
+				},
+			},
+			{
+				name: "file without owners",
+				raw: &checker.RawResults{
+					BranchProtectionResults: checker.BranchProtectionsData{
+						Codeowners: &checker.Codeowners{Path: "CODEOWNERS"},
+						SensitivePaths: []checker.SensitivePath{
+							{Path: p.paths[0], Kind: p.kind, Owners: []string{"@org/maintainers"}},
+							{Path: p.paths[1], Kind: p.kind, Owners: []string{}},
+						},
+					},
+				},
+				outcomes: []finding.Outcome{
+					finding.OutcomeNegative,
+				},
+			},
+			{
+				name: "no CODEOWNERS",
+				raw: &checker.RawResults{
+					BranchProtectionResults: checker.BranchProtectionsData{
+						SensitivePaths: []checker.SensitivePath{
+							{Path: p.paths[0], Kind: p.kind},
+						},
+					},
+				},
+				outcomes: []finding.Outcome{
+					finding.OutcomeNegative,
+				},
+			},
+			{
+				name: "no files",
+				raw: &checker.RawResults{
+					BranchProtectionResults: checker.BranchProtectionsData{
+						Codeowners: &checker.Codeowners{Path: "CODEOWNERS"},
+					},
+				},
+				outcomes: []finding.Outcome{
+					finding.OutcomeNotApplicable,
+				},
+			},
+			{
+				name: "nil raw",
+				err:  uerror.ErrNil,
+			},
+		}
+		for _, tt := range tests {
+			tt := tt // Re-initializing variable so it is not changed while executing the closure below
+			t.Run(p.id+"/"+tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				findings, s, err := p.run(tt.raw)
+				if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+				}
+				if err != nil {
+					return
+				}
+				if diff := cmp.Diff(p.id, s); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+				test.AssertOutcomes(t, findings, tt.outcomes)
+			})
+		}
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codereview
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+const (
+	// RevisionIDKey is the revision ID of a changeset failing a probe.
+	RevisionIDKey = "revisionID"
+	// NumChangesetsKey is the number of changesets a probe applied to.
+	NumChangesetsKey = "changesets"
+)
+
+// Check reports whether a probe applies to the changeset and, if so,
+// whether the changeset passes it.
+type Check func(c *checker.Changeset) (applicable, ok bool)
+
+// Texts are the finding texts of a probe. Negative is formatted
+// with the revision ID of the changeset and Positive with the
+// number of changesets the probe applied to.
+type Texts struct {
+	NotApplicable string
+	Negative      string
+	Positive      string
+}
+
+// Run returns one negative finding for each changeset that fails check,
+// or a single positive finding if all applicable changesets pass it.
+func Run(raw *checker.RawResults, fs embed.FS, probeID string, check Check, texts Texts,
+) ([]finding.Finding, string, error) {
+	var findings []finding.Finding
+	changesets := raw.CodeReviewResults.DefaultBranchChangesets
+	applicable := 0
+	for i := range changesets {
+		c := &changesets[i]
+		isApplicable, ok := check(c)
+		if !isApplicable {
+			continue
+		}
+		applicable++
+		if ok {
+			continue
+		}
+		f, err := finding.NewWith(fs, probeID, fmt.Sprintf(texts.Negative, c.RevisionID),
+			nil, finding.OutcomeNegative)
+		if err != nil {
+			return nil, probeID, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(RevisionIDKey, c.RevisionID)
+		findings = append(findings, *f)
+	}
+
+	if len(findings) > 0 {
+		return findings, probeID, nil
+	}
+
+	var f *finding.Finding
+	var err error
+	if applicable == 0 {
+		f, err = finding.NewWith(fs, probeID, texts.NotApplicable, nil, finding.OutcomeNotApplicable)
+	} else {
+		f, err = finding.NewWith(fs, probeID, fmt.Sprintf(texts.Positive, applicable),
+			nil, finding.OutcomePositive)
+	}
+	if err != nil {
+		return nil, probeID, fmt.Errorf("create finding: %w", err)
+	}
+	f = f.WithValue(NumChangesetsKey, strconv.Itoa(applicable))
+	return []finding.Finding{*f}, probeID, nil
+}
+
+// Approvals returns the approving reviews submitted for the changeset
+// by users other than its author.
+func Approvals(c *checker.Changeset) []clients.Review {
+	var approvals []clients.Review
+	for _, review := range c.SubmittedReviews() {
+		if review.State != "APPROVED" || review.Author == nil ||
+			review.Author.Login == "" || review.Author.Login == c.Author.Login {
+			continue
+		}
+		approvals = append(approvals, review)
+	}
+	return approvals
+}
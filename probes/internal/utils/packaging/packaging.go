@@ -0,0 +1,66 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packaging
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+// PackageKey is the purl of the published package, if known.
+const PackageKey = "package"
+
+// Run returns one positive finding for each workflow publishing to the
+// ecosystem, or a single not applicable finding if there are none. The
+// destination describes where packages of the ecosystem are published to.
+func Run(raw *checker.RawResults, fs embed.FS, probeID string,
+	ecosystem checker.PackageEcosystem, destination string,
+) ([]finding.Finding, string, error) {
+	var findings []finding.Finding
+	for i := range raw.PackagingResults.Packages {
+		p := &raw.PackagingResults.Packages[i]
+		// Skip debug messages.
+		if p.Msg != nil || p.Ecosystem != ecosystem {
+			continue
+		}
+		f, err := finding.NewWith(fs, probeID,
+			fmt.Sprintf("workflow publishing to %s detected", destination), nil,
+			finding.OutcomePositive)
+		if err != nil {
+			return nil, probeID, fmt.Errorf("create finding: %w", err)
+		}
+		if p.File != nil {
+			f = f.WithLocation(p.File.Location())
+		}
+		if p.Name != nil {
+			f = f.WithValue(PackageKey, *p.Name)
+		}
+		findings = append(findings, *f)
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewWith(fs, probeID,
+			fmt.Sprintf("no workflow publishing to %s detected", destination), nil,
+			finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, probeID, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, probeID, nil
+}
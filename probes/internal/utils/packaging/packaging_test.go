@@ -0,0 +1,132 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packaging_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+	"github.com/ossf/scorecard/v4/probes/packagedAsContainerImage"
+	"github.com/ossf/scorecard/v4/probes/packagedAsHelmChart"
+	"github.com/ossf/scorecard/v4/probes/packagedToCratesIO"
+	"github.com/ossf/scorecard/v4/probes/packagedToMaven"
+	"github.com/ossf/scorecard/v4/probes/packagedToNpm"
+	"github.com/ossf/scorecard/v4/probes/packagedToNuGet"
+	"github.com/ossf/scorecard/v4/probes/packagedToPyPI"
+	"github.com/ossf/scorecard/v4/probes/packagedToRubyGems"
+	"github.com/ossf/scorecard/v4/probes/packagedWithGoReleaser"
+)
+
+// Test_Run tests the probes of each ecosystem.
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	probes := []struct {
+		id        string
+		run       func(*checker.RawResults) ([]finding.Finding, string, error)
+		ecosystem checker.PackageEcosystem
+	}{
+		{packagedAsContainerImage.Probe, packagedAsContainerImage.Run, checker.PackageEcosystemContainer},
+		{packagedAsHelmChart.Probe, packagedAsHelmChart.Run, checker.PackageEcosystemHelm},
+		{packagedToCratesIO.Probe, packagedToCratesIO.Run, checker.PackageEcosystemCratesIO},
+		{packagedToMaven.Probe, packagedToMaven.Run, checker.PackageEcosystemMaven},
+		{packagedToNpm.Probe, packagedToNpm.Run, checker.PackageEcosystemNpm},
+		{packagedToNuGet.Probe, packagedToNuGet.Run, checker.PackageEcosystemNuGet},
+		{packagedToPyPI.Probe, packagedToPyPI.Run, checker.PackageEcosystemPyPI},
+		{packagedToRubyGems.Probe, packagedToRubyGems.Run, checker.PackageEcosystemRubyGems},
+		{packagedWithGoReleaser.Probe, packagedWithGoReleaser.Run, checker.PackageEcosystemGo},
+	}
+	msg := "not a publishing workflow"
+	purl := "pkg:generic/example"
+	for _, p := range probes {
+		p := p
+		//nolint:govet
+		tests := []struct {
+			name     string
+			raw      *checker.RawResults
+			outcomes []finding.Outcome
+			err      error
+		}{
+			{
+				name:     "no packages",
+				raw:      &checker.RawResults{},
+				outcomes: []finding.Outcome{finding.OutcomeNotApplicable},
+			},
+			{
+				name: "debug messages and other ecosystems",
+				raw: &checker.RawResults{
+					PackagingResults: checker.PackagingData{
+						Packages: []checker.Package{
+							{
+								Msg:       &msg,
+								Ecosystem: p.ecosystem,
+							},
+							{
+								Ecosystem: "other",
+							},
+						},
+					},
+				},
+				outcomes: []finding.Outcome{finding.OutcomeNotApplicable},
+			},
+			{
+				name: "published",
+				raw: &checker.RawResults{
+					PackagingResults: checker.PackagingData{
+						Packages: []checker.Package{
+							{
+								Name:      &purl,
+								File:      &checker.File{Path: ".github/workflows/release.yml"},
+								Ecosystem: p.ecosystem,
+							},
+							{
+								Ecosystem: p.ecosystem,
+							},
+						},
+					},
+				},
+				outcomes: []finding.Outcome{finding.OutcomePositive, finding.OutcomePositive},
+			},
+			{
+				name: "nil raw",
+				err:  uerror.ErrNil,
+			},
+		}
+		for _, tt := range tests {
+			tt := tt // Re-initializing variable so it is not changed while executing the closure below
+			t.Run(p.id+"/"+tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				findings, s, err := p.run(tt.raw)
+				if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+				}
+				if err != nil {
+					return
+				}
+				if diff := cmp.Diff(p.id, s); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+				test.AssertOutcomes(t, findings, tt.outcomes)
+			})
+		}
+	}
+}
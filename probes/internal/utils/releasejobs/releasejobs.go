@@ -0,0 +1,100 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package releasejobs
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+const (
+	// JobKey is the key of the release job in its workflow.
+	JobKey = "job"
+	// EnvironmentKey is the deployment environment of the release job.
+	EnvironmentKey = "environment"
+)
+
+// Protected reports whether the rules of an environment protect the jobs running in it.
+type Protected func(env *clients.Environment) bool
+
+// Run returns one finding for each release job, telling whether the
+// deployment environment of the job is protected. The description names
+// the rules protecting it, e.g. "required reviewers".
+func Run(raw *checker.RawResults, fs embed.FS, probeID string, protected Protected,
+	description string,
+) ([]finding.Finding, string, error) {
+	r := &raw.PackagingResults
+	if len(r.ReleaseJobs) == 0 {
+		f, err := finding.NewWith(fs, probeID,
+			"no workflow jobs publishing packages detected", nil,
+			finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, probeID, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, probeID, nil
+	}
+
+	var findings []finding.Finding
+	for i := range r.ReleaseJobs {
+		job := &r.ReleaseJobs[i]
+		text, outcome := evaluate(job, r.Environments, protected, description)
+		f, err := finding.NewWith(fs, probeID, text, nil, outcome)
+		if err != nil {
+			return nil, probeID, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithLocation(job.File.Location()).
+			WithValue(JobKey, job.ID).
+			WithValue(EnvironmentKey, job.Environment)
+		findings = append(findings, *f)
+	}
+	return findings, probeID, nil
+}
+
+func evaluate(job *checker.ReleaseJob, environments []clients.Environment, protected Protected,
+	description string,
+) (string, finding.Outcome) {
+	switch {
+	case job.Environment == "":
+		return fmt.Sprintf("release job '%s' does not run in a deployment environment", job.ID),
+			finding.OutcomeNegative
+	case strings.Contains(job.Environment, "${{"):
+		return fmt.Sprintf("release job '%s' runs in an environment set by an expression", job.ID),
+			finding.OutcomeNotAvailable
+	case environments == nil:
+		return "could not list the deployment environments of the repository",
+			finding.OutcomeNotAvailable
+	}
+	for i := range environments {
+		env := &environments[i]
+		// Environment names are case-insensitive.
+		if !strings.EqualFold(env.Name, job.Environment) {
+			continue
+		}
+		if protected(env) {
+			return fmt.Sprintf("environment '%s' of release job '%s' has %s",
+				job.Environment, job.ID, description), finding.OutcomePositive
+		}
+		break
+	}
+	// Environments missing from the settings are created without
+	// protection rules when a job first runs in them.
+	return fmt.Sprintf("environment '%s' of release job '%s' has no %s",
+		job.Environment, job.ID, description), finding.OutcomeNegative
+}
@@ -0,0 +1,142 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package releasejobs_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+	"github.com/ossf/scorecard/v4/probes/releaseEnvironmentRequiresReviewers"
+	"github.com/ossf/scorecard/v4/probes/releaseJobsUseProtectedEnvironment"
+)
+
+// Test_Run tests the cases on which the probes of release jobs agree.
+// The rules each probe accepts are tested in the probe packages.
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	probes := []struct {
+		run func(*checker.RawResults) ([]finding.Finding, string, error)
+		id  string
+	}{
+		{releaseEnvironmentRequiresReviewers.Run, releaseEnvironmentRequiresReviewers.Probe},
+		{releaseJobsUseProtectedEnvironment.Run, releaseJobsUseProtectedEnvironment.Probe},
+	}
+	jobs := func(environments ...string) []checker.ReleaseJob {
+		ret := make([]checker.ReleaseJob, 0, len(environments))
+		for _, env := range environments {
+			ret = append(ret, checker.ReleaseJob{
+				File:        checker.File{Path: ".github/workflows/release.yml", Offset: 10},
+				ID:          "publish",
+				Environment: env,
+			})
+		}
+		return ret
+	}
+	environments := []clients.Environment{
+		{Name: "release", RequiredReviewers: 1},
+		{Name: "unprotected", WaitTimer: 5},
+	}
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name:     "no release jobs",
+			raw:      &checker.RawResults{},
+			outcomes: []finding.Outcome{finding.OutcomeNotApplicable},
+		},
+		{
+			name: "environment requires reviewers",
+			raw: &checker.RawResults{
+				PackagingResults: checker.PackagingData{
+					ReleaseJobs:  jobs("Release"),
+					Environments: environments,
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomePositive},
+		},
+		{
+			name: "unprotected, missing and no environment",
+			raw: &checker.RawResults{
+				PackagingResults: checker.PackagingData{
+					ReleaseJobs:  jobs("unprotected", "missing", ""),
+					Environments: environments,
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNegative,
+				finding.OutcomeNegative,
+				finding.OutcomeNegative,
+			},
+		},
+		{
+			name: "environments not listed",
+			raw: &checker.RawResults{
+				PackagingResults: checker.PackagingData{
+					ReleaseJobs: jobs("release", ""),
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotAvailable,
+				finding.OutcomeNegative,
+			},
+		},
+		{
+			name: "environment set by an expression",
+			raw: &checker.RawResults{
+				PackagingResults: checker.PackagingData{
+					ReleaseJobs:  jobs("${{ inputs.environment }}"),
+					Environments: environments,
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeNotAvailable},
+		},
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+	}
+	for _, p := range probes {
+		p := p
+		for _, tt := range tests {
+			tt := tt // Re-initializing variable so it is not changed while executing the closure below
+			t.Run(p.id+"/"+tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				findings, s, err := p.run(tt.raw)
+				if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+				}
+				if err != nil {
+					return
+				}
+				if diff := cmp.Diff(p.id, s); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+				test.AssertOutcomes(t, findings, tt.outcomes)
+			})
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package securitysettings
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+// SettingKey is the name of the security setting.
+const SettingKey = "setting"
+
+// Run returns one finding telling whether the security setting is enabled.
+// The description names the setting, e.g. "secret scanning".
+func Run(raw *checker.RawResults, fs embed.FS, probeID string, setting clients.SecuritySetting,
+	description string,
+) ([]finding.Finding, string, error) {
+	var text string
+	var outcome finding.Outcome
+	enabled, known := raw.SecuritySettingsResults.Settings[setting]
+	switch {
+	case !known:
+		text = fmt.Sprintf("could not determine whether %s is enabled", description)
+		outcome = finding.OutcomeNotAvailable
+	case enabled:
+		text = fmt.Sprintf("%s is enabled", description)
+		outcome = finding.OutcomePositive
+	default:
+		text = fmt.Sprintf("%s is disabled", description)
+		outcome = finding.OutcomeNegative
+	}
+	f, err := finding.NewWith(fs, probeID, text, nil, outcome)
+	if err != nil {
+		return nil, probeID, fmt.Errorf("create finding: %w", err)
+	}
+	f = f.WithValue(SettingKey, string(setting))
+	return []finding.Finding{*f}, probeID, nil
+}
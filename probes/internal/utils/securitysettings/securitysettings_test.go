@@ -0,0 +1,110 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package securitysettings_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/codeScanningEnabled"
+	"github.com/ossf/scorecard/v4/probes/dependencyAlertsEnabled"
+	"github.com/ossf/scorecard/v4/probes/dependencySecurityUpdatesEnabled"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+	"github.com/ossf/scorecard/v4/probes/secretPushProtectionEnabled"
+	"github.com/ossf/scorecard/v4/probes/secretScanningEnabled"
+)
+
+// Test_Run tests the probes of each security setting.
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	probes := []struct {
+		id      string
+		run     func(*checker.RawResults) ([]finding.Finding, string, error)
+		setting clients.SecuritySetting
+	}{
+		{codeScanningEnabled.Probe, codeScanningEnabled.Run, clients.CodeScanning},
+		{dependencyAlertsEnabled.Probe, dependencyAlertsEnabled.Run, clients.DependencyAlerts},
+		{dependencySecurityUpdatesEnabled.Probe, dependencySecurityUpdatesEnabled.Run, clients.DependencySecurityUpdates},
+		{secretPushProtectionEnabled.Probe, secretPushProtectionEnabled.Run, clients.SecretPushProtection},
+		{secretScanningEnabled.Probe, secretScanningEnabled.Run, clients.SecretScanning},
+	}
+	for _, p := range probes {
+		p := p
+		//nolint:govet
+		tests := []struct {
+			name     string
+			raw      *checker.RawResults
+			outcomes []finding.Outcome
+			err      error
+		}{
+			{
+				name: "enabled",
+				raw: &checker.RawResults{
+					SecuritySettingsResults: checker.SecuritySettingsData{
+						Settings: map[clients.SecuritySetting]bool{
+							p.setting: true,
+						},
+					},
+				},
+				outcomes: []finding.Outcome{finding.OutcomePositive},
+			},
+			{
+				name: "disabled",
+				raw: &checker.RawResults{
+					SecuritySettingsResults: checker.SecuritySettingsData{
+						Settings: map[clients.SecuritySetting]bool{
+							p.setting: false,
+						},
+					},
+				},
+				outcomes: []finding.Outcome{finding.OutcomeNegative},
+			},
+			{
+				name:     "unknown",
+				raw:      &checker.RawResults{},
+				outcomes: []finding.Outcome{finding.OutcomeNotAvailable},
+			},
+			{
+				name: "nil raw",
+				err:  uerror.ErrNil,
+			},
+		}
+		for _, tt := range tests {
+			tt := tt // Re-initializing variable so it is not changed while executing the closure below
+			t.Run(p.id+"/"+tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				findings, s, err := p.run(tt.raw)
+				if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+					t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+				}
+				if err != nil {
+					return
+				}
+				if diff := cmp.Diff(p.id, s); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+				test.AssertOutcomes(t, findings, tt.outcomes)
+			})
+		}
+	}
+}
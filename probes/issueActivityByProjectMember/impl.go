@@ -34,7 +34,6 @@ const (
 	Probe          = "issueActivityByProjectMember"
 	NumIssuesKey   = "numberOfIssuesUpdatedWithinThreshold"
 	LookbackDayKey = "lookBackDays"
-	lookBackDays   = 90
 )
 
 func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
@@ -45,8 +44,8 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 	r := raw.MaintainedResults
 	numberOfIssuesUpdatedWithinThreshold := 0
 
-	// Look for activity in past `lookBackDays`.
-	threshold := time.Now().AddDate(0 /*years*/, 0 /*months*/, -1*lookBackDays /*days*/)
+	// Look for activity in the look back window.
+	threshold := r.LookBackThreshold()
 	var findings []finding.Finding
 	for i := range r.Issues {
 		if hasActivityByCollaboratorOrHigher(&r.Issues[i], threshold) {
@@ -70,7 +69,7 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 	}
 	f = f.WithValues(map[string]string{
 		NumIssuesKey:   strconv.Itoa(numberOfIssuesUpdatedWithinThreshold),
-		LookbackDayKey: strconv.Itoa(lookBackDays),
+		LookbackDayKey: strconv.Itoa(r.LookBack()),
 	})
 	findings = append(findings, *f)
 
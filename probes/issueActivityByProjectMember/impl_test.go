@@ -103,7 +103,7 @@ func Test_Run(t *testing.T) {
 				},
 			},
 			values: map[string]string{
-				LookbackDayKey: strconv.Itoa(lookBackDays),
+				LookbackDayKey: strconv.Itoa(checker.DefaultLookBackDays),
 				NumIssuesKey:   "5",
 			},
 			outcomes: []finding.Outcome{finding.OutcomePositive},
@@ -116,7 +116,7 @@ func Test_Run(t *testing.T) {
 				},
 			},
 			values: map[string]string{
-				LookbackDayKey: strconv.Itoa(lookBackDays),
+				LookbackDayKey: strconv.Itoa(checker.DefaultLookBackDays),
 				NumIssuesKey:   "20",
 			},
 			outcomes: []finding.Outcome{finding.OutcomePositive},
@@ -129,7 +129,7 @@ func Test_Run(t *testing.T) {
 				},
 			},
 			values: map[string]string{
-				LookbackDayKey: strconv.Itoa(lookBackDays),
+				LookbackDayKey: strconv.Itoa(checker.DefaultLookBackDays),
 				NumIssuesKey:   "5",
 			},
 			outcomes: []finding.Outcome{finding.OutcomePositive},
@@ -0,0 +1,179 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package licenseDeclarationsAreConsistent
+
+import (
+	"embed"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "licenseDeclarationsAreConsistent"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	var findings []finding.Finding
+	reference, source := fileLicenses(raw.LicenseResults.LicenseFiles), "license file"
+	if len(reference) > 0 {
+		for i := range raw.LicenseResults.ManifestLicenses {
+			m := &raw.LicenseResults.ManifestLicenses[i]
+			if !m.Valid {
+				continue
+			}
+			f, err := compare(m, reference, source)
+			if err != nil {
+				return nil, Probe, err
+			}
+			findings = append(findings, *f)
+		}
+	} else {
+		reference, source = manifestLicenses(raw.LicenseResults.ManifestLicenses), "package manifest"
+	}
+
+	headerFindings, err := compareHeaders(raw.LicenseResults.HeaderLicenses, reference, source)
+	if err != nil {
+		return nil, Probe, err
+	}
+	findings = append(findings, headerFindings...)
+
+	if len(findings) == 0 {
+		f, err := finding.NewWith(fs, Probe, "not enough license declarations to compare",
+			nil, finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
+
+func compare(d *checker.LicenseDeclaration, reference map[string]string, source string) (*finding.Finding, error) {
+	var f *finding.Finding
+	var err error
+	if consistent(d, reference) {
+		f, err = finding.NewPositive(fs, Probe,
+			fmt.Sprintf("license '%s' matches the %s", d.Expression, source), d.File.Location())
+	} else {
+		f, err = finding.NewNegative(fs, Probe,
+			fmt.Sprintf("license '%s' does not match the %s (%s)", d.Expression, source, names(reference)),
+			d.File.Location())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create finding: %w", err)
+	}
+	return f, nil
+}
+
+// compareHeaders reports each inconsistent header expression once, at its first occurrence.
+func compareHeaders(headers []checker.LicenseDeclaration, reference map[string]string,
+	source string,
+) ([]finding.Finding, error) {
+	if len(reference) == 0 {
+		return nil, nil
+	}
+	var findings []finding.Finding
+	checked := 0
+	reported := make(map[string]bool)
+	for i := range headers {
+		h := &headers[i]
+		if !h.Valid {
+			continue
+		}
+		checked++
+		if reported[h.Expression] || consistent(h, reference) {
+			continue
+		}
+		reported[h.Expression] = true
+		f, err := compare(h, reference, source)
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, *f)
+	}
+	if checked > 0 && len(reported) == 0 {
+		f, err := finding.NewPositive(fs, Probe,
+			fmt.Sprintf("%d SPDX-License-Identifier headers match the %s", checked, source), nil)
+		if err != nil {
+			return nil, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, nil
+}
+
+// consistent returns whether the declaration shares a license with the reference,
+// so that declaring only one of the licenses of a dual-licensed project is accepted.
+func consistent(d *checker.LicenseDeclaration, reference map[string]string) bool {
+	for _, id := range d.SpdxIDs {
+		if _, ok := reference[normalize(id)]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func fileLicenses(files []checker.LicenseFile) map[string]string {
+	licenses := make(map[string]string)
+	for i := range files {
+		id := files[i].LicenseInformation.SpdxID
+		switch strings.ToUpper(id) {
+		case "", "NOASSERTION", "OTHER":
+			continue
+		}
+		licenses[normalize(id)] = id
+	}
+	return licenses
+}
+
+func manifestLicenses(manifests []checker.LicenseDeclaration) map[string]string {
+	licenses := make(map[string]string)
+	for i := range manifests {
+		for _, id := range manifests[i].SpdxIDs {
+			licenses[normalize(id)] = id
+		}
+	}
+	return licenses
+}
+
+// normalize makes the deprecated identifiers of the GNU licenses, e.g. GPL-2.0,
+// equal to their current ones, e.g. GPL-2.0-only.
+func normalize(id string) string {
+	id = strings.ToUpper(id)
+	for _, suffix := range []string{"-ONLY", "-OR-LATER"} {
+		id = strings.TrimSuffix(id, suffix)
+	}
+	return id
+}
+
+func names(licenses map[string]string) string {
+	var ids []string
+	for _, id := range licenses {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return strings.Join(ids, ", ")
+}
@@ -58,6 +58,18 @@ func Test_Run(t *testing.T) {
 				finding.OutcomePositive,
 			},
 		},
+		{
+			name: "Was created 10 days ago with a 7 day window",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					CreatedAt:    time.Now().AddDate(0 /*years*/, 0 /*months*/, -10 /*days*/),
+					LookBackDays: 7,
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomePositive,
+			},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt // Re-initializing variable so it is not changed while executing the closure below
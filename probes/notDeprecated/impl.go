@@ -0,0 +1,82 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package notDeprecated
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe          = "notDeprecated"
+	SourceKey      = "source"
+	LocationKey    = "location"
+	ReplacementKey = "replacement"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	deprecations := raw.MaintainedResults.Deprecations
+	if len(deprecations) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no deprecation notice found", nil,
+			finding.OutcomePositive)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	var findings []finding.Finding
+	for i := range deprecations {
+		d := &deprecations[i]
+		text := fmt.Sprintf("project is deprecated (%s %s): %s", d.Source, d.Location, d.Message)
+		if d.Replacement != "" {
+			text = fmt.Sprintf("%s, replacement: %s", text, d.Replacement)
+		}
+		var loc *finding.Location
+		if d.Source == checker.DeprecationSourceReadme {
+			loc = &finding.Location{
+				Type: finding.FileTypeText,
+				Path: d.Location,
+			}
+		}
+		f, err := finding.NewWith(fs, Probe, text, loc, finding.OutcomeNegative)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		values := map[string]string{
+			SourceKey:   string(d.Source),
+			LocationKey: d.Location,
+		}
+		if d.Replacement != "" {
+			values[ReplacementKey] = d.Replacement
+		}
+		f = f.WithValues(values)
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
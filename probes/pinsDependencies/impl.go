@@ -163,6 +163,9 @@ func generateTextUnpinned(rr *checker.Dependency) string {
 		owner := generateOwnerToDisplay(gitHubOwned)
 		return fmt.Sprintf("%s not pinned by hash", owner)
 	}
+	if rr.Type == checker.DependencyUseTypeGitSubmodule && rr.Submodule != nil {
+		return fmt.Sprintf("%s tracks branch '%s'", rr.Type, rr.Submodule.Branch)
+	}
 
 	return fmt.Sprintf("%s not pinned by hash", rr.Type)
 }
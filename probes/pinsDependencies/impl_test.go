@@ -654,6 +654,15 @@ func TestGenerateText(t *testing.T) {
 			},
 			expectedText: "third-party GitHubAction not pinned by hash",
 		},
+		{
+			name: "Submodule tracking a branch",
+			dependency: &checker.Dependency{
+				Type:      checker.DependencyUseTypeGitSubmodule,
+				Location:  &checker.File{},
+				Submodule: &checker.Submodule{Path: "third_party/lib", Branch: "main"},
+			},
+			expectedText: "gitSubmodule tracks branch 'main'",
+		},
 	}
 
 	for _, tc := range tests {
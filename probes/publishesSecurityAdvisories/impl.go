@@ -0,0 +1,69 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package publishesSecurityAdvisories
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe = "publishesSecurityAdvisories"
+	// AdvisoryIDKey is the key in the finding values holding the advisory identifier.
+	AdvisoryIDKey = "advisoryID"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	advisories := raw.SecurityPolicyResults.SecurityAdvisories
+	if advisories == nil {
+		f, err := finding.NewNotAvailable(fs, Probe, "could not list security advisories", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+	if len(advisories) == 0 {
+		f, err := finding.NewNegative(fs, Probe, "no published security advisory", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	var findings []finding.Finding
+	for i := range advisories {
+		advisory := &advisories[i]
+		f, err := finding.NewPositive(fs, Probe,
+			fmt.Sprintf("security advisory %s published", advisory.ID), nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(AdvisoryIDKey, advisory.ID)
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package publishesWithTrustedPublishing
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe = "publishesWithTrustedPublishing"
+	// JobKey is the key of the release job in its workflow.
+	JobKey = "job"
+	// SecretKey is the name of the secret a release job publishes with.
+	SecretKey = "secret"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := &raw.PackagingResults
+	if len(r.ReleaseJobs) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no workflow jobs publishing packages detected", nil,
+			finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	var findings []finding.Finding
+	for i := range r.ReleaseJobs {
+		jobFindings, err := jobFindings(&r.ReleaseJobs[i])
+		if err != nil {
+			return nil, Probe, err
+		}
+		findings = append(findings, jobFindings...)
+	}
+	return findings, Probe, nil
+}
+
+func jobFindings(job *checker.ReleaseJob) ([]finding.Finding, error) {
+	var findings []finding.Finding
+	var oidc *checker.PublishCredential
+	for i := range job.Credentials {
+		c := &job.Credentials[i]
+		if c.Type == checker.PublishCredentialOIDC {
+			if oidc == nil {
+				oidc = c
+			}
+			continue
+		}
+		f, err := finding.NewNegative(fs, Probe,
+			fmt.Sprintf("release job '%s' publishes with the long-lived secret '%s'", job.ID, c.Secret),
+			c.File.Location())
+		if err != nil {
+			return nil, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithValue(JobKey, job.ID).
+			WithValue(SecretKey, c.Secret)
+		findings = append(findings, *f)
+	}
+	if len(findings) > 0 {
+		return findings, nil
+	}
+
+	var f *finding.Finding
+	var err error
+	if oidc != nil {
+		f, err = finding.NewPositive(fs, Probe,
+			fmt.Sprintf("release job '%s' publishes with OIDC credentials", job.ID), oidc.File.Location())
+	} else {
+		f, err = finding.NewNotAvailable(fs, Probe,
+			fmt.Sprintf("could not determine the credentials release job '%s' publishes with", job.ID),
+			job.File.Location())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create finding: %w", err)
+	}
+	f = f.WithValue(JobKey, job.ID)
+	return []finding.Finding{*f}, nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package publishesWithTrustedPublishing
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	file := checker.File{Path: ".github/workflows/release.yml", Offset: 10}
+	secret := checker.PublishCredential{File: file, Type: checker.PublishCredentialSecret, Secret: "PYPI_TOKEN"}
+	oidc := checker.PublishCredential{File: file, Type: checker.PublishCredentialOIDC}
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name:     "no release jobs",
+			raw:      &checker.RawResults{},
+			outcomes: []finding.Outcome{finding.OutcomeNotApplicable},
+		},
+		{
+			name: "trusted publishing",
+			raw: &checker.RawResults{
+				PackagingResults: checker.PackagingData{
+					ReleaseJobs: []checker.ReleaseJob{
+						{File: file, ID: "publish", IDTokenWrite: true, Credentials: []checker.PublishCredential{oidc}},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomePositive},
+		},
+		{
+			name: "secrets take precedence over oidc",
+			raw: &checker.RawResults{
+				PackagingResults: checker.PackagingData{
+					ReleaseJobs: []checker.ReleaseJob{
+						{
+							File:         file,
+							ID:           "publish",
+							IDTokenWrite: true,
+							Credentials:  []checker.PublishCredential{oidc, secret, secret},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeNegative, finding.OutcomeNegative},
+		},
+		{
+			name: "unknown credentials",
+			raw: &checker.RawResults{
+				PackagingResults: checker.PackagingData{
+					ReleaseJobs: []checker.ReleaseJob{
+						{File: file, ID: "publish"},
+						{File: file, ID: "publish-pypi", Credentials: []checker.PublishCredential{secret}},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeNotAvailable, finding.OutcomeNegative},
+		},
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(Probe, s); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+		})
+	}
+}
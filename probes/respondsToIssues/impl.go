@@ -0,0 +1,80 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package respondsToIssues
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe                  = "respondsToIssues"
+	MedianResponseHoursKey = "medianResponseHours"
+	NumIssuesKey           = "issues"
+	NumRespondedIssuesKey  = "respondedIssues"
+	// responseThreshold is the longest median response time considered prompt.
+	responseThreshold = 14 * 24 * time.Hour
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := raw.MaintainedResults.IssueResponseTime
+	if r.Issues == 0 {
+		f, err := finding.NewWith(fs, Probe, "no issue opened by other users within the threshold",
+			nil, finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	var text string
+	var outcome finding.Outcome
+	hours := int(r.Median.Hours())
+	switch {
+	case r.Responded == 0:
+		text = fmt.Sprintf("maintainers did not respond to any of %d issues", r.Issues)
+		outcome = finding.OutcomeNegative
+	case r.Median <= responseThreshold:
+		text = fmt.Sprintf("maintainers respond to issues in %d hours (median)", hours)
+		outcome = finding.OutcomePositive
+	default:
+		text = fmt.Sprintf("maintainers take %d hours to respond to issues (median)", hours)
+		outcome = finding.OutcomeNegative
+	}
+	f, err := finding.NewWith(fs, Probe, text, nil, outcome)
+	if err != nil {
+		return nil, Probe, fmt.Errorf("create finding: %w", err)
+	}
+	f = f.WithValues(map[string]string{
+		MedianResponseHoursKey: strconv.Itoa(hours),
+		NumIssuesKey:           strconv.Itoa(r.Issues),
+		NumRespondedIssuesKey:  strconv.Itoa(r.Responded),
+	})
+	return []finding.Finding{*f}, Probe, nil
+}
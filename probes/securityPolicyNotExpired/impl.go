@@ -0,0 +1,78 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package securityPolicyNotExpired
+
+import (
+	"embed"
+	"fmt"
+	"time"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "securityPolicyNotExpired"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	now := time.Now()
+	var findings []finding.Finding
+	for i := range raw.SecurityPolicyResults.SecurityTxtFiles {
+		policy := &raw.SecurityPolicyResults.SecurityTxtFiles[i]
+		if policy.SecurityTxt == nil {
+			continue
+		}
+		var f *finding.Finding
+		var err error
+		expires := policy.SecurityTxt.Expires
+		switch {
+		case expires == nil:
+			f, err = finding.NewWith(fs, Probe, "security.txt has no valid Expires field",
+				policy.File.Location(), finding.OutcomeNegative)
+		case !expires.After(now):
+			f, err = finding.NewWith(fs, Probe,
+				fmt.Sprintf("security.txt expired on %s", expires.Format(time.RFC3339)),
+				policy.File.Location(), finding.OutcomeNegative)
+		default:
+			f, err = finding.NewWith(fs, Probe,
+				fmt.Sprintf("security.txt expires on %s", expires.Format(time.RFC3339)),
+				policy.File.Location(), finding.OutcomePositive)
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithRemediationMetadata(raw.Metadata.Metadata)
+		findings = append(findings, *f)
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewWith(fs, Probe, "no security.txt file detected",
+			nil, finding.OutcomeNotAvailable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+
+	return findings, Probe, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package submoduleCommitsAreReachable
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe = "submoduleCommitsAreReachable"
+	// PathKey is the path of the submodule in the repository.
+	PathKey = "path"
+	// CommitKey is the commit the submodule is pinned at.
+	CommitKey = "commit"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	var findings []finding.Finding
+	deps := raw.PinningDependenciesResults.Dependencies
+	for i := range deps {
+		dep := &deps[i]
+		if dep.Type != checker.DependencyUseTypeGitSubmodule || dep.Submodule == nil {
+			continue
+		}
+		f, err := submoduleFinding(dep)
+		if err != nil {
+			return nil, Probe, err
+		}
+		findings = append(findings, *f)
+	}
+	if len(findings) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no submodules detected", nil,
+			finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+	return findings, Probe, nil
+}
+
+func submoduleFinding(dep *checker.Dependency) (*finding.Finding, error) {
+	s := dep.Submodule
+	loc := dep.Location.Location()
+	var f *finding.Finding
+	var err error
+	switch {
+	case dep.PinnedAt == nil || s.Reachable == nil:
+		f, err = finding.NewNotAvailable(fs, Probe,
+			fmt.Sprintf("could not verify the commit of submodule %s is on an upstream branch", s.Path), loc)
+	case *s.Reachable:
+		f, err = finding.NewPositive(fs, Probe,
+			fmt.Sprintf("submodule %s is pinned at a commit of an upstream branch", s.Path), loc)
+	default:
+		f, err = finding.NewNegative(fs, Probe,
+			fmt.Sprintf("submodule %s is pinned at commit %s, which is on no upstream branch", s.Path, *dep.PinnedAt), loc)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create finding: %w", err)
+	}
+	f = f.WithValue(PathKey, s.Path)
+	if dep.PinnedAt != nil {
+		f = f.WithValue(CommitKey, *dep.PinnedAt)
+	}
+	return f, nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package submodulesFromTrustedHosts
+
+import (
+	"embed"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe = "submodulesFromTrustedHosts"
+	// PathKey is the path of the submodule in the repository.
+	PathKey = "path"
+	// URLKey is the URL of the submodule as declared in `.gitmodules`.
+	URLKey = "url"
+	// HostKey is the host of the submodule, if any.
+	HostKey = "host"
+)
+
+var trustedHosts = []string{
+	"github.com",
+	"gitlab.com",
+	"bitbucket.org",
+	"codeberg.org",
+	"git.sr.ht",
+}
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	repoHost := raw.Metadata.Metadata["repository.host"]
+	var findings []finding.Finding
+	deps := raw.PinningDependenciesResults.Dependencies
+	for i := range deps {
+		dep := &deps[i]
+		if dep.Type != checker.DependencyUseTypeGitSubmodule || dep.Submodule == nil {
+			continue
+		}
+		f, err := submoduleFinding(dep, repoHost)
+		if err != nil {
+			return nil, Probe, err
+		}
+		findings = append(findings, *f)
+	}
+	if len(findings) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no submodules detected", nil,
+			finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+	return findings, Probe, nil
+}
+
+func submoduleFinding(dep *checker.Dependency, repoHost string) (*finding.Finding, error) {
+	s := dep.Submodule
+	loc := dep.Location.Location()
+	var f *finding.Finding
+	var err error
+	host, secure, relative := parseSubmoduleURL(s.URL)
+	switch {
+	case relative:
+		f, err = finding.NewPositive(fs, Probe,
+			fmt.Sprintf("submodule %s is hosted with the project", s.Path), loc)
+	case !secure:
+		f, err = finding.NewNegative(fs, Probe,
+			fmt.Sprintf("submodule %s is fetched over an insecure transport: %s", s.Path, s.URL), loc)
+	case !isTrustedHost(host, repoHost):
+		f, err = finding.NewNegative(fs, Probe,
+			fmt.Sprintf("submodule %s is hosted on %s, which is not a well-known forge", s.Path, host), loc)
+	default:
+		f, err = finding.NewPositive(fs, Probe,
+			fmt.Sprintf("submodule %s is fetched securely from %s", s.Path, host), loc)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create finding: %w", err)
+	}
+	f = f.WithValue(PathKey, s.Path).
+		WithValue(URLKey, s.URL)
+	if host != "" {
+		f = f.WithValue(HostKey, host)
+	}
+	return f, nil
+}
+
+// parseSubmoduleURL returns the host of a submodule URL and whether its
+// transport is authenticated, or whether it is relative to the repository.
+func parseSubmoduleURL(rawURL string) (host string, secure, relative bool) {
+	if strings.HasPrefix(rawURL, "./") || strings.HasPrefix(rawURL, "../") {
+		return "", true, true
+	}
+	if !strings.Contains(rawURL, "://") {
+		// scp-like syntax of SSH, e.g. `git@github.com:owner/repo.git`.
+		userHost, _, found := strings.Cut(rawURL, ":")
+		if !found || strings.Contains(userHost, "/") {
+			// Local path.
+			return "", false, false
+		}
+		_, host, _ = strings.Cut(userHost, "@")
+		if host == "" {
+			host = userHost
+		}
+		return strings.ToLower(host), true, false
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", false, false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "https", "ssh", "git+ssh", "ssh+git":
+		secure = true
+	}
+	return strings.ToLower(u.Hostname()), secure, false
+}
+
+func isTrustedHost(host, repoHost string) bool {
+	if host == "" {
+		return false
+	}
+	if strings.EqualFold(host, repoHost) {
+		return true
+	}
+	if host == "googlesource.com" || strings.HasSuffix(host, ".googlesource.com") {
+		return true
+	}
+	for _, h := range trustedHosts {
+		if host == h {
+			return true
+		}
+	}
+	return false
+}
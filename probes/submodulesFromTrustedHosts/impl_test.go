@@ -0,0 +1,140 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package submodulesFromTrustedHosts
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	submodule := func(url string) checker.Dependency {
+		return checker.Dependency{
+			Name:      &url,
+			Location:  &checker.File{Path: ".gitmodules", Offset: 1, EndOffset: 1},
+			Type:      checker.DependencyUseTypeGitSubmodule,
+			Submodule: &checker.Submodule{Path: "third_party/lib", URL: url},
+		}
+	}
+	withSubmodules := func(urls ...string) *checker.RawResults {
+		raw := &checker.RawResults{
+			Metadata: checker.MetadataData{
+				Metadata: map[string]string{"repository.host": "gitea.example.com"},
+			},
+		}
+		for _, url := range urls {
+			raw.PinningDependenciesResults.Dependencies = append(raw.PinningDependenciesResults.Dependencies,
+				submodule(url))
+		}
+		return raw
+	}
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name: "no submodules",
+			raw: &checker.RawResults{
+				PinningDependenciesResults: checker.PinningDependenciesData{
+					Dependencies: []checker.Dependency{
+						{Type: checker.DependencyUseTypeGHAction},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "secure well-known hosts",
+			raw: withSubmodules(
+				"https://github.com/google/googletest.git",
+				"git@gitlab.com:owner/repo.git",
+				"ssh://git@bitbucket.org/owner/repo.git",
+				"https://chromium.googlesource.com/chromium/src",
+				"../lib.git",
+				"https://gitea.example.com/owner/repo.git",
+			),
+			outcomes: []finding.Outcome{
+				finding.OutcomePositive,
+				finding.OutcomePositive,
+				finding.OutcomePositive,
+				finding.OutcomePositive,
+				finding.OutcomePositive,
+				finding.OutcomePositive,
+			},
+		},
+		{
+			name: "insecure transports",
+			raw: withSubmodules(
+				"http://github.com/google/googletest.git",
+				"git://git.example.org/lib.git",
+				"file:///srv/git/lib.git",
+				"/srv/git/lib.git",
+			),
+			outcomes: []finding.Outcome{
+				finding.OutcomeNegative,
+				finding.OutcomeNegative,
+				finding.OutcomeNegative,
+				finding.OutcomeNegative,
+			},
+		},
+		{
+			name: "other hosts",
+			raw: withSubmodules(
+				"https://git.example.org/lib.git",
+				"git@github.com.example.org:owner/repo.git",
+			),
+			outcomes: []finding.Outcome{
+				finding.OutcomeNegative,
+				finding.OutcomeNegative,
+			},
+		},
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(Probe, s); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			test.AssertOutcomes(t, findings, tt.outcomes)
+		})
+	}
+}
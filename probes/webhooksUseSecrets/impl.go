@@ -49,6 +49,19 @@ func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
 	}
 
 	for _, hook := range r.Webhooks {
+		if hook.AuthSecretUnknown {
+			msg := "Could not determine whether webhook uses token authorization."
+			f, err := finding.NewWith(fs, Probe,
+				msg, nil, finding.OutcomeNotAvailable)
+			if err != nil {
+				return nil, Probe, fmt.Errorf("create finding: %w", err)
+			}
+			f = f.WithLocation(&finding.Location{
+				Path: hook.Path,
+			})
+			findings = append(findings, *f)
+			continue
+		}
 		if hook.UsesAuthSecret {
 			msg := "Webhook with token authorization found."
 			f, err := finding.NewWith(fs, Probe,
@@ -64,6 +64,23 @@ func Test_Run(t *testing.T) {
 				finding.OutcomePositive,
 			},
 		},
+		{
+			name: "Webhooks present with unknown auth secret",
+			raw: &checker.RawResults{
+				WebhookResults: checker.WebhooksData{
+					Webhooks: []clients.Webhook{
+						{
+							Path:              "https://example.com/hook",
+							ID:                1,
+							AuthSecretUnknown: true,
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotAvailable,
+			},
+		},
 		{
 			name: "Webhooks present without auth secret",
 			raw: &checker.RawResults{
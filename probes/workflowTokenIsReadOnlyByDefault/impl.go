@@ -0,0 +1,61 @@
+// Copyright 2024 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:stylecheck
+package workflowTokenIsReadOnlyByDefault
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "workflowTokenIsReadOnlyByDefault"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := &raw.TokenPermissionsResults
+	perms := r.DefaultWorkflowPermissions()
+	if perms == nil {
+		f, err := finding.NewNotAvailable(fs, Probe,
+			"Default workflow permissions are not available.", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	var f *finding.Finding
+	var err error
+	if r.DefaultWorkflowPermissionsAreReadOnly() {
+		f, err = finding.NewPositive(fs, Probe,
+			"GITHUB_TOKEN defaults to read-only permissions.", nil)
+	} else {
+		f, err = finding.NewNegative(fs, Probe,
+			fmt.Sprintf("GITHUB_TOKEN defaults to '%s' permissions.", perms.DefaultPermissions), nil)
+	}
+	if err != nil {
+		return nil, Probe, fmt.Errorf("create finding: %w", err)
+	}
+	return []finding.Finding{*f}, Probe, nil
+}